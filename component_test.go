@@ -0,0 +1,59 @@
+/* This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at http://mozilla.org/MPL/2.0/. */
+
+package libmapper
+
+import (
+	"strings"
+
+	graph "gopkg.in/r3labs/graph.v2"
+)
+
+// testComponent : a minimal component, identified as "type::name"
+type testComponent struct {
+	ID     string            `json:"_component_id"`
+	Action string            `json:"_action"`
+	Deps   []string          `json:"deps"`
+	Value  string            `json:"value"`
+	Ref    string            `json:"ref"`
+	Tags   map[string]string `json:"tags"`
+}
+
+func (c *testComponent) GetID() string              { return c.ID }
+func (c *testComponent) GetName() string            { return strings.Split(c.ID, "::")[1] }
+func (c *testComponent) GetType() string            { return strings.Split(c.ID, "::")[0] }
+func (c *testComponent) GetGroup() string           { return "" }
+func (c *testComponent) GetAction() string          { return c.Action }
+func (c *testComponent) GetState() string           { return "" }
+func (c *testComponent) GetProvider() string        { return "test" }
+func (c *testComponent) GetProviderID() string      { return "" }
+func (c *testComponent) GetTags() map[string]string { return c.Tags }
+func (c *testComponent) GetTag(tag string) string   { return c.Tags[tag] }
+func (c *testComponent) SetAction(a string)         { c.Action = a }
+func (c *testComponent) SetState(string)            {}
+func (c *testComponent) Diff(graph.Component) bool  { return false }
+func (c *testComponent) Update(graph.Component)     {}
+func (c *testComponent) Rebuild(*graph.Graph)       {}
+func (c *testComponent) Dependencies() []string     { return c.Deps }
+func (c *testComponent) Validate() error            { return nil }
+func (c *testComponent) IsStateful() bool           { return false }
+func (c *testComponent) SetDefaultVariables()       {}
+
+// ref : returns a template referencing a field of another component
+func ref(id, field string) string {
+	return `$(components.#[_component_id="` + id + `"].` + field + `)`
+}
+
+// testGraph : returns a graph of the given components and planned changes
+func testGraph(components, changes []graph.Component) *graph.Graph {
+	g := graph.New()
+
+	for _, c := range components {
+		g.AddComponent(c)
+	}
+
+	g.Changes = changes
+
+	return g
+}
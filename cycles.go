@@ -0,0 +1,78 @@
+/* This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at http://mozilla.org/MPL/2.0/. */
+
+package libmapper
+
+import (
+	"strings"
+
+	graph "gopkg.in/r3labs/graph.v2"
+)
+
+const (
+	unvisited = iota
+	visiting
+	visited
+)
+
+// CycleError : a chain of components that depend on each other. Each component
+// in the path depends on the one that follows it, ending with the first component
+type CycleError struct {
+	Path []string
+}
+
+// Error : returns the full path of the cycle
+func (e *CycleError) Error() string {
+	return "Dependency cycle detected: " + strings.Join(e.Path, " -> ")
+}
+
+// CheckCycles : ensures that the graph's edges do not form a cycle. If they do, a
+// *CycleError naming the components in the first cycle found is returned
+func CheckCycles(g *graph.Graph) error {
+	deps := make(map[string][]string)
+
+	for _, e := range g.Edges {
+		deps[e.Destination] = append(deps[e.Destination], e.Source)
+	}
+
+	state := make(map[string]int)
+
+	for _, c := range g.Components {
+		path := visit(c.GetID(), deps, state, nil)
+		if path != nil {
+			return &CycleError{Path: path}
+		}
+	}
+
+	return nil
+}
+
+// visit : walks the dependencies of a component depth first, returning the
+// path of the first cycle found
+func visit(id string, deps map[string][]string, state map[string]int, stack []string) []string {
+	switch state[id] {
+	case visited:
+		return nil
+	case visiting:
+		for i := range stack {
+			if stack[i] == id {
+				return append(append([]string{}, stack[i:]...), id)
+			}
+		}
+	}
+
+	state[id] = visiting
+	stack = append(stack, id)
+
+	for _, dep := range deps[id] {
+		path := visit(dep, deps, state, stack)
+		if path != nil {
+			return path
+		}
+	}
+
+	state[id] = visited
+
+	return nil
+}
@@ -0,0 +1,59 @@
+/* This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at http://mozilla.org/MPL/2.0/. */
+
+package libmapper
+
+import (
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+	graph "gopkg.in/r3labs/graph.v2"
+)
+
+func TestCheckCycles(t *testing.T) {
+	Convey("Given a graph", t, func() {
+		g := testGraph([]graph.Component{
+			&testComponent{ID: "t::a"},
+			&testComponent{ID: "t::b"},
+			&testComponent{ID: "t::c"},
+		}, nil)
+
+		Convey("When its edges do not form a cycle", func() {
+			g.Edges = []graph.Edge{
+				{Source: "t::a", Destination: "t::b"},
+				{Source: "t::a", Destination: "t::c"},
+				{Source: "t::b", Destination: "t::c"},
+			}
+
+			Convey("It should not return an error", func() {
+				So(CheckCycles(g), ShouldBeNil)
+			})
+		})
+
+		Convey("When a component depends on itself", func() {
+			g.Edges = []graph.Edge{
+				{Source: "t::a", Destination: "t::a"},
+			}
+
+			Convey("It should return the component as the cycle", func() {
+				So(CheckCycles(g), ShouldResemble, &CycleError{Path: []string{"t::a", "t::a"}})
+			})
+		})
+
+		Convey("When its edges form a cycle", func() {
+			g.Edges = []graph.Edge{
+				{Source: "t::a", Destination: "t::b"},
+				{Source: "t::b", Destination: "t::c"},
+				{Source: "t::c", Destination: "t::a"},
+			}
+
+			err := CheckCycles(g)
+
+			Convey("It should return the path of the cycle", func() {
+				So(err, ShouldResemble, &CycleError{Path: []string{"t::a", "t::c", "t::b", "t::a"}})
+				So(err.Error(), ShouldEqual, "Dependency cycle detected: t::a -> t::c -> t::b -> t::a")
+			})
+		})
+	})
+}
@@ -0,0 +1,323 @@
+/* This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at http://mozilla.org/MPL/2.0/. */
+
+package libmapper
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	graph "gopkg.in/r3labs/graph.v2"
+)
+
+const (
+	// GROUPBYVPC : groups components by the vpc they belong to
+	GROUPBYVPC = "vpc"
+	// GROUPBYNETWORK : groups components by the network they belong to
+	GROUPBYNETWORK = "network"
+)
+
+// ACTIONCOLOURS : the colours used for each planned action
+var ACTIONCOLOURS = map[string]string{
+	"create": "#b7e1a1",
+	"update": "#ffd97f",
+	"delete": "#f4a6a6",
+	"find":   "#a6c8f4",
+	"none":   "#e0e0e0",
+}
+
+// ACTIONPRECEDENCE : planned actions from most to least significant. A collapsed
+// node is coloured by the most significant action of its components
+var ACTIONPRECEDENCE = []string{"delete", "create", "update", "find", "none"}
+
+// ExportOptions : options used when exporting a graph
+type ExportOptions struct {
+	// GroupBy : groups components by their vpc or network, i.e. GROUPBYVPC.
+	// Components without a vpc or network field are grouped with their dependencies
+	GroupBy string
+	// CollapseBy : renders the components of a type that share a value for this
+	// tag, i.e. the provider's instance group tag, as a single node with a count
+	CollapseBy string
+	// ColourActions : colours each node by its planned action
+	ColourActions bool
+}
+
+type exportNode struct {
+	id     string
+	label  string
+	group  string
+	action string
+	count  int
+}
+
+type export struct {
+	nodes  []*exportNode
+	edges  [][2]string
+	groups []string
+}
+
+// ExportDOT : renders a graph's components and edges in graphviz dot format
+func ExportDOT(g *graph.Graph, opts *ExportOptions) string {
+	var b strings.Builder
+
+	e := buildExport(g, opts)
+
+	b.WriteString("digraph " + strconv.Quote(g.Name) + " {\n")
+	b.WriteString("  node [shape=box];\n")
+
+	writeNode := func(indent string, n *exportNode) {
+		attrs := "label=" + strconv.Quote(n.label)
+		if opts != nil && opts.ColourActions && ACTIONCOLOURS[n.action] != "" {
+			attrs += `, style=filled, fillcolor="` + ACTIONCOLOURS[n.action] + `"`
+		}
+		b.WriteString(indent + strconv.Quote(n.id) + " [" + attrs + "];\n")
+	}
+
+	for i, group := range e.groups {
+		b.WriteString(fmt.Sprintf("  subgraph cluster_%d {\n", i))
+		b.WriteString("    label=" + strconv.Quote(group) + ";\n")
+		for _, n := range e.nodes {
+			if n.group == group {
+				writeNode("    ", n)
+			}
+		}
+		b.WriteString("  }\n")
+	}
+
+	for _, n := range e.nodes {
+		if n.group == "" {
+			writeNode("  ", n)
+		}
+	}
+
+	for _, edge := range e.edges {
+		b.WriteString("  " + strconv.Quote(edge[0]) + " -> " + strconv.Quote(edge[1]) + ";\n")
+	}
+
+	b.WriteString("}\n")
+
+	return b.String()
+}
+
+// ExportMermaid : renders a graph's components and edges as a mermaid flowchart
+func ExportMermaid(g *graph.Graph, opts *ExportOptions) string {
+	var b strings.Builder
+
+	e := buildExport(g, opts)
+
+	// mermaid ids cannot contain the component id delimiter
+	ids := make(map[string]string)
+	for i, n := range e.nodes {
+		ids[n.id] = "n" + strconv.Itoa(i)
+	}
+
+	b.WriteString("flowchart LR\n")
+
+	writeNode := func(indent string, n *exportNode) {
+		b.WriteString(indent + ids[n.id] + `["` + mermaidEscape(n.label) + `"]` + "\n")
+	}
+
+	for i, group := range e.groups {
+		b.WriteString(fmt.Sprintf("  subgraph g%d[\"%s\"]\n", i, mermaidEscape(group)))
+		for _, n := range e.nodes {
+			if n.group == group {
+				writeNode("    ", n)
+			}
+		}
+		b.WriteString("  end\n")
+	}
+
+	for _, n := range e.nodes {
+		if n.group == "" {
+			writeNode("  ", n)
+		}
+	}
+
+	for _, edge := range e.edges {
+		b.WriteString("  " + ids[edge[0]] + " --> " + ids[edge[1]] + "\n")
+	}
+
+	if opts != nil && opts.ColourActions {
+		for _, action := range ACTIONPRECEDENCE {
+			var nodes []string
+			for _, n := range e.nodes {
+				if n.action == action {
+					nodes = append(nodes, ids[n.id])
+				}
+			}
+
+			if len(nodes) > 0 {
+				b.WriteString("  classDef " + action + " fill:" + ACTIONCOLOURS[action] + "\n")
+				b.WriteString("  class " + strings.Join(nodes, ",") + " " + action + "\n")
+			}
+		}
+	}
+
+	return b.String()
+}
+
+// buildExport : maps a graph's components to the nodes and edges to be rendered
+func buildExport(g *graph.Graph, opts *ExportOptions) *export {
+	if opts == nil {
+		opts = &ExportOptions{}
+	}
+
+	e := &export{}
+
+	var components []graph.Component
+
+	index := make(map[string]graph.Component)
+	actions := make(map[string]string)
+
+	for _, c := range g.Components {
+		components = append(components, c)
+		index[c.GetID()] = c
+		actions[c.GetID()] = c.GetAction()
+	}
+
+	// planned changes take precedence, and include components that are being removed
+	for _, c := range g.Changes {
+		_, ok := index[c.GetID()]
+		if ok != true {
+			components = append(components, c)
+			index[c.GetID()] = c
+		}
+		actions[c.GetID()] = c.GetAction()
+	}
+
+	groups := make(map[string]string)
+	nodes := make(map[string]*exportNode)
+	nodeIDs := make(map[string]string)
+
+	for _, c := range components {
+		id := c.GetID()
+
+		if opts.CollapseBy != "" && c.GetTag(opts.CollapseBy) != "" {
+			id = c.GetType() + "::" + c.GetTag(opts.CollapseBy)
+		}
+
+		nodeIDs[c.GetID()] = id
+
+		n, ok := nodes[id]
+		if ok {
+			n.count++
+			n.action = significantAction(n.action, actions[c.GetID()])
+			continue
+		}
+
+		n = &exportNode{
+			id:     id,
+			label:  id,
+			action: actions[c.GetID()],
+			count:  1,
+		}
+
+		if opts.GroupBy != "" {
+			n.group = exportGroup(c, opts.GroupBy, index, groups, make(map[string]bool))
+			if n.group != "" && isOneOf(e.groups, n.group) != true {
+				e.groups = append(e.groups, n.group)
+			}
+		}
+
+		nodes[id] = n
+		e.nodes = append(e.nodes, n)
+	}
+
+	for _, n := range e.nodes {
+		if n.count > 1 {
+			n.label = n.id + " (" + strconv.Itoa(n.count) + ")"
+		}
+	}
+
+	for _, edge := range g.Edges {
+		src, sok := nodeIDs[edge.Source]
+		dst, dok := nodeIDs[edge.Destination]
+
+		if sok != true || dok != true || src == dst {
+			continue
+		}
+
+		if hasEdge(e.edges, src, dst) != true {
+			e.edges = append(e.edges, [2]string{src, dst})
+		}
+	}
+
+	return e
+}
+
+// exportGroup : returns the vpc or network a component belongs to. Components
+// that do not specify one inherit the group of their first grouped dependency
+func exportGroup(c graph.Component, groupBy string, index map[string]graph.Component, groups map[string]string, visited map[string]bool) string {
+	group, ok := groups[c.GetID()]
+	if ok {
+		return group
+	}
+
+	if visited[c.GetID()] {
+		return ""
+	}
+	visited[c.GetID()] = true
+
+	if c.GetType() == groupBy {
+		group = c.GetName()
+	} else {
+		v, err := ToMap(c)
+		if err == nil {
+			group, _ = v[groupBy].(string)
+		}
+	}
+
+	if group == "" {
+		for _, dep := range c.Dependencies() {
+			dc, ok := index[dep]
+			if ok != true {
+				continue
+			}
+
+			group = exportGroup(dc, groupBy, index, groups, visited)
+			if group != "" {
+				break
+			}
+		}
+	}
+
+	groups[c.GetID()] = group
+
+	return group
+}
+
+// significantAction : returns the more significant of two planned actions
+func significantAction(a, b string) string {
+	for _, action := range ACTIONPRECEDENCE {
+		if a == action || b == action {
+			return action
+		}
+	}
+
+	return a
+}
+
+func mermaidEscape(s string) string {
+	return strings.Replace(s, `"`, "#quot;", -1)
+}
+
+func hasEdge(edges [][2]string, src, dst string) bool {
+	for _, e := range edges {
+		if e[0] == src && e[1] == dst {
+			return true
+		}
+	}
+
+	return false
+}
+
+func isOneOf(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
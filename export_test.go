@@ -0,0 +1,56 @@
+/* This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at http://mozilla.org/MPL/2.0/. */
+
+package libmapper
+
+import (
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+	graph "gopkg.in/r3labs/graph.v2"
+)
+
+func TestExportDOT(t *testing.T) {
+	Convey("Given a graph with an instance group planned for mixed actions", t, func() {
+		tags := map[string]string{"group": "web"}
+
+		g := testGraph(nil, []graph.Component{
+			&testComponent{ID: "instance::web-1", Action: "update", Tags: tags},
+			&testComponent{ID: "instance::web-2", Action: "create", Tags: tags},
+			&testComponent{ID: "instance::web-3", Action: "none", Tags: tags},
+		})
+
+		Convey("When exporting it with the group collapsed", func() {
+			out := ExportDOT(g, &ExportOptions{CollapseBy: "group", ColourActions: true})
+
+			Convey("It should render the group as a single node with a count", func() {
+				So(out, ShouldContainSubstring, `"instance::web" [label="instance::web (3)"`)
+				So(out, ShouldNotContainSubstring, "instance::web-1")
+			})
+
+			Convey("It should colour the node by its most significant action", func() {
+				So(out, ShouldContainSubstring, `fillcolor="`+ACTIONCOLOURS["create"]+`"`)
+			})
+		})
+
+		Convey("When one of its instances is being deleted", func() {
+			g.Changes[2].SetAction("delete")
+
+			out := ExportDOT(g, &ExportOptions{CollapseBy: "group", ColourActions: true})
+
+			Convey("It should colour the node as deleted", func() {
+				So(out, ShouldContainSubstring, `fillcolor="`+ACTIONCOLOURS["delete"]+`"`)
+			})
+		})
+
+		Convey("When exporting it without collapsing", func() {
+			out := ExportDOT(g, &ExportOptions{ColourActions: true})
+
+			Convey("It should render each instance", func() {
+				So(out, ShouldContainSubstring, `"instance::web-1" [label="instance::web-1"`)
+				So(out, ShouldContainSubstring, `"instance::web-3" [label="instance::web-3"`)
+			})
+		})
+	})
+}
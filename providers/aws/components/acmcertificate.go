@@ -0,0 +1,255 @@
+/* This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at http://mozilla.org/MPL/2.0/. */
+
+package components
+
+import (
+	"encoding/pem"
+	"errors"
+	"reflect"
+	"strings"
+
+	graph "gopkg.in/r3labs/graph.v2"
+)
+
+const (
+	// VALIDATIONDNS : certificate validated through dns records
+	VALIDATIONDNS = "DNS"
+	// VALIDATIONIMPORT : certificate imported from pem encoded material
+	VALIDATIONIMPORT = "IMPORT"
+)
+
+// ACMValidationRecord ...
+type ACMValidationRecord struct {
+	DomainName string `json:"domain_name"`
+	Name       string `json:"name"`
+	Type       string `json:"type"`
+	Value      string `json:"value"`
+}
+
+// ACMCertificate : mapping of an acm certificate component
+type ACMCertificate struct {
+	ProviderType      string                `json:"_provider"`
+	ComponentType     string                `json:"_component"`
+	ComponentID       string                `json:"_component_id"`
+	State             string                `json:"_state"`
+	Action            string                `json:"_action"`
+	ARN               string                `json:"certificate_arn"`
+	Name              string                `json:"name"`
+	DomainName        string                `json:"domain_name,omitempty"`
+	AlternativeNames  []string              `json:"alternative_names"`
+	ValidationMethod  string                `json:"validation_method"`
+	HostedZoneID      string                `json:"hosted_zone_id,omitempty"`
+	ValidationRecords []ACMValidationRecord `json:"validation_records"`
+	CertificateBody   string                `json:"certificate_body,omitempty"`
+	CertificateChain  string                `json:"certificate_chain,omitempty"`
+	PrivateKey        string                `json:"private_key,omitempty"`
+	Status            string                `json:"status"`
+	Tags              map[string]string     `json:"tags"`
+	DatacenterType    string                `json:"datacenter_type,omitempty"`
+	DatacenterName    string                `json:"datacenter_name,omitempty"`
+	DatacenterRegion  string                `json:"datacenter_region"`
+	AccessKeyID       string                `json:"aws_access_key_id"`
+	SecretAccessKey   string                `json:"aws_secret_access_key"`
+	Service           string                `json:"service"`
+}
+
+// GetID : returns the component's ID
+func (a *ACMCertificate) GetID() string {
+	return a.ComponentID
+}
+
+// GetName returns a components name
+func (a *ACMCertificate) GetName() string {
+	return a.Name
+}
+
+// GetProvider : returns the provider type
+func (a *ACMCertificate) GetProvider() string {
+	return a.ProviderType
+}
+
+// GetProviderID returns a components provider id
+func (a *ACMCertificate) GetProviderID() string {
+	return a.ARN
+}
+
+// GetType : returns the type of the component
+func (a *ACMCertificate) GetType() string {
+	return a.ComponentType
+}
+
+// GetState : returns the state of the component
+func (a *ACMCertificate) GetState() string {
+	return a.State
+}
+
+// SetState : sets the state of the component
+func (a *ACMCertificate) SetState(s string) {
+	a.State = s
+}
+
+// GetAction : returns the action of the component
+func (a *ACMCertificate) GetAction() string {
+	return a.Action
+}
+
+// SetAction : Sets the action of the component
+func (a *ACMCertificate) SetAction(s string) {
+	a.Action = s
+}
+
+// GetGroup : returns the components group
+func (a *ACMCertificate) GetGroup() string {
+	return ""
+}
+
+// GetTags returns a components tags
+func (a *ACMCertificate) GetTags() map[string]string {
+	return a.Tags
+}
+
+// GetTag returns a components tag
+func (a *ACMCertificate) GetTag(tag string) string {
+	return a.Tags[tag]
+}
+
+// Diff : diff's the component against another component of the same type
+func (a *ACMCertificate) Diff(c graph.Component) bool {
+	ca, ok := c.(*ACMCertificate)
+	if ok {
+		if a.ValidationMethod != ca.ValidationMethod {
+			return true
+		}
+
+		if a.ValidationMethod == VALIDATIONIMPORT {
+			return a.CertificateBody != ca.CertificateBody ||
+				a.CertificateChain != ca.CertificateChain ||
+				a.PrivateKey != ca.PrivateKey
+		}
+
+		if a.DomainName != ca.DomainName {
+			return true
+		}
+
+		return !reflect.DeepEqual(a.AlternativeNames, ca.AlternativeNames)
+	}
+
+	return false
+}
+
+// Update : updates the provider returned values of a component
+func (a *ACMCertificate) Update(c graph.Component) {
+	ca, ok := c.(*ACMCertificate)
+	if ok {
+		a.ARN = ca.ARN
+		a.Status = ca.Status
+		a.ValidationRecords = ca.ValidationRecords
+	}
+
+	a.SetDefaultVariables()
+}
+
+// Rebuild : rebuilds the component's internal state, such as templated values
+func (a *ACMCertificate) Rebuild(g *graph.Graph) {
+	if a.ValidationMethod == "" {
+		if a.CertificateBody != "" {
+			a.ValidationMethod = VALIDATIONIMPORT
+		} else {
+			a.ValidationMethod = VALIDATIONDNS
+		}
+	}
+
+	a.ValidationMethod = strings.ToUpper(a.ValidationMethod)
+
+	a.SetDefaultVariables()
+}
+
+// References : returns the references the component holds to other components
+func (a *ACMCertificate) References() []Reference {
+	return nil
+}
+
+// Dependencies : returns a list of component id's upon which the component depends
+func (a *ACMCertificate) Dependencies() []string {
+	return dependencies(a.References())
+}
+
+// Validate : validates the components values
+func (a *ACMCertificate) Validate() error {
+	if a.Name == "" {
+		return errors.New("ACM Certificate name should not be null")
+	}
+
+	switch a.ValidationMethod {
+	case VALIDATIONIMPORT:
+		if validatePEM(a.CertificateBody, "CERTIFICATE") != true {
+			return errors.New("ACM Certificate body should be a pem encoded certificate")
+		}
+
+		if a.CertificateChain != "" && validatePEM(a.CertificateChain, "CERTIFICATE") != true {
+			return errors.New("ACM Certificate chain should contain pem encoded certificates")
+		}
+
+		if validatePEM(a.PrivateKey, "PRIVATE KEY") != true {
+			return errors.New("ACM Certificate private key should be a pem encoded private key")
+		}
+	case VALIDATIONDNS:
+		if a.DomainName == "" {
+			return errors.New("ACM Certificate domain name should not be null")
+		}
+
+		if len(a.DomainName) > 253 {
+			return errors.New("ACM Certificate domain name should not exceed 253 characters")
+		}
+
+		if a.CertificateBody != "" || a.PrivateKey != "" {
+			return errors.New("ACM Certificate should not specify a certificate body or private key when requesting a certificate")
+		}
+	default:
+		return errors.New("ACM Certificate validation method must be one of dns or import")
+	}
+
+	return nil
+}
+
+// IsStateful : returns true if the component needs to be actioned to be removed.
+func (a *ACMCertificate) IsStateful() bool {
+	return true
+}
+
+// SetDefaultVariables : sets up the default template variables for a component
+func (a *ACMCertificate) SetDefaultVariables() {
+	a.ComponentType = TYPEACMCERTIFICATE
+	a.ComponentID = TYPEACMCERTIFICATE + TYPEDELIMITER + a.Name
+	a.ProviderType = PROVIDERTYPE
+	a.DatacenterName = DATACENTERNAME
+	a.DatacenterType = DATACENTERTYPE
+	a.DatacenterRegion = DATACENTERREGION
+	a.AccessKeyID = ACCESSKEYID
+	a.SecretAccessKey = SECRETACCESSKEY
+}
+
+// validatePEM checks that all blocks of a pem encoded value are of the expected type
+func validatePEM(data, suffix string) bool {
+	rest := []byte(data)
+	found := false
+
+	for {
+		var b *pem.Block
+
+		b, rest = pem.Decode(rest)
+		if b == nil {
+			break
+		}
+
+		if strings.HasSuffix(b.Type, suffix) != true {
+			return false
+		}
+
+		found = true
+	}
+
+	return found && strings.TrimSpace(string(rest)) == ""
+}
@@ -0,0 +1,267 @@
+/* This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at http://mozilla.org/MPL/2.0/. */
+
+package components
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+
+	graph "gopkg.in/r3labs/graph.v2"
+)
+
+// CloudWatchAlarm : mapping of a cloudwatch alarm component
+type CloudWatchAlarm struct {
+	ProviderType       string            `json:"_provider"`
+	ComponentType      string            `json:"_component"`
+	ComponentID        string            `json:"_component_id"`
+	State              string            `json:"_state"`
+	Action             string            `json:"_action"`
+	ARN                string            `json:"alarm_arn"`
+	Name               string            `json:"name"`
+	MetricName         string            `json:"metric_name"`
+	Namespace          string            `json:"namespace"`
+	Statistic          string            `json:"statistic"`
+	ComparisonOperator string            `json:"comparison_operator"`
+	Threshold          float64           `json:"threshold"`
+	Period             int64             `json:"period"`
+	EvaluationPeriods  int64             `json:"evaluation_periods"`
+	Actions            []string          `json:"actions"`
+	Instance           string            `json:"instance,omitempty"`
+	ELB                string            `json:"elb,omitempty"`
+	RDSCluster         string            `json:"rds_cluster,omitempty"`
+	Dimensions         map[string]string `json:"dimensions"`
+	Tags               map[string]string `json:"tags"`
+	DatacenterType     string            `json:"datacenter_type,omitempty"`
+	DatacenterName     string            `json:"datacenter_name,omitempty"`
+	DatacenterRegion   string            `json:"datacenter_region"`
+	AccessKeyID        string            `json:"aws_access_key_id"`
+	SecretAccessKey    string            `json:"aws_secret_access_key"`
+	Service            string            `json:"service"`
+}
+
+// GetID : returns the component's ID
+func (a *CloudWatchAlarm) GetID() string {
+	return a.ComponentID
+}
+
+// GetName returns a components name
+func (a *CloudWatchAlarm) GetName() string {
+	return a.Name
+}
+
+// GetProvider : returns the provider type
+func (a *CloudWatchAlarm) GetProvider() string {
+	return a.ProviderType
+}
+
+// GetProviderID returns a components provider id
+func (a *CloudWatchAlarm) GetProviderID() string {
+	return a.ARN
+}
+
+// GetType : returns the type of the component
+func (a *CloudWatchAlarm) GetType() string {
+	return a.ComponentType
+}
+
+// GetState : returns the state of the component
+func (a *CloudWatchAlarm) GetState() string {
+	return a.State
+}
+
+// SetState : sets the state of the component
+func (a *CloudWatchAlarm) SetState(s string) {
+	a.State = s
+}
+
+// GetAction : returns the action of the component
+func (a *CloudWatchAlarm) GetAction() string {
+	return a.Action
+}
+
+// SetAction : Sets the action of the component
+func (a *CloudWatchAlarm) SetAction(s string) {
+	a.Action = s
+}
+
+// GetGroup : returns the components group
+func (a *CloudWatchAlarm) GetGroup() string {
+	return a.Tags[GROUPALARM]
+}
+
+// GetTags returns a components tags
+func (a *CloudWatchAlarm) GetTags() map[string]string {
+	return a.Tags
+}
+
+// GetTag returns a components tag
+func (a *CloudWatchAlarm) GetTag(tag string) string {
+	return a.Tags[tag]
+}
+
+// Diff : diff's the component against another component of the same type
+func (a *CloudWatchAlarm) Diff(c graph.Component) bool {
+	ca, ok := c.(*CloudWatchAlarm)
+	if ok {
+		if a.MetricName != ca.MetricName ||
+			a.Namespace != ca.Namespace ||
+			a.Statistic != ca.Statistic ||
+			a.ComparisonOperator != ca.ComparisonOperator ||
+			a.Threshold != ca.Threshold ||
+			a.Period != ca.Period ||
+			a.EvaluationPeriods != ca.EvaluationPeriods {
+			return true
+		}
+
+		if a.Instance != ca.Instance || a.ELB != ca.ELB || a.RDSCluster != ca.RDSCluster {
+			return true
+		}
+
+		return !reflect.DeepEqual(a.Actions, ca.Actions)
+	}
+
+	return false
+}
+
+// Update : updates the provider returned values of a component
+func (a *CloudWatchAlarm) Update(c graph.Component) {
+	ca, ok := c.(*CloudWatchAlarm)
+	if ok {
+		a.ARN = ca.ARN
+	}
+
+	a.SetDefaultVariables()
+}
+
+// Rebuild : rebuilds the component's internal state, such as templated values
+func (a *CloudWatchAlarm) Rebuild(g *graph.Graph) {
+	if a.Dimensions == nil {
+		a.Dimensions = make(map[string]string)
+	}
+
+	if a.Instance == "" && a.Dimensions["InstanceId"] != "" {
+		i := g.GetComponents().ByProviderID(a.Dimensions["InstanceId"])
+		if i != nil {
+			a.Instance = i.GetName()
+		}
+	}
+
+	applyReferences(a.References())
+
+	a.SetDefaultVariables()
+}
+
+// References : returns the references the component holds to other components
+func (a *CloudWatchAlarm) References() []Reference {
+	var refs []Reference
+
+	if a.Instance != "" {
+		refs = append(refs, refInstanceID(a.Instance).with(a.dimension("InstanceId")))
+	}
+
+	if a.ELB != "" {
+		refs = append(refs, refELBName(a.ELB).with(a.dimension("LoadBalancerName")))
+	}
+
+	if a.RDSCluster != "" {
+		refs = append(refs, refRDSClusterName(a.RDSCluster).with(a.dimension("DBClusterIdentifier")))
+	}
+
+	return refs
+}
+
+// Dependencies : returns a list of component id's upon which the component depends
+func (a *CloudWatchAlarm) Dependencies() []string {
+	return dependencies(a.References())
+}
+
+// Validate : validates the components values
+func (a *CloudWatchAlarm) Validate() error {
+	var statistics = []string{"SampleCount", "Average", "Sum", "Minimum", "Maximum"}
+	var operators = []string{"GreaterThanOrEqualToThreshold", "GreaterThanThreshold", "LessThanThreshold", "LessThanOrEqualToThreshold"}
+
+	if a.Name == "" {
+		return errors.New("CloudWatch Alarm name should not be null")
+	}
+
+	if len(a.Name) > 255 {
+		return errors.New("CloudWatch Alarm name should not exceed 255 characters")
+	}
+
+	if a.MetricName == "" {
+		return errors.New("CloudWatch Alarm metric should not be null")
+	}
+
+	if a.Namespace == "" {
+		return errors.New("CloudWatch Alarm namespace should not be null")
+	}
+
+	if isOneOf(statistics, a.Statistic) != true {
+		return fmt.Errorf("CloudWatch Alarm statistic must be one of %s", strings.Join(statistics, ", "))
+	}
+
+	if isOneOf(operators, a.ComparisonOperator) != true {
+		return fmt.Errorf("CloudWatch Alarm comparison must be one of %s", strings.Join(operators, ", "))
+	}
+
+	if a.Period != 10 && a.Period != 30 && (a.Period < 60 || a.Period%60 != 0) {
+		return errors.New("CloudWatch Alarm period should be 10, 30 or a multiple of 60 seconds")
+	}
+
+	if a.EvaluationPeriods < 1 {
+		return errors.New("CloudWatch Alarm evaluation periods should be greater than 0")
+	}
+
+	if a.Period*a.EvaluationPeriods > 86400 {
+		return errors.New("CloudWatch Alarm period multiplied by evaluation periods should not exceed one day")
+	}
+
+	for _, action := range a.Actions {
+		if strings.HasPrefix(action, "arn:aws:sns:") != true {
+			return fmt.Errorf("CloudWatch Alarm action (%s) should be a valid sns topic arn", action)
+		}
+	}
+
+	targets := 0
+	for _, t := range []string{a.Instance, a.ELB, a.RDSCluster} {
+		if t != "" {
+			targets++
+		}
+	}
+
+	if targets > 1 {
+		return errors.New("CloudWatch Alarm should only be attached to one of an instance, elb or rds cluster")
+	}
+
+	return nil
+}
+
+// IsStateful : returns true if the component needs to be actioned to be removed.
+func (a *CloudWatchAlarm) IsStateful() bool {
+	return true
+}
+
+// SetDefaultVariables : sets up the default template variables for a component
+func (a *CloudWatchAlarm) SetDefaultVariables() {
+	a.ComponentType = TYPECLOUDWATCHALARM
+	a.ComponentID = TYPECLOUDWATCHALARM + TYPEDELIMITER + a.Name
+	a.ProviderType = PROVIDERTYPE
+	a.DatacenterName = DATACENTERNAME
+	a.DatacenterType = DATACENTERTYPE
+	a.DatacenterRegion = DATACENTERREGION
+	a.AccessKeyID = ACCESSKEYID
+	a.SecretAccessKey = SECRETACCESSKEY
+}
+
+// dimension : returns a function setting the value of a dimension, unless it is already set
+func (a *CloudWatchAlarm) dimension(name string) func(string) {
+	return func(v string) {
+		if a.Dimensions[name] == "" {
+			a.Dimensions[name] = v
+		}
+	}
+}
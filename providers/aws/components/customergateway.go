@@ -0,0 +1,167 @@
+/* This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at http://mozilla.org/MPL/2.0/. */
+
+package components
+
+import (
+	"errors"
+	"fmt"
+
+	graph "gopkg.in/r3labs/graph.v2"
+)
+
+// CustomerGateway : mapping of a customer gateway component
+type CustomerGateway struct {
+	ProviderType         string            `json:"_provider"`
+	ComponentType        string            `json:"_component"`
+	ComponentID          string            `json:"_component_id"`
+	State                string            `json:"_state"`
+	Action               string            `json:"_action"`
+	CustomerGatewayAWSID string            `json:"customer_gateway_aws_id"`
+	Name                 string            `json:"name"`
+	IPAddress            string            `json:"ip_address"`
+	BGPASN               int64             `json:"bgp_asn"`
+	Tags                 map[string]string `json:"tags"`
+	DatacenterType       string            `json:"datacenter_type,omitempty"`
+	DatacenterName       string            `json:"datacenter_name,omitempty"`
+	DatacenterRegion     string            `json:"datacenter_region"`
+	AccessKeyID          string            `json:"aws_access_key_id"`
+	SecretAccessKey      string            `json:"aws_secret_access_key"`
+	Service              string            `json:"service"`
+}
+
+// GetID : returns the component's ID
+func (x *CustomerGateway) GetID() string {
+	return x.ComponentID
+}
+
+// GetName returns a components name
+func (x *CustomerGateway) GetName() string {
+	return x.Name
+}
+
+// GetProvider : returns the provider type
+func (x *CustomerGateway) GetProvider() string {
+	return x.ProviderType
+}
+
+// GetProviderID returns a components provider id
+func (x *CustomerGateway) GetProviderID() string {
+	return x.CustomerGatewayAWSID
+}
+
+// GetType : returns the type of the component
+func (x *CustomerGateway) GetType() string {
+	return x.ComponentType
+}
+
+// GetState : returns the state of the component
+func (x *CustomerGateway) GetState() string {
+	return x.State
+}
+
+// SetState : sets the state of the component
+func (x *CustomerGateway) SetState(s string) {
+	x.State = s
+}
+
+// GetAction : returns the action of the component
+func (x *CustomerGateway) GetAction() string {
+	return x.Action
+}
+
+// SetAction : Sets the action of the component
+func (x *CustomerGateway) SetAction(s string) {
+	x.Action = s
+}
+
+// GetGroup : returns the components group
+func (x *CustomerGateway) GetGroup() string {
+	return ""
+}
+
+// GetTags returns a components tags
+func (x *CustomerGateway) GetTags() map[string]string {
+	return x.Tags
+}
+
+// GetTag returns a components tag
+func (x *CustomerGateway) GetTag(tag string) string {
+	return x.Tags[tag]
+}
+
+// Diff : diff's the component against another component of the same type.
+// Customer gateways cannot be modified, so any change replaces the gateway
+func (x *CustomerGateway) Diff(c graph.Component) bool {
+	cx, ok := c.(*CustomerGateway)
+	if ok {
+		return x.IPAddress != cx.IPAddress || x.BGPASN != cx.BGPASN
+	}
+
+	return false
+}
+
+// Update : updates the provider returned values of a component
+func (x *CustomerGateway) Update(c graph.Component) {
+	cx, ok := c.(*CustomerGateway)
+	if ok {
+		x.CustomerGatewayAWSID = cx.CustomerGatewayAWSID
+	}
+
+	x.SetDefaultVariables()
+}
+
+// Rebuild : rebuilds the component's internal state, such as templated values
+func (x *CustomerGateway) Rebuild(g *graph.Graph) {
+	if x.BGPASN == 0 {
+		x.BGPASN = 65000
+	}
+
+	x.SetDefaultVariables()
+}
+
+// References : returns the references the component holds to other components
+func (x *CustomerGateway) References() []Reference {
+	return nil
+}
+
+// Dependencies : returns a list of component id's upon which the component depends
+func (x *CustomerGateway) Dependencies() []string {
+	return dependencies(x.References())
+}
+
+// Validate : validates the components values
+func (x *CustomerGateway) Validate() error {
+	if x.Name == "" {
+		return errors.New("Customer Gateway name should not be null")
+	}
+
+	err := validatePublicIPv4(x.IPAddress)
+	if err != nil {
+		return errors.New("Customer Gateway " + err.Error())
+	}
+
+	if x.BGPASN < 1 || x.BGPASN > 4294967294 {
+		return fmt.Errorf("Customer Gateway bgp asn (%d) should be between 1 and 4294967294", x.BGPASN)
+	}
+
+	return nil
+}
+
+// IsStateful : returns true if the component needs to be actioned to be removed.
+func (x *CustomerGateway) IsStateful() bool {
+	return true
+}
+
+// SetDefaultVariables : sets up the default template variables for a component
+func (x *CustomerGateway) SetDefaultVariables() {
+	x.ComponentType = TYPECUSTOMERGATEWAY
+	x.ComponentID = TYPECUSTOMERGATEWAY + TYPEDELIMITER + x.Name
+	x.ProviderType = PROVIDERTYPE
+	x.DatacenterName = DATACENTERNAME
+	x.DatacenterType = DATACENTERTYPE
+	x.DatacenterRegion = DATACENTERREGION
+	x.AccessKeyID = ACCESSKEYID
+	x.SecretAccessKey = SECRETACCESSKEY
+}
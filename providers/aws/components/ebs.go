@@ -6,6 +6,8 @@ package components
 
 import (
 	"errors"
+	"fmt"
+	"strings"
 
 	graph "gopkg.in/r3labs/graph.v2"
 )
@@ -24,6 +26,9 @@ type EBSVolume struct {
 	Size             *int64            `json:"size"`
 	Iops             *int64            `json:"iops"`
 	Encrypted        bool              `json:"encrypted"`
+	SnapshotID       string            `json:"snapshot_id,omitempty"`
+	SnapshotSize     *int64            `json:"snapshot_size,omitempty"`
+	EncryptionKey    string            `json:"encryption_key"`
 	EncryptionKeyID  *string           `json:"encryption_key_id"`
 	Tags             map[string]string `json:"tags"`
 	DatacenterType   string            `json:"datacenter_type,omitempty"`
@@ -104,6 +109,9 @@ func (e *EBSVolume) Update(c graph.Component) {
 	ce, ok := c.(*EBSVolume)
 	if ok {
 		e.VolumeAWSID = ce.VolumeAWSID
+		if ce.SnapshotSize != nil {
+			e.SnapshotSize = ce.SnapshotSize
+		}
 	}
 
 	e.SetDefaultVariables()
@@ -115,12 +123,36 @@ func (e *EBSVolume) Rebuild(g *graph.Graph) {
 		e.Iops = nil
 	}
 
+	if e.EncryptionKey == "" && e.EncryptionKeyID != nil {
+		k := g.GetComponents().ByProviderID(*e.EncryptionKeyID)
+		if k != nil {
+			e.EncryptionKey = k.GetName()
+		}
+	}
+
+	applyReferences(e.References())
+
 	e.SetDefaultVariables()
 }
 
+// References : returns the references the component holds to other components
+func (e *EBSVolume) References() []Reference {
+	var refs []Reference
+
+	if e.EncryptionKey != "" {
+		refs = append(refs, refKMSKeyARN(e.EncryptionKey).with(func(t string) {
+			if e.EncryptionKeyID == nil {
+				e.EncryptionKeyID = &t
+			}
+		}))
+	}
+
+	return refs
+}
+
 // Dependencies : returns a list of component id's upon which the component depends
 func (e *EBSVolume) Dependencies() []string {
-	return []string{}
+	return dependencies(e.References())
 }
 
 // Validate : validates the components values
@@ -138,7 +170,7 @@ func (e *EBSVolume) Validate() error {
 	}
 
 	if e.Encrypted && e.EncryptionKeyID == nil {
-		return errors.New("EBS Volume encryption key id (KMS key id) should be set if volume is encrypted")
+		return errors.New("EBS Volume encryption key (KMS key name or id) should be set if volume is encrypted")
 	}
 
 	if e.VolumeType != "io1" && e.Iops != nil {
@@ -151,6 +183,16 @@ func (e *EBSVolume) Validate() error {
 		}
 	}
 
+	if e.SnapshotID != "" && strings.HasPrefix(e.SnapshotID, "snap-") != true {
+		return errors.New("EBS Volume snapshot id should be a valid snapshot id, i.e. 'snap-12345678'")
+	}
+
+	if e.SnapshotID != "" && e.Size != nil && e.SnapshotSize != nil {
+		if *e.Size < *e.SnapshotSize {
+			return fmt.Errorf("EBS Volume size (%d GB) should not be smaller than its snapshot (%d GB)", *e.Size, *e.SnapshotSize)
+		}
+	}
+
 	return nil
 }
 
@@ -0,0 +1,155 @@
+/* This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at http://mozilla.org/MPL/2.0/. */
+
+package components
+
+import (
+	"errors"
+
+	graph "gopkg.in/r3labs/graph.v2"
+)
+
+// ECSCluster : mapping of an ecs cluster component
+type ECSCluster struct {
+	ProviderType      string            `json:"_provider"`
+	ComponentType     string            `json:"_component"`
+	ComponentID       string            `json:"_component_id"`
+	State             string            `json:"_state"`
+	Action            string            `json:"_action"`
+	ARN               string            `json:"cluster_arn"`
+	Name              string            `json:"name"`
+	ContainerInsights bool              `json:"container_insights"`
+	Tags              map[string]string `json:"tags"`
+	DatacenterType    string            `json:"datacenter_type,omitempty"`
+	DatacenterName    string            `json:"datacenter_name,omitempty"`
+	DatacenterRegion  string            `json:"datacenter_region"`
+	AccessKeyID       string            `json:"aws_access_key_id"`
+	SecretAccessKey   string            `json:"aws_secret_access_key"`
+	Service           string            `json:"service"`
+}
+
+// GetID : returns the component's ID
+func (e *ECSCluster) GetID() string {
+	return e.ComponentID
+}
+
+// GetName returns a components name
+func (e *ECSCluster) GetName() string {
+	return e.Name
+}
+
+// GetProvider : returns the provider type
+func (e *ECSCluster) GetProvider() string {
+	return e.ProviderType
+}
+
+// GetProviderID returns a components provider id
+func (e *ECSCluster) GetProviderID() string {
+	return e.ARN
+}
+
+// GetType : returns the type of the component
+func (e *ECSCluster) GetType() string {
+	return e.ComponentType
+}
+
+// GetState : returns the state of the component
+func (e *ECSCluster) GetState() string {
+	return e.State
+}
+
+// SetState : sets the state of the component
+func (e *ECSCluster) SetState(s string) {
+	e.State = s
+}
+
+// GetAction : returns the action of the component
+func (e *ECSCluster) GetAction() string {
+	return e.Action
+}
+
+// SetAction : Sets the action of the component
+func (e *ECSCluster) SetAction(s string) {
+	e.Action = s
+}
+
+// GetGroup : returns the components group
+func (e *ECSCluster) GetGroup() string {
+	return ""
+}
+
+// GetTags returns a components tags
+func (e *ECSCluster) GetTags() map[string]string {
+	return e.Tags
+}
+
+// GetTag returns a components tag
+func (e *ECSCluster) GetTag(tag string) string {
+	return e.Tags[tag]
+}
+
+// Diff : diff's the component against another component of the same type
+func (e *ECSCluster) Diff(c graph.Component) bool {
+	ce, ok := c.(*ECSCluster)
+	if ok {
+		return e.ContainerInsights != ce.ContainerInsights
+	}
+
+	return false
+}
+
+// Update : updates the provider returned values of a component
+func (e *ECSCluster) Update(c graph.Component) {
+	ce, ok := c.(*ECSCluster)
+	if ok {
+		e.ARN = ce.ARN
+	}
+
+	e.SetDefaultVariables()
+}
+
+// Rebuild : rebuilds the component's internal state, such as templated values
+func (e *ECSCluster) Rebuild(g *graph.Graph) {
+	e.SetDefaultVariables()
+}
+
+// References : returns the references the component holds to other components
+func (e *ECSCluster) References() []Reference {
+	return nil
+}
+
+// Dependencies : returns a list of component id's upon which the component depends
+func (e *ECSCluster) Dependencies() []string {
+	return dependencies(e.References())
+}
+
+// Validate : validates the components values
+func (e *ECSCluster) Validate() error {
+	if e.Name == "" {
+		return errors.New("ECS Cluster name should not be null")
+	}
+
+	if len(e.Name) > 255 {
+		return errors.New("ECS Cluster name should not exceed 255 characters")
+	}
+
+	return nil
+}
+
+// IsStateful : returns true if the component needs to be actioned to be removed.
+func (e *ECSCluster) IsStateful() bool {
+	return true
+}
+
+// SetDefaultVariables : sets up the default template variables for a component
+func (e *ECSCluster) SetDefaultVariables() {
+	e.ComponentType = TYPEECSCLUSTER
+	e.ComponentID = TYPEECSCLUSTER + TYPEDELIMITER + e.Name
+	e.ProviderType = PROVIDERTYPE
+	e.DatacenterName = DATACENTERNAME
+	e.DatacenterType = DATACENTERTYPE
+	e.DatacenterRegion = DATACENTERREGION
+	e.AccessKeyID = ACCESSKEYID
+	e.SecretAccessKey = SECRETACCESSKEY
+}
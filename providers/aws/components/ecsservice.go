@@ -0,0 +1,283 @@
+/* This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at http://mozilla.org/MPL/2.0/. */
+
+package components
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+
+	graph "gopkg.in/r3labs/graph.v2"
+)
+
+// ECSLoadBalancer ...
+type ECSLoadBalancer struct {
+	ELB           string `json:"elb"`
+	ELBName       string `json:"elb_name"`
+	ContainerName string `json:"container_name"`
+	ContainerPort int    `json:"container_port"`
+}
+
+// ECSService : mapping of an ecs service component
+type ECSService struct {
+	ProviderType        string            `json:"_provider"`
+	ComponentType       string            `json:"_component"`
+	ComponentID         string            `json:"_component_id"`
+	State               string            `json:"_state"`
+	Action              string            `json:"_action"`
+	ARN                 string            `json:"service_arn"`
+	Name                string            `json:"name"`
+	Cluster             string            `json:"cluster"`
+	ClusterARN          string            `json:"cluster_arn"`
+	TaskDefinition      string            `json:"task_definition"`
+	TaskDefinitionARN   string            `json:"task_definition_arn"`
+	TaskDefinitionHash  string            `json:"task_definition_hash"`
+	LaunchType          string            `json:"launch_type"`
+	NetworkMode         string            `json:"network_mode"`
+	DesiredCount        int64             `json:"desired_count"`
+	Networks            []string          `json:"networks"`
+	NetworkAWSIDs       []string          `json:"network_aws_ids"`
+	SecurityGroups      []string          `json:"security_groups"`
+	SecurityGroupAWSIDs []string          `json:"security_group_aws_ids"`
+	AssignPublicIP      bool              `json:"assign_public_ip"`
+	LoadBalancers       []ECSLoadBalancer `json:"load_balancers"`
+	Tags                map[string]string `json:"tags"`
+	DatacenterType      string            `json:"datacenter_type,omitempty"`
+	DatacenterName      string            `json:"datacenter_name,omitempty"`
+	DatacenterRegion    string            `json:"datacenter_region"`
+	AccessKeyID         string            `json:"aws_access_key_id"`
+	SecretAccessKey     string            `json:"aws_secret_access_key"`
+	Service             string            `json:"service"`
+}
+
+// GetID : returns the component's ID
+func (e *ECSService) GetID() string {
+	return e.ComponentID
+}
+
+// GetName returns a components name
+func (e *ECSService) GetName() string {
+	return e.Name
+}
+
+// GetProvider : returns the provider type
+func (e *ECSService) GetProvider() string {
+	return e.ProviderType
+}
+
+// GetProviderID returns a components provider id
+func (e *ECSService) GetProviderID() string {
+	return e.ARN
+}
+
+// GetType : returns the type of the component
+func (e *ECSService) GetType() string {
+	return e.ComponentType
+}
+
+// GetState : returns the state of the component
+func (e *ECSService) GetState() string {
+	return e.State
+}
+
+// SetState : sets the state of the component
+func (e *ECSService) SetState(s string) {
+	e.State = s
+}
+
+// GetAction : returns the action of the component
+func (e *ECSService) GetAction() string {
+	return e.Action
+}
+
+// SetAction : Sets the action of the component
+func (e *ECSService) SetAction(s string) {
+	e.Action = s
+}
+
+// GetGroup : returns the components group
+func (e *ECSService) GetGroup() string {
+	return ""
+}
+
+// GetTags returns a components tags
+func (e *ECSService) GetTags() map[string]string {
+	return e.Tags
+}
+
+// GetTag returns a components tag
+func (e *ECSService) GetTag(tag string) string {
+	return e.Tags[tag]
+}
+
+// Diff : diff's the component against another component of the same type.
+// A changed task definition is rolled out by updating the service onto the
+// newly registered revision, rather than replacing the service.
+func (e *ECSService) Diff(c graph.Component) bool {
+	cs, ok := c.(*ECSService)
+	if ok {
+		if e.TaskDefinition != cs.TaskDefinition || e.TaskDefinitionHash != cs.TaskDefinitionHash {
+			return true
+		}
+
+		if e.DesiredCount != cs.DesiredCount || e.AssignPublicIP != cs.AssignPublicIP {
+			return true
+		}
+
+		if reflect.DeepEqual(e.Networks, cs.Networks) != true {
+			return true
+		}
+
+		return !reflect.DeepEqual(e.SecurityGroups, cs.SecurityGroups)
+	}
+
+	return false
+}
+
+// Update : updates the provider returned values of a component
+func (e *ECSService) Update(c graph.Component) {
+	cs, ok := c.(*ECSService)
+	if ok {
+		e.ARN = cs.ARN
+	}
+
+	e.SetDefaultVariables()
+}
+
+// Rebuild : rebuilds the component's internal state, such as templated values
+func (e *ECSService) Rebuild(g *graph.Graph) {
+	for _, c := range g.GetComponents().ByType(TYPEECSTASKDEFINITION) {
+		td, ok := c.(*ECSTaskDefinition)
+		if ok && td.Name == e.TaskDefinition {
+			if e.LaunchType == "" {
+				e.LaunchType = td.LaunchType
+			}
+
+			e.NetworkMode = td.NetworkMode
+			e.TaskDefinitionHash = td.Checksum()
+		}
+	}
+
+	if len(e.NetworkAWSIDs) > len(e.Networks) {
+		for _, nwid := range e.NetworkAWSIDs {
+			nw := g.GetComponents().ByProviderID(nwid)
+			if nw != nil {
+				e.Networks = append(e.Networks, nw.GetName())
+			}
+		}
+	}
+
+	if len(e.SecurityGroupAWSIDs) > len(e.SecurityGroups) {
+		for _, sgid := range e.SecurityGroupAWSIDs {
+			sg := g.GetComponents().ByProviderID(sgid)
+			if sg != nil {
+				e.SecurityGroups = append(e.SecurityGroups, sg.GetName())
+			}
+		}
+	}
+
+	applyReferences(e.References())
+
+	e.SetDefaultVariables()
+}
+
+// References : returns the references the component holds to other components
+func (e *ECSService) References() []Reference {
+	var refs []Reference
+
+	for i, sg := range e.SecurityGroups {
+		refs = append(refs, refSecurityGroupID(sg).at(&e.SecurityGroupAWSIDs, i))
+	}
+
+	for i, nw := range e.Networks {
+		refs = append(refs, refSubnetID(nw).at(&e.NetworkAWSIDs, i))
+	}
+
+	for x := range e.LoadBalancers {
+		refs = append(refs, refELBName(e.LoadBalancers[x].ELB).to(&e.LoadBalancers[x].ELBName))
+	}
+
+	refs = append(refs, refECSClusterARN(e.Cluster).to(&e.ClusterARN))
+	refs = append(refs, refECSTaskDefinitionARN(e.TaskDefinition).to(&e.TaskDefinitionARN))
+
+	return refs
+}
+
+// Dependencies : returns a list of component id's upon which the component depends
+func (e *ECSService) Dependencies() []string {
+	return dependencies(e.References())
+}
+
+// Validate : validates the components values
+func (e *ECSService) Validate() error {
+	if e.Name == "" {
+		return errors.New("ECS Service name should not be null")
+	}
+
+	if e.Cluster == "" {
+		return errors.New("ECS Service cluster should not be null")
+	}
+
+	if e.TaskDefinition == "" {
+		return errors.New("ECS Service task definition should not be null")
+	}
+
+	if e.LaunchType != LAUNCHTYPEFARGATE && e.LaunchType != LAUNCHTYPEEC2 {
+		return errors.New("ECS Service launch type must be one of fargate or ec2")
+	}
+
+	if e.DesiredCount < 0 {
+		return errors.New("ECS Service desired count should not be negative")
+	}
+
+	if e.NetworkMode == "awsvpc" && len(e.Networks) < 1 {
+		return errors.New("ECS Service should specify at least one network when the task definition uses awsvpc")
+	}
+
+	if e.NetworkMode != "awsvpc" && (len(e.Networks) > 0 || len(e.SecurityGroups) > 0) {
+		return errors.New("ECS Service should only specify networks and security groups when the task definition uses awsvpc")
+	}
+
+	if e.AssignPublicIP && e.LaunchType != LAUNCHTYPEFARGATE {
+		return errors.New("ECS Service can only assign a public ip when using the fargate launch type")
+	}
+
+	if len(e.SecurityGroups) != len(e.SecurityGroupAWSIDs) {
+		return errors.New("ECS Service security groups are incorrect")
+	}
+
+	for _, lb := range e.LoadBalancers {
+		if lb.ELB == "" {
+			return errors.New("ECS Service load balancer should specify an elb")
+		}
+
+		if lb.ContainerName == "" {
+			return fmt.Errorf("ECS Service load balancer (%s) should specify a container name", lb.ELB)
+		}
+
+		if lb.ContainerPort < 1 || lb.ContainerPort > 65535 {
+			return fmt.Errorf("ECS Service load balancer (%s) container port (%d) is out of range [1 - 65535]", lb.ELB, lb.ContainerPort)
+		}
+	}
+
+	return nil
+}
+
+// IsStateful : returns true if the component needs to be actioned to be removed.
+func (e *ECSService) IsStateful() bool {
+	return true
+}
+
+// SetDefaultVariables : sets up the default template variables for a component
+func (e *ECSService) SetDefaultVariables() {
+	e.ComponentType = TYPEECSSERVICE
+	e.ComponentID = TYPEECSSERVICE + TYPEDELIMITER + e.Name
+	e.ProviderType = PROVIDERTYPE
+	e.DatacenterName = DATACENTERNAME
+	e.DatacenterType = DATACENTERTYPE
+	e.DatacenterRegion = DATACENTERREGION
+	e.AccessKeyID = ACCESSKEYID
+	e.SecretAccessKey = SECRETACCESSKEY
+}
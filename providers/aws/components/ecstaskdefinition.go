@@ -0,0 +1,334 @@
+/* This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at http://mozilla.org/MPL/2.0/. */
+
+package components
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+
+	graph "gopkg.in/r3labs/graph.v2"
+)
+
+const (
+	// LAUNCHTYPEFARGATE : fargate launch type
+	LAUNCHTYPEFARGATE = "FARGATE"
+	// LAUNCHTYPEEC2 : ec2 launch type
+	LAUNCHTYPEEC2 = "EC2"
+)
+
+// ECSPortMapping ...
+type ECSPortMapping struct {
+	ContainerPort int    `json:"container_port"`
+	HostPort      int    `json:"host_port"`
+	Protocol      string `json:"protocol"`
+}
+
+// ECSLogConfiguration ...
+type ECSLogConfiguration struct {
+	Driver  string            `json:"driver"`
+	Options map[string]string `json:"options"`
+}
+
+// ECSContainerDefinition ...
+type ECSContainerDefinition struct {
+	Name              string               `json:"name"`
+	Image             string               `json:"image"`
+	CPU               int64                `json:"cpu"`
+	Memory            int64                `json:"memory"`
+	MemoryReservation int64                `json:"memory_reservation"`
+	Essential         bool                 `json:"essential"`
+	Command           []string             `json:"command"`
+	PortMappings      []ECSPortMapping     `json:"port_mappings"`
+	Environment       map[string]string    `json:"environment"`
+	LogConfiguration  *ECSLogConfiguration `json:"log_configuration,omitempty"`
+}
+
+// ECSTaskDefinition : mapping of an ecs task definition component
+type ECSTaskDefinition struct {
+	ProviderType     string                   `json:"_provider"`
+	ComponentType    string                   `json:"_component"`
+	ComponentID      string                   `json:"_component_id"`
+	State            string                   `json:"_state"`
+	Action           string                   `json:"_action"`
+	ARN              string                   `json:"task_definition_arn"`
+	Revision         int64                    `json:"revision"`
+	Name             string                   `json:"name"`
+	LaunchType       string                   `json:"launch_type"`
+	NetworkMode      string                   `json:"network_mode"`
+	CPU              int64                    `json:"cpu"`
+	Memory           int64                    `json:"memory"`
+	ExecutionRoleARN string                   `json:"execution_role_arn,omitempty"`
+	TaskRoleARN      string                   `json:"task_role_arn,omitempty"`
+	Containers       []ECSContainerDefinition `json:"containers"`
+	Tags             map[string]string        `json:"tags"`
+	DatacenterType   string                   `json:"datacenter_type,omitempty"`
+	DatacenterName   string                   `json:"datacenter_name,omitempty"`
+	DatacenterRegion string                   `json:"datacenter_region"`
+	AccessKeyID      string                   `json:"aws_access_key_id"`
+	SecretAccessKey  string                   `json:"aws_secret_access_key"`
+	Service          string                   `json:"service"`
+}
+
+// GetID : returns the component's ID
+func (t *ECSTaskDefinition) GetID() string {
+	return t.ComponentID
+}
+
+// GetName returns a components name
+func (t *ECSTaskDefinition) GetName() string {
+	return t.Name
+}
+
+// GetProvider : returns the provider type
+func (t *ECSTaskDefinition) GetProvider() string {
+	return t.ProviderType
+}
+
+// GetProviderID returns a components provider id
+func (t *ECSTaskDefinition) GetProviderID() string {
+	return t.ARN
+}
+
+// GetType : returns the type of the component
+func (t *ECSTaskDefinition) GetType() string {
+	return t.ComponentType
+}
+
+// GetState : returns the state of the component
+func (t *ECSTaskDefinition) GetState() string {
+	return t.State
+}
+
+// SetState : sets the state of the component
+func (t *ECSTaskDefinition) SetState(s string) {
+	t.State = s
+}
+
+// GetAction : returns the action of the component
+func (t *ECSTaskDefinition) GetAction() string {
+	return t.Action
+}
+
+// SetAction : Sets the action of the component
+func (t *ECSTaskDefinition) SetAction(s string) {
+	t.Action = s
+}
+
+// GetGroup : returns the components group
+func (t *ECSTaskDefinition) GetGroup() string {
+	return ""
+}
+
+// GetTags returns a components tags
+func (t *ECSTaskDefinition) GetTags() map[string]string {
+	return t.Tags
+}
+
+// GetTag returns a components tag
+func (t *ECSTaskDefinition) GetTag(tag string) string {
+	return t.Tags[tag]
+}
+
+// Diff : diff's the component against another component of the same type.
+// Task definitions are immutable, so an update registers a new revision of
+// the task definition family.
+func (t *ECSTaskDefinition) Diff(c graph.Component) bool {
+	ct, ok := c.(*ECSTaskDefinition)
+	if ok {
+		return t.Checksum() != ct.Checksum()
+	}
+
+	return false
+}
+
+// Update : updates the provider returned values of a component
+func (t *ECSTaskDefinition) Update(c graph.Component) {
+	ct, ok := c.(*ECSTaskDefinition)
+	if ok {
+		t.ARN = ct.ARN
+		t.Revision = ct.Revision
+	}
+
+	t.SetDefaultVariables()
+}
+
+// Rebuild : rebuilds the component's internal state, such as templated values
+func (t *ECSTaskDefinition) Rebuild(g *graph.Graph) {
+	if t.LaunchType == "" {
+		t.LaunchType = LAUNCHTYPEEC2
+	}
+
+	if t.NetworkMode == "" {
+		t.NetworkMode = "bridge"
+		if t.LaunchType == LAUNCHTYPEFARGATE {
+			t.NetworkMode = "awsvpc"
+		}
+	}
+
+	for i := 0; i < len(t.Containers); i++ {
+		for x := 0; x < len(t.Containers[i].PortMappings); x++ {
+			if t.Containers[i].PortMappings[x].Protocol == "" {
+				t.Containers[i].PortMappings[x].Protocol = PROTOCOLTCP
+			}
+		}
+	}
+
+	t.SetDefaultVariables()
+}
+
+// References : returns the references the component holds to other components
+func (t *ECSTaskDefinition) References() []Reference {
+	return nil
+}
+
+// Dependencies : returns a list of component id's upon which the component depends
+func (t *ECSTaskDefinition) Dependencies() []string {
+	return dependencies(t.References())
+}
+
+// Validate : validates the components values
+func (t *ECSTaskDefinition) Validate() error {
+	var drivers = []string{"awslogs", "awsfirelens", "json-file", "syslog", "journald", "gelf", "fluentd", "splunk"}
+
+	if t.Name == "" {
+		return errors.New("ECS Task Definition name should not be null")
+	}
+
+	if t.LaunchType != LAUNCHTYPEFARGATE && t.LaunchType != LAUNCHTYPEEC2 {
+		return errors.New("ECS Task Definition launch type must be one of fargate or ec2")
+	}
+
+	if isOneOf([]string{"awsvpc", "bridge", "host", "none"}, t.NetworkMode) != true {
+		return errors.New("ECS Task Definition network mode must be one of awsvpc, bridge, host or none")
+	}
+
+	if t.LaunchType == LAUNCHTYPEFARGATE {
+		if t.NetworkMode != "awsvpc" {
+			return errors.New("ECS Task Definition network mode must be awsvpc when using the fargate launch type")
+		}
+
+		err := validateFargateSize(t.CPU, t.Memory)
+		if err != nil {
+			return err
+		}
+	}
+
+	if len(t.Containers) < 1 {
+		return errors.New("ECS Task Definition should specify at least one container")
+	}
+
+	names := make(map[string]bool)
+
+	for _, container := range t.Containers {
+		if container.Name == "" {
+			return errors.New("ECS Task Definition container name should not be null")
+		}
+
+		if names[container.Name] {
+			return fmt.Errorf("ECS Task Definition container name (%s) is not unique", container.Name)
+		}
+
+		names[container.Name] = true
+
+		if container.Image == "" {
+			return fmt.Errorf("ECS Task Definition container (%s) image should not be null", container.Name)
+		}
+
+		if t.Memory == 0 && container.Memory == 0 && container.MemoryReservation == 0 {
+			return fmt.Errorf("ECS Task Definition container (%s) should specify memory if the task does not", container.Name)
+		}
+
+		for _, pm := range container.PortMappings {
+			if pm.ContainerPort < 1 || pm.ContainerPort > 65535 {
+				return fmt.Errorf("ECS Task Definition container (%s) port (%d) is out of range [1 - 65535]", container.Name, pm.ContainerPort)
+			}
+
+			err := validatePort(pm.HostPort, "ECS Task Definition Host")
+			if err != nil {
+				return err
+			}
+
+			if t.NetworkMode == "awsvpc" && pm.HostPort != 0 && pm.HostPort != pm.ContainerPort {
+				return fmt.Errorf("ECS Task Definition container (%s) host port must match the container port when using awsvpc", container.Name)
+			}
+
+			if pm.Protocol != PROTOCOLTCP && pm.Protocol != PROTOCOLUDP {
+				return fmt.Errorf("ECS Task Definition container (%s) port protocol must be one of tcp or udp", container.Name)
+			}
+		}
+
+		if container.LogConfiguration != nil && isOneOf(drivers, container.LogConfiguration.Driver) != true {
+			return fmt.Errorf("ECS Task Definition container (%s) log driver must be one of %s", container.Name, strings.Join(drivers, ", "))
+		}
+
+		if t.LaunchType == LAUNCHTYPEFARGATE && container.LogConfiguration != nil {
+			if isOneOf([]string{"awslogs", "awsfirelens", "splunk"}, container.LogConfiguration.Driver) != true {
+				return fmt.Errorf("ECS Task Definition container (%s) log driver is not supported by fargate", container.Name)
+			}
+		}
+	}
+
+	return nil
+}
+
+// IsStateful : returns true if the component needs to be actioned to be removed.
+func (t *ECSTaskDefinition) IsStateful() bool {
+	return true
+}
+
+// SetDefaultVariables : sets up the default template variables for a component
+func (t *ECSTaskDefinition) SetDefaultVariables() {
+	t.ComponentType = TYPEECSTASKDEFINITION
+	t.ComponentID = TYPEECSTASKDEFINITION + TYPEDELIMITER + t.Name
+	t.ProviderType = PROVIDERTYPE
+	t.DatacenterName = DATACENTERNAME
+	t.DatacenterType = DATACENTERTYPE
+	t.DatacenterRegion = DATACENTERREGION
+	t.AccessKeyID = ACCESSKEYID
+	t.SecretAccessKey = SECRETACCESSKEY
+}
+
+// Checksum : returns a checksum of the values that make up a task definition revision
+func (t *ECSTaskDefinition) Checksum() string {
+	data, _ := json.Marshal(struct {
+		LaunchType       string
+		NetworkMode      string
+		CPU              int64
+		Memory           int64
+		ExecutionRoleARN string
+		TaskRoleARN      string
+		Containers       []ECSContainerDefinition
+	}{t.LaunchType, t.NetworkMode, t.CPU, t.Memory, t.ExecutionRoleARN, t.TaskRoleARN, t.Containers})
+
+	sum := sha256.Sum256(data)
+
+	return hex.EncodeToString(sum[:])
+}
+
+// validateFargateSize checks the task cpu and memory are a supported fargate combination
+func validateFargateSize(cpu, memory int64) error {
+	var sizes = map[int64][2]int64{
+		256:  {512, 2048},
+		512:  {1024, 4096},
+		1024: {2048, 8192},
+		2048: {4096, 16384},
+		4096: {8192, 30720},
+	}
+
+	size, ok := sizes[cpu]
+	if ok != true {
+		return errors.New("ECS Task Definition cpu must be one of 256, 512, 1024, 2048 or 4096 when using the fargate launch type")
+	}
+
+	if memory < size[0] || memory > size[1] || (memory != 512 && memory%1024 != 0) {
+		return fmt.Errorf("ECS Task Definition memory for %d cpu units must be between %d and %d in 1GB increments when using the fargate launch type", cpu, size[0], size[1])
+	}
+
+	return nil
+}
@@ -0,0 +1,275 @@
+/* This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at http://mozilla.org/MPL/2.0/. */
+
+package components
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"reflect"
+	"strings"
+	"unicode"
+
+	graph "gopkg.in/r3labs/graph.v2"
+)
+
+// EKSCluster : mapping of an eks cluster component
+type EKSCluster struct {
+	ProviderType         string            `json:"_provider"`
+	ComponentType        string            `json:"_component"`
+	ComponentID          string            `json:"_component_id"`
+	State                string            `json:"_state"`
+	Action               string            `json:"_action"`
+	ClusterARN           string            `json:"cluster_arn"`
+	Endpoint             string            `json:"endpoint"`
+	CertificateAuthority string            `json:"certificate_authority"`
+	Name                 string            `json:"name"`
+	Version              string            `json:"version"`
+	RoleARN              string            `json:"role_arn"`
+	Networks             []string          `json:"networks"`
+	NetworkAWSIDs        []string          `json:"network_aws_ids"`
+	AvailabilityZones    []string          `json:"availability_zones"`
+	SecurityGroups       []string          `json:"security_groups"`
+	SecurityGroupAWSIDs  []string          `json:"security_group_aws_ids"`
+	PrivateAccess        bool              `json:"endpoint_private_access"`
+	PublicAccess         bool              `json:"endpoint_public_access"`
+	PublicAccessCIDRs    []string          `json:"public_access_cidrs"`
+	Tags                 map[string]string `json:"tags"`
+	DatacenterType       string            `json:"datacenter_type,omitempty"`
+	DatacenterName       string            `json:"datacenter_name,omitempty"`
+	DatacenterRegion     string            `json:"datacenter_region"`
+	AccessKeyID          string            `json:"aws_access_key_id"`
+	SecretAccessKey      string            `json:"aws_secret_access_key"`
+	Service              string            `json:"service"`
+}
+
+// GetID : returns the component's ID
+func (e *EKSCluster) GetID() string {
+	return e.ComponentID
+}
+
+// GetName returns a components name
+func (e *EKSCluster) GetName() string {
+	return e.Name
+}
+
+// GetProvider : returns the provider type
+func (e *EKSCluster) GetProvider() string {
+	return e.ProviderType
+}
+
+// GetProviderID returns a components provider id
+func (e *EKSCluster) GetProviderID() string {
+	return e.ClusterARN
+}
+
+// GetType : returns the type of the component
+func (e *EKSCluster) GetType() string {
+	return e.ComponentType
+}
+
+// GetState : returns the state of the component
+func (e *EKSCluster) GetState() string {
+	return e.State
+}
+
+// SetState : sets the state of the component
+func (e *EKSCluster) SetState(s string) {
+	e.State = s
+}
+
+// GetAction : returns the action of the component
+func (e *EKSCluster) GetAction() string {
+	return e.Action
+}
+
+// SetAction : Sets the action of the component
+func (e *EKSCluster) SetAction(s string) {
+	e.Action = s
+}
+
+// GetGroup : returns the components group
+func (e *EKSCluster) GetGroup() string {
+	return ""
+}
+
+// GetTags returns a components tags
+func (e *EKSCluster) GetTags() map[string]string {
+	return e.Tags
+}
+
+// GetTag returns a components tag
+func (e *EKSCluster) GetTag(tag string) string {
+	return e.Tags[tag]
+}
+
+// Diff : diff's the component against another component of the same type
+func (e *EKSCluster) Diff(c graph.Component) bool {
+	ce, ok := c.(*EKSCluster)
+	if ok {
+		if e.Version != ce.Version ||
+			e.RoleARN != ce.RoleARN ||
+			e.PrivateAccess != ce.PrivateAccess ||
+			e.PublicAccess != ce.PublicAccess {
+			return true
+		}
+
+		if reflect.DeepEqual(e.PublicAccessCIDRs, ce.PublicAccessCIDRs) != true {
+			return true
+		}
+
+		if reflect.DeepEqual(e.Networks, ce.Networks) != true {
+			return true
+		}
+
+		return !reflect.DeepEqual(e.SecurityGroups, ce.SecurityGroups)
+	}
+
+	return false
+}
+
+// Update : updates the provider returned values of a component
+func (e *EKSCluster) Update(c graph.Component) {
+	ce, ok := c.(*EKSCluster)
+	if ok {
+		e.ClusterARN = ce.ClusterARN
+		e.Endpoint = ce.Endpoint
+		e.CertificateAuthority = ce.CertificateAuthority
+	}
+
+	e.SetDefaultVariables()
+}
+
+// Rebuild : rebuilds the component's internal state, such as templated values
+func (e *EKSCluster) Rebuild(g *graph.Graph) {
+	if len(e.NetworkAWSIDs) > len(e.Networks) {
+		for _, nwid := range e.NetworkAWSIDs {
+			nw := g.GetComponents().ByProviderID(nwid)
+			if nw != nil {
+				e.Networks = append(e.Networks, nw.GetName())
+			}
+		}
+	}
+
+	if len(e.SecurityGroupAWSIDs) > len(e.SecurityGroups) {
+		for _, sgid := range e.SecurityGroupAWSIDs {
+			sg := g.GetComponents().ByProviderID(sgid)
+			if sg != nil {
+				e.SecurityGroups = append(e.SecurityGroups, sg.GetName())
+			}
+		}
+	}
+
+	e.AvailabilityZones = []string{}
+	for _, c := range g.GetComponents().ByType(TYPENETWORK) {
+		nw, ok := c.(*Network)
+		if ok && isOneOf(e.Networks, nw.Name) && nw.AvailabilityZone != "" {
+			e.AvailabilityZones = appendUnique(e.AvailabilityZones, nw.AvailabilityZone)
+		}
+	}
+
+	if e.PublicAccess && len(e.PublicAccessCIDRs) < 1 {
+		e.PublicAccessCIDRs = []string{"0.0.0.0/0"}
+	}
+
+	applyReferences(e.References())
+
+	e.SetDefaultVariables()
+}
+
+// References : returns the references the component holds to other components
+func (e *EKSCluster) References() []Reference {
+	var refs []Reference
+
+	for i, sg := range e.SecurityGroups {
+		refs = append(refs, refSecurityGroupID(sg).at(&e.SecurityGroupAWSIDs, i))
+	}
+
+	for i, nw := range e.Networks {
+		refs = append(refs, refSubnetID(nw).at(&e.NetworkAWSIDs, i))
+	}
+
+	return refs
+}
+
+// Dependencies : returns a list of component id's upon which the component depends
+func (e *EKSCluster) Dependencies() []string {
+	return dependencies(e.References())
+}
+
+// Validate : validates the components values
+func (e *EKSCluster) Validate() error {
+	if e.Name == "" {
+		return errors.New("EKS Cluster name should not be null")
+	}
+
+	if len(e.Name) > 100 {
+		return errors.New("EKS Cluster name should not exceed 100 characters")
+	}
+
+	for _, c := range e.Name {
+		if unicode.IsLetter(c) != true && unicode.IsDigit(c) != true && c != '-' && c != '_' {
+			return errors.New("EKS Cluster name can only contain alphanumeric characters, hyphens and underscores")
+		}
+	}
+
+	if e.Version != "" && strings.HasPrefix(e.Version, "1.") != true {
+		return fmt.Errorf("EKS Cluster version (%s) is not valid, i.e. '1.14'", e.Version)
+	}
+
+	if strings.HasPrefix(e.RoleARN, "arn:aws:iam::") != true {
+		return errors.New("EKS Cluster should specify a valid role arn")
+	}
+
+	if len(e.Networks) != len(e.NetworkAWSIDs) {
+		return errors.New("EKS Cluster networks are incorrect")
+	}
+
+	if len(e.SecurityGroups) != len(e.SecurityGroupAWSIDs) {
+		return errors.New("EKS Cluster security groups are incorrect")
+	}
+
+	if len(e.SecurityGroups) > 5 {
+		return errors.New("EKS Cluster should not specify more than 5 security groups")
+	}
+
+	if len(e.AvailabilityZones) < 2 {
+		return errors.New("EKS Cluster networks should span at least two availability zones")
+	}
+
+	if e.PrivateAccess != true && e.PublicAccess != true {
+		return errors.New("EKS Cluster endpoint should allow either private or public access")
+	}
+
+	if e.PublicAccess != true && len(e.PublicAccessCIDRs) > 0 {
+		return errors.New("EKS Cluster public access cidrs are only valid when public access is enabled")
+	}
+
+	for _, cidr := range e.PublicAccessCIDRs {
+		_, _, err := net.ParseCIDR(cidr)
+		if err != nil {
+			return fmt.Errorf("EKS Cluster public access cidr (%s) is not valid", cidr)
+		}
+	}
+
+	return nil
+}
+
+// IsStateful : returns true if the component needs to be actioned to be removed.
+func (e *EKSCluster) IsStateful() bool {
+	return true
+}
+
+// SetDefaultVariables : sets up the default template variables for a component
+func (e *EKSCluster) SetDefaultVariables() {
+	e.ComponentType = TYPEEKSCLUSTER
+	e.ComponentID = TYPEEKSCLUSTER + TYPEDELIMITER + e.Name
+	e.ProviderType = PROVIDERTYPE
+	e.DatacenterName = DATACENTERNAME
+	e.DatacenterType = DATACENTERTYPE
+	e.DatacenterRegion = DATACENTERREGION
+	e.AccessKeyID = ACCESSKEYID
+	e.SecretAccessKey = SECRETACCESSKEY
+}
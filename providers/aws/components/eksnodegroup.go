@@ -0,0 +1,271 @@
+/* This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at http://mozilla.org/MPL/2.0/. */
+
+package components
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+
+	graph "gopkg.in/r3labs/graph.v2"
+)
+
+// EKSTaint ...
+type EKSTaint struct {
+	Key    string `json:"key"`
+	Value  string `json:"value"`
+	Effect string `json:"effect"`
+}
+
+// EKSNodeGroup : mapping of an eks managed node group component
+type EKSNodeGroup struct {
+	ProviderType     string            `json:"_provider"`
+	ComponentType    string            `json:"_component"`
+	ComponentID      string            `json:"_component_id"`
+	State            string            `json:"_state"`
+	Action           string            `json:"_action"`
+	NodeGroupARN     string            `json:"node_group_arn"`
+	Name             string            `json:"name"`
+	Cluster          string            `json:"cluster"`
+	ClusterName      string            `json:"cluster_name"`
+	RoleARN          string            `json:"node_role_arn"`
+	InstanceTypes    []string          `json:"instance_types"`
+	DiskSize         *int64            `json:"disk_size,omitempty"`
+	MinSize          int64             `json:"min_size"`
+	MaxSize          int64             `json:"max_size"`
+	DesiredSize      int64             `json:"desired_size"`
+	Networks         []string          `json:"networks"`
+	NetworkAWSIDs    []string          `json:"network_aws_ids"`
+	Labels           map[string]string `json:"labels"`
+	Taints           []EKSTaint        `json:"taints"`
+	Tags             map[string]string `json:"tags"`
+	DatacenterType   string            `json:"datacenter_type,omitempty"`
+	DatacenterName   string            `json:"datacenter_name,omitempty"`
+	DatacenterRegion string            `json:"datacenter_region"`
+	AccessKeyID      string            `json:"aws_access_key_id"`
+	SecretAccessKey  string            `json:"aws_secret_access_key"`
+	Service          string            `json:"service"`
+}
+
+// GetID : returns the component's ID
+func (e *EKSNodeGroup) GetID() string {
+	return e.ComponentID
+}
+
+// GetName returns a components name
+func (e *EKSNodeGroup) GetName() string {
+	return e.Name
+}
+
+// GetProvider : returns the provider type
+func (e *EKSNodeGroup) GetProvider() string {
+	return e.ProviderType
+}
+
+// GetProviderID returns a components provider id
+func (e *EKSNodeGroup) GetProviderID() string {
+	return e.NodeGroupARN
+}
+
+// GetType : returns the type of the component
+func (e *EKSNodeGroup) GetType() string {
+	return e.ComponentType
+}
+
+// GetState : returns the state of the component
+func (e *EKSNodeGroup) GetState() string {
+	return e.State
+}
+
+// SetState : sets the state of the component
+func (e *EKSNodeGroup) SetState(s string) {
+	e.State = s
+}
+
+// GetAction : returns the action of the component
+func (e *EKSNodeGroup) GetAction() string {
+	return e.Action
+}
+
+// SetAction : Sets the action of the component
+func (e *EKSNodeGroup) SetAction(s string) {
+	e.Action = s
+}
+
+// GetGroup : returns the components group
+func (e *EKSNodeGroup) GetGroup() string {
+	return ""
+}
+
+// GetTags returns a components tags
+func (e *EKSNodeGroup) GetTags() map[string]string {
+	return e.Tags
+}
+
+// GetTag returns a components tag
+func (e *EKSNodeGroup) GetTag(tag string) string {
+	return e.Tags[tag]
+}
+
+// Diff : diff's the component against another component of the same type
+func (e *EKSNodeGroup) Diff(c graph.Component) bool {
+	ce, ok := c.(*EKSNodeGroup)
+	if ok {
+		if e.Cluster != ce.Cluster ||
+			e.RoleARN != ce.RoleARN ||
+			e.MinSize != ce.MinSize ||
+			e.MaxSize != ce.MaxSize ||
+			e.DesiredSize != ce.DesiredSize {
+			return true
+		}
+
+		if reflect.DeepEqual(e.DiskSize, ce.DiskSize) != true ||
+			reflect.DeepEqual(e.InstanceTypes, ce.InstanceTypes) != true ||
+			reflect.DeepEqual(e.Networks, ce.Networks) != true {
+			return true
+		}
+
+		if reflect.DeepEqual(e.Labels, ce.Labels) != true {
+			return true
+		}
+
+		return !reflect.DeepEqual(e.Taints, ce.Taints)
+	}
+
+	return false
+}
+
+// Update : updates the provider returned values of a component
+func (e *EKSNodeGroup) Update(c graph.Component) {
+	ce, ok := c.(*EKSNodeGroup)
+	if ok {
+		e.NodeGroupARN = ce.NodeGroupARN
+	}
+
+	e.SetDefaultVariables()
+}
+
+// Rebuild : rebuilds the component's internal state, such as templated values
+func (e *EKSNodeGroup) Rebuild(g *graph.Graph) {
+	if len(e.NetworkAWSIDs) > len(e.Networks) {
+		for _, nwid := range e.NetworkAWSIDs {
+			nw := g.GetComponents().ByProviderID(nwid)
+			if nw != nil {
+				e.Networks = append(e.Networks, nw.GetName())
+			}
+		}
+	}
+
+	if e.DesiredSize == 0 {
+		e.DesiredSize = e.MinSize
+	}
+
+	applyReferences(e.References())
+
+	e.SetDefaultVariables()
+}
+
+// References : returns the references the component holds to other components
+func (e *EKSNodeGroup) References() []Reference {
+	var refs []Reference
+
+	for i, nw := range e.Networks {
+		refs = append(refs, refSubnetID(nw).at(&e.NetworkAWSIDs, i))
+	}
+
+	refs = append(refs, refEKSClusterName(e.Cluster).to(&e.ClusterName))
+
+	return refs
+}
+
+// Dependencies : returns a list of component id's upon which the component depends
+func (e *EKSNodeGroup) Dependencies() []string {
+	return dependencies(e.References())
+}
+
+// Validate : validates the components values
+func (e *EKSNodeGroup) Validate() error {
+	var effects = []string{"NO_SCHEDULE", "NO_EXECUTE", "PREFER_NO_SCHEDULE"}
+
+	if e.Name == "" {
+		return errors.New("EKS Node Group name should not be null")
+	}
+
+	if e.Cluster == "" {
+		return errors.New("EKS Node Group cluster should not be null")
+	}
+
+	if strings.HasPrefix(e.RoleARN, "arn:aws:iam::") != true {
+		return errors.New("EKS Node Group should specify a valid node role arn")
+	}
+
+	if len(e.InstanceTypes) < 1 {
+		return errors.New("EKS Node Group should specify at least one instance type")
+	}
+
+	if len(e.Networks) < 1 {
+		return errors.New("EKS Node Group should specify at least one network")
+	}
+
+	if len(e.Networks) != len(e.NetworkAWSIDs) {
+		return errors.New("EKS Node Group networks are incorrect")
+	}
+
+	if e.MaxSize < 1 {
+		return errors.New("EKS Node Group max size should be at least 1")
+	}
+
+	if e.MinSize < 0 || e.MinSize > e.MaxSize {
+		return errors.New("EKS Node Group min size should be between 0 and the max size")
+	}
+
+	if e.DesiredSize < e.MinSize || e.DesiredSize > e.MaxSize {
+		return errors.New("EKS Node Group desired size should be between the min and max size")
+	}
+
+	if e.DiskSize != nil && (*e.DiskSize < 1 || *e.DiskSize > 16384) {
+		return errors.New("EKS Node Group disk size should be between 1 - 16384 (GB)")
+	}
+
+	if len(e.Taints) > 50 {
+		return errors.New("EKS Node Group should not specify more than 50 taints")
+	}
+
+	for _, t := range e.Taints {
+		if t.Key == "" {
+			return errors.New("EKS Node Group taint key should not be null")
+		}
+
+		if isOneOf(effects, t.Effect) != true {
+			return fmt.Errorf("EKS Node Group taint (%s) effect should be one of %s", t.Key, strings.Join(effects, ", "))
+		}
+	}
+
+	for k := range e.Labels {
+		if k == "" || len(k) > 63 {
+			return errors.New("EKS Node Group label keys should be between 1 and 63 characters")
+		}
+	}
+
+	return nil
+}
+
+// IsStateful : returns true if the component needs to be actioned to be removed.
+func (e *EKSNodeGroup) IsStateful() bool {
+	return true
+}
+
+// SetDefaultVariables : sets up the default template variables for a component
+func (e *EKSNodeGroup) SetDefaultVariables() {
+	e.ComponentType = TYPEEKSNODEGROUP
+	e.ComponentID = TYPEEKSNODEGROUP + TYPEDELIMITER + e.Name
+	e.ProviderType = PROVIDERTYPE
+	e.DatacenterName = DATACENTERNAME
+	e.DatacenterType = DATACENTERTYPE
+	e.DatacenterRegion = DATACENTERREGION
+	e.AccessKeyID = ACCESSKEYID
+	e.SecretAccessKey = SECRETACCESSKEY
+}
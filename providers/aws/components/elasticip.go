@@ -0,0 +1,193 @@
+/* This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at http://mozilla.org/MPL/2.0/. */
+
+package components
+
+import (
+	"errors"
+	"strings"
+
+	graph "gopkg.in/r3labs/graph.v2"
+)
+
+// ElasticIP : mapping of an elastic ip component
+type ElasticIP struct {
+	ProviderType     string            `json:"_provider"`
+	ComponentType    string            `json:"_component"`
+	ComponentID      string            `json:"_component_id"`
+	State            string            `json:"_state"`
+	Action           string            `json:"_action"`
+	ElasticIPAWSID   string            `json:"elastic_ip_aws_id"`
+	AssociationID    string            `json:"association_id"`
+	Name             string            `json:"name"`
+	IP               string            `json:"ip"`
+	Instance         string            `json:"instance,omitempty"`
+	InstanceAWSID    string            `json:"instance_aws_id,omitempty"`
+	Tags             map[string]string `json:"tags"`
+	DatacenterType   string            `json:"datacenter_type,omitempty"`
+	DatacenterName   string            `json:"datacenter_name,omitempty"`
+	DatacenterRegion string            `json:"datacenter_region"`
+	AccessKeyID      string            `json:"aws_access_key_id"`
+	SecretAccessKey  string            `json:"aws_secret_access_key"`
+	Service          string            `json:"service"`
+}
+
+// GetID : returns the component's ID
+func (e *ElasticIP) GetID() string {
+	return e.ComponentID
+}
+
+// GetName returns a components name
+func (e *ElasticIP) GetName() string {
+	return e.Name
+}
+
+// GetProvider : returns the provider type
+func (e *ElasticIP) GetProvider() string {
+	return e.ProviderType
+}
+
+// GetProviderID returns a components provider id
+func (e *ElasticIP) GetProviderID() string {
+	return e.ElasticIPAWSID
+}
+
+// GetType : returns the type of the component
+func (e *ElasticIP) GetType() string {
+	return e.ComponentType
+}
+
+// GetState : returns the state of the component
+func (e *ElasticIP) GetState() string {
+	return e.State
+}
+
+// SetState : sets the state of the component
+func (e *ElasticIP) SetState(s string) {
+	e.State = s
+}
+
+// GetAction : returns the action of the component
+func (e *ElasticIP) GetAction() string {
+	return e.Action
+}
+
+// SetAction : Sets the action of the component
+func (e *ElasticIP) SetAction(s string) {
+	e.Action = s
+}
+
+// GetGroup : returns the components group
+func (e *ElasticIP) GetGroup() string {
+	return e.Tags[GROUPELASTICIP]
+}
+
+// GetTags returns a components tags
+func (e *ElasticIP) GetTags() map[string]string {
+	return e.Tags
+}
+
+// GetTag returns a components tag
+func (e *ElasticIP) GetTag(tag string) string {
+	return e.Tags[tag]
+}
+
+// Diff : diff's the component against another component of the same type
+func (e *ElasticIP) Diff(c graph.Component) bool {
+	ce, ok := c.(*ElasticIP)
+	if ok {
+		if e.Instance != ce.Instance {
+			return true
+		}
+
+		// the associated instance has been replaced
+		return isResolved(e.InstanceAWSID) && isResolved(ce.InstanceAWSID) && e.InstanceAWSID != ce.InstanceAWSID
+	}
+
+	return false
+}
+
+// Update : updates the provider returned values of a component
+func (e *ElasticIP) Update(c graph.Component) {
+	ce, ok := c.(*ElasticIP)
+	if ok {
+		e.ElasticIPAWSID = ce.ElasticIPAWSID
+		e.AssociationID = ce.AssociationID
+		e.IP = ce.IP
+	}
+
+	e.SetDefaultVariables()
+}
+
+// Rebuild : rebuilds the component's internal state, such as templated values
+func (e *ElasticIP) Rebuild(g *graph.Graph) {
+	if e.Instance == "" && e.InstanceAWSID != "" {
+		for _, c := range g.GetComponents().ByType(TYPEINSTANCE) {
+			i, ok := c.(*Instance)
+			if ok && i.InstanceAWSID == e.InstanceAWSID {
+				e.Instance = i.Name
+			}
+		}
+	}
+
+	// an instance that is being created or replaced does not have the
+	// id held by the elastic ip, so it is templated again
+	for _, c := range g.GetComponents().ByType(TYPEINSTANCE) {
+		i, ok := c.(*Instance)
+		if ok && i.Name == e.Instance && i.InstanceAWSID != e.InstanceAWSID {
+			e.InstanceAWSID = ""
+		}
+	}
+
+	applyReferences(e.References())
+
+	e.SetDefaultVariables()
+}
+
+// References : returns the references the component holds to other components.
+// Nat gateways reference the elastic ip they are allocated, so they are not referenced here
+func (e *ElasticIP) References() []Reference {
+	var refs []Reference
+
+	if e.Instance != "" {
+		refs = append(refs, refInstanceID(e.Instance).to(&e.InstanceAWSID))
+	}
+
+	return refs
+}
+
+// Dependencies : returns a list of component id's upon which the component depends
+func (e *ElasticIP) Dependencies() []string {
+	return dependencies(e.References())
+}
+
+// Validate : validates the components values
+func (e *ElasticIP) Validate() error {
+	if e.Name == "" {
+		return errors.New("Elastic IP name should not be null")
+	}
+
+	if e.ElasticIPAWSID != "" && strings.HasPrefix(e.ElasticIPAWSID, "eipalloc-") != true {
+		return errors.New("Elastic IP id should be a valid allocation id, i.e. 'eipalloc-12345678'")
+	}
+
+	return nil
+}
+
+// IsStateful : returns true if the component needs to be actioned to be removed.
+func (e *ElasticIP) IsStateful() bool {
+	return true
+}
+
+// SetDefaultVariables : sets up the default template variables for a component
+func (e *ElasticIP) SetDefaultVariables() {
+	e.ComponentType = TYPEELASTICIP
+	e.ComponentID = TYPEELASTICIP + TYPEDELIMITER + e.Name
+	e.ProviderType = PROVIDERTYPE
+	e.DatacenterName = DATACENTERNAME
+	e.DatacenterType = DATACENTERTYPE
+	e.DatacenterRegion = DATACENTERREGION
+	e.AccessKeyID = ACCESSKEYID
+	e.SecretAccessKey = SECRETACCESSKEY
+}
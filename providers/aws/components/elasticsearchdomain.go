@@ -0,0 +1,345 @@
+/* This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at http://mozilla.org/MPL/2.0/. */
+
+package components
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+	"unicode"
+
+	graph "gopkg.in/r3labs/graph.v2"
+)
+
+// ElasticsearchDomain : mapping of an elasticsearch domain component
+type ElasticsearchDomain struct {
+	ProviderType           string            `json:"_provider"`
+	ComponentType          string            `json:"_component"`
+	ComponentID            string            `json:"_component_id"`
+	State                  string            `json:"_state"`
+	Action                 string            `json:"_action"`
+	DomainARN              string            `json:"domain_arn"`
+	DomainID               string            `json:"domain_id"`
+	Endpoint               string            `json:"endpoint"`
+	Name                   string            `json:"name"`
+	Version                string            `json:"elasticsearch_version"`
+	InstanceType           string            `json:"instance_type"`
+	InstanceCount          int64             `json:"instance_count"`
+	DedicatedMasterEnabled bool              `json:"dedicated_master_enabled"`
+	DedicatedMasterType    string            `json:"dedicated_master_type,omitempty"`
+	DedicatedMasterCount   int64             `json:"dedicated_master_count,omitempty"`
+	ZoneAwareness          bool              `json:"zone_awareness"`
+	EBSEnabled             bool              `json:"ebs_enabled"`
+	VolumeType             string            `json:"volume_type,omitempty"`
+	VolumeSize             *int64            `json:"volume_size,omitempty"`
+	Iops                   *int64            `json:"iops,omitempty"`
+	Networks               []string          `json:"networks"`
+	NetworkAWSIDs          []string          `json:"network_aws_ids"`
+	SecurityGroups         []string          `json:"security_groups"`
+	SecurityGroupAWSIDs    []string          `json:"security_group_aws_ids"`
+	EncryptionAtRest       bool              `json:"encryption_at_rest"`
+	EncryptionKey          string            `json:"encryption_key,omitempty"`
+	EncryptionKeyID        string            `json:"encryption_key_id,omitempty"`
+	AccessPolicy           string            `json:"access_policy,omitempty"`
+	Tags                   map[string]string `json:"tags"`
+	DatacenterType         string            `json:"datacenter_type,omitempty"`
+	DatacenterName         string            `json:"datacenter_name,omitempty"`
+	DatacenterRegion       string            `json:"datacenter_region"`
+	AccessKeyID            string            `json:"aws_access_key_id"`
+	SecretAccessKey        string            `json:"aws_secret_access_key"`
+	Service                string            `json:"service"`
+}
+
+// GetID : returns the component's ID
+func (e *ElasticsearchDomain) GetID() string {
+	return e.ComponentID
+}
+
+// GetName returns a components name
+func (e *ElasticsearchDomain) GetName() string {
+	return e.Name
+}
+
+// GetProvider : returns the provider type
+func (e *ElasticsearchDomain) GetProvider() string {
+	return e.ProviderType
+}
+
+// GetProviderID returns a components provider id
+func (e *ElasticsearchDomain) GetProviderID() string {
+	return e.DomainARN
+}
+
+// GetType : returns the type of the component
+func (e *ElasticsearchDomain) GetType() string {
+	return e.ComponentType
+}
+
+// GetState : returns the state of the component
+func (e *ElasticsearchDomain) GetState() string {
+	return e.State
+}
+
+// SetState : sets the state of the component
+func (e *ElasticsearchDomain) SetState(s string) {
+	e.State = s
+}
+
+// GetAction : returns the action of the component
+func (e *ElasticsearchDomain) GetAction() string {
+	return e.Action
+}
+
+// SetAction : Sets the action of the component
+func (e *ElasticsearchDomain) SetAction(s string) {
+	e.Action = s
+}
+
+// GetGroup : returns the components group
+func (e *ElasticsearchDomain) GetGroup() string {
+	return ""
+}
+
+// GetTags returns a components tags
+func (e *ElasticsearchDomain) GetTags() map[string]string {
+	return e.Tags
+}
+
+// GetTag returns a components tag
+func (e *ElasticsearchDomain) GetTag(tag string) string {
+	return e.Tags[tag]
+}
+
+// Diff : diff's the component against another component of the same type
+func (e *ElasticsearchDomain) Diff(c graph.Component) bool {
+	ce, ok := c.(*ElasticsearchDomain)
+	if ok {
+		if e.requiresBlueGreen(c) {
+			return true
+		}
+
+		if e.AccessPolicy != ce.AccessPolicy {
+			return true
+		}
+
+		return !reflect.DeepEqual(e.SecurityGroups, ce.SecurityGroups)
+	}
+
+	return false
+}
+
+// requiresBlueGreen : returns true if the differences to another domain can only
+// be applied by a blue/green deployment of the domain's nodes. Access policy and
+// security group changes are applied in place
+func (e *ElasticsearchDomain) requiresBlueGreen(c graph.Component) bool {
+	ce, ok := c.(*ElasticsearchDomain)
+	if ok {
+		if e.Version != ce.Version ||
+			e.InstanceType != ce.InstanceType ||
+			e.InstanceCount != ce.InstanceCount ||
+			e.ZoneAwareness != ce.ZoneAwareness {
+			return true
+		}
+
+		if e.DedicatedMasterEnabled != ce.DedicatedMasterEnabled ||
+			e.DedicatedMasterType != ce.DedicatedMasterType ||
+			e.DedicatedMasterCount != ce.DedicatedMasterCount {
+			return true
+		}
+
+		if e.EBSEnabled != ce.EBSEnabled ||
+			e.VolumeType != ce.VolumeType ||
+			reflect.DeepEqual(e.VolumeSize, ce.VolumeSize) != true ||
+			reflect.DeepEqual(e.Iops, ce.Iops) != true {
+			return true
+		}
+
+		if e.EncryptionAtRest != ce.EncryptionAtRest || e.EncryptionKey != ce.EncryptionKey {
+			return true
+		}
+
+		return !reflect.DeepEqual(e.Networks, ce.Networks)
+	}
+
+	return false
+}
+
+// Update : updates the provider returned values of a component
+func (e *ElasticsearchDomain) Update(c graph.Component) {
+	ce, ok := c.(*ElasticsearchDomain)
+	if ok {
+		e.DomainARN = ce.DomainARN
+		e.DomainID = ce.DomainID
+		e.Endpoint = ce.Endpoint
+	}
+
+	e.SetDefaultVariables()
+}
+
+// Rebuild : rebuilds the component's internal state, such as templated values
+func (e *ElasticsearchDomain) Rebuild(g *graph.Graph) {
+	if len(e.NetworkAWSIDs) > len(e.Networks) {
+		for _, nwid := range e.NetworkAWSIDs {
+			nw := g.GetComponents().ByProviderID(nwid)
+			if nw != nil {
+				e.Networks = append(e.Networks, nw.GetName())
+			}
+		}
+	}
+
+	if len(e.SecurityGroupAWSIDs) > len(e.SecurityGroups) {
+		for _, sgid := range e.SecurityGroupAWSIDs {
+			sg := g.GetComponents().ByProviderID(sgid)
+			if sg != nil {
+				e.SecurityGroups = append(e.SecurityGroups, sg.GetName())
+			}
+		}
+	}
+
+	if e.EncryptionKey == "" && e.EncryptionKeyID != "" {
+		k := g.GetComponents().ByProviderID(e.EncryptionKeyID)
+		if k != nil {
+			e.EncryptionKey = k.GetName()
+		}
+	}
+
+	if e.EBSEnabled && e.VolumeType == "" {
+		e.VolumeType = "gp2"
+	}
+
+	applyReferences(e.References())
+
+	e.SetDefaultVariables()
+}
+
+// References : returns the references the component holds to other components
+func (e *ElasticsearchDomain) References() []Reference {
+	var refs []Reference
+
+	for i, sg := range e.SecurityGroups {
+		refs = append(refs, refSecurityGroupID(sg).at(&e.SecurityGroupAWSIDs, i))
+	}
+
+	for i, nw := range e.Networks {
+		refs = append(refs, refSubnetID(nw).at(&e.NetworkAWSIDs, i))
+	}
+
+	if e.EncryptionKey != "" {
+		refs = append(refs, refKMSKeyARN(e.EncryptionKey).to(&e.EncryptionKeyID))
+	}
+
+	return refs
+}
+
+// Dependencies : returns a list of component id's upon which the component depends
+func (e *ElasticsearchDomain) Dependencies() []string {
+	return dependencies(e.References())
+}
+
+// Validate : validates the components values
+func (e *ElasticsearchDomain) Validate() error {
+	if len(e.Name) < 3 || len(e.Name) > 28 {
+		return errors.New("Elasticsearch Domain name should be between 3 and 28 characters")
+	}
+
+	if unicode.IsLower(rune(e.Name[0])) != true {
+		return errors.New("Elasticsearch Domain name should start with a lowercase letter")
+	}
+
+	for _, c := range e.Name {
+		if unicode.IsLower(c) != true && unicode.IsDigit(c) != true && c != '-' {
+			return errors.New("Elasticsearch Domain name can only contain lowercase letters, numbers and hyphens")
+		}
+	}
+
+	if e.Version == "" {
+		return errors.New("Elasticsearch Domain version should not be null")
+	}
+
+	if strings.HasSuffix(e.InstanceType, ".elasticsearch") != true {
+		return errors.New("Elasticsearch Domain instance type should be a valid elasticsearch instance type, i.e. 'm5.large.elasticsearch'")
+	}
+
+	if e.InstanceCount < 1 {
+		return errors.New("Elasticsearch Domain instance count should be at least 1")
+	}
+
+	if e.ZoneAwareness && e.InstanceCount%2 != 0 {
+		return errors.New("Elasticsearch Domain instance count should be even when zone awareness is enabled")
+	}
+
+	if e.DedicatedMasterEnabled {
+		if strings.HasSuffix(e.DedicatedMasterType, ".elasticsearch") != true {
+			return errors.New("Elasticsearch Domain dedicated master type should be a valid elasticsearch instance type")
+		}
+
+		if e.DedicatedMasterCount != 3 && e.DedicatedMasterCount != 5 {
+			return errors.New("Elasticsearch Domain dedicated master count should be 3 or 5")
+		}
+	} else if e.DedicatedMasterType != "" || e.DedicatedMasterCount != 0 {
+		return errors.New("Elasticsearch Domain dedicated master options are only valid when dedicated masters are enabled")
+	}
+
+	if e.EBSEnabled {
+		if isOneOf([]string{"standard", "gp2", "io1"}, e.VolumeType) != true {
+			return fmt.Errorf("Elasticsearch Domain volume type (%s) should be one of standard, gp2 or io1", e.VolumeType)
+		}
+
+		if e.VolumeSize == nil || *e.VolumeSize < 10 {
+			return errors.New("Elasticsearch Domain volume size should be at least 10 (GB)")
+		}
+
+		if e.VolumeType != "io1" && e.Iops != nil {
+			return errors.New("Elasticsearch Domain volume type must be 'io1' when specifying iops")
+		}
+	} else if e.VolumeSize != nil || e.Iops != nil {
+		return errors.New("Elasticsearch Domain volume options are only valid when ebs storage is enabled")
+	}
+
+	if e.ZoneAwareness && len(e.Networks) > 0 && len(e.Networks) < 2 {
+		return errors.New("Elasticsearch Domain should specify at least two networks when zone awareness is enabled")
+	}
+
+	if len(e.Networks) != len(e.NetworkAWSIDs) {
+		return errors.New("Elasticsearch Domain networks are incorrect")
+	}
+
+	if len(e.SecurityGroups) != len(e.SecurityGroupAWSIDs) {
+		return errors.New("Elasticsearch Domain security groups are incorrect")
+	}
+
+	if len(e.SecurityGroups) > 0 && len(e.Networks) < 1 {
+		return errors.New("Elasticsearch Domain security groups can only be specified for domains placed in networks")
+	}
+
+	if e.EncryptionAtRest != true && e.EncryptionKeyID != "" {
+		return errors.New("Elasticsearch Domain encryption key should only be set if encryption at rest is enabled")
+	}
+
+	if e.AccessPolicy != "" && json.Valid([]byte(e.AccessPolicy)) != true {
+		return errors.New("Elasticsearch Domain access policy is not valid json")
+	}
+
+	return nil
+}
+
+// IsStateful : returns true if the component needs to be actioned to be removed.
+func (e *ElasticsearchDomain) IsStateful() bool {
+	return true
+}
+
+// SetDefaultVariables : sets up the default template variables for a component
+func (e *ElasticsearchDomain) SetDefaultVariables() {
+	e.ComponentType = TYPEELASTICSEARCHDOMAIN
+	e.ComponentID = TYPEELASTICSEARCHDOMAIN + TYPEDELIMITER + e.Name
+	e.ProviderType = PROVIDERTYPE
+	e.DatacenterName = DATACENTERNAME
+	e.DatacenterType = DATACENTERTYPE
+	e.DatacenterRegion = DATACENTERREGION
+	e.AccessKeyID = ACCESSKEYID
+	e.SecretAccessKey = SECRETACCESSKEY
+}
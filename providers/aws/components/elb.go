@@ -15,10 +15,11 @@ import (
 
 // ELBListener ...
 type ELBListener struct {
-	FromPort int    `json:"from_port"`
-	ToPort   int    `json:"to_port"`
-	Protocol string `json:"protocol"`
-	SSLCert  string `json:"ssl_cert"`
+	FromPort       int    `json:"from_port"`
+	ToPort         int    `json:"to_port"`
+	Protocol       string `json:"protocol"`
+	SSLCert        string `json:"ssl_cert"`
+	SSLCertificate string `json:"ssl_certificate,omitempty"`
 }
 
 // ELB : Mapping for a elb component
@@ -120,7 +121,8 @@ func (e *ELB) Diff(c graph.Component) bool {
 			if e.Listeners[i].FromPort != ce.Listeners[i].FromPort ||
 				e.Listeners[i].ToPort != ce.Listeners[i].ToPort ||
 				e.Listeners[i].Protocol != ce.Listeners[i].Protocol ||
-				e.Listeners[i].SSLCert != ce.Listeners[i].SSLCert {
+				e.Listeners[i].SSLCert != ce.Listeners[i].SSLCert ||
+				e.Listeners[i].SSLCertificate != ce.Listeners[i].SSLCertificate {
 				return true
 			}
 		}
@@ -157,12 +159,6 @@ func (e *ELB) Update(c graph.Component) {
 
 // Rebuild : rebuilds the component's internal state, such as templated values
 func (e *ELB) Rebuild(g *graph.Graph) {
-	if len(e.Networks) > len(e.NetworkAWSIDs) {
-		for _, nw := range e.Networks {
-			e.NetworkAWSIDs = append(e.NetworkAWSIDs, templSubnetID(nw))
-		}
-	}
-
 	if len(e.NetworkAWSIDs) > len(e.Networks) {
 		for _, nwid := range e.NetworkAWSIDs {
 			nw := g.GetComponents().ByProviderID(nwid)
@@ -172,12 +168,6 @@ func (e *ELB) Rebuild(g *graph.Graph) {
 		}
 	}
 
-	if len(e.SecurityGroups) > len(e.SecurityGroupAWSIDs) {
-		for _, sg := range e.SecurityGroups {
-			e.SecurityGroupAWSIDs = append(e.SecurityGroupAWSIDs, templSecurityGroupID(sg))
-		}
-	}
-
 	if len(e.SecurityGroupAWSIDs) > len(e.SecurityGroups) {
 		for _, sgid := range e.SecurityGroupAWSIDs {
 			sg := g.GetComponents().ByProviderID(sgid)
@@ -187,14 +177,6 @@ func (e *ELB) Rebuild(g *graph.Graph) {
 		}
 	}
 
-	if len(e.Instances) > len(e.InstanceAWSIDs) {
-		for _, ig := range e.Instances {
-			for _, i := range g.GetComponents().ByGroup(GROUPINSTANCE, ig) {
-				e.InstanceAWSIDs = append(e.InstanceAWSIDs, templInstanceID(i.GetProviderID()))
-			}
-		}
-	}
-
 	if len(e.InstanceAWSIDs) > len(e.Instances) {
 		for _, iid := range e.InstanceAWSIDs {
 			i := g.GetComponents().ByProviderID(iid)
@@ -210,26 +192,53 @@ func (e *ELB) Rebuild(g *graph.Graph) {
 		}
 	}
 
+	for x := 0; x < len(e.Listeners); x++ {
+		if e.Listeners[x].SSLCertificate == "" && e.Listeners[x].SSLCert != "" {
+			cert := g.GetComponents().ByProviderID(e.Listeners[x].SSLCert)
+			if cert != nil {
+				e.Listeners[x].SSLCertificate = cert.GetName()
+			}
+		}
+	}
+
+	// certificates are only templated when they exist, so Validate can report missing ones
+	for _, r := range e.References() {
+		if r.Type != TYPEACMCERTIFICATE || g.HasComponent(r.ComponentID()) {
+			applyReferences([]Reference{r})
+		}
+	}
+
 	e.SetDefaultVariables()
 }
 
-// Dependencies : returns a list of component id's upon which the component depends
-func (e *ELB) Dependencies() []string {
-	var deps []string
+// References : returns the references the component holds to other components
+func (e *ELB) References() []Reference {
+	var refs []Reference
+
+	for i, sg := range e.SecurityGroups {
+		refs = append(refs, refSecurityGroupID(sg).at(&e.SecurityGroupAWSIDs, i))
+	}
 
-	for _, sg := range e.SecurityGroups {
-		deps = append(deps, TYPESECURITYGROUP+TYPEDELIMITER+sg)
+	for i, nw := range e.Networks {
+		refs = append(refs, refSubnetID(nw).at(&e.NetworkAWSIDs, i))
 	}
 
-	for _, nw := range e.Networks {
-		deps = append(deps, TYPENETWORK+TYPEDELIMITER+nw)
+	for i, in := range e.InstanceNames {
+		refs = append(refs, refInstanceID(in).at(&e.InstanceAWSIDs, i))
 	}
 
-	for _, in := range e.Instances {
-		deps = append(deps, TYPENETWORK+TYPEDELIMITER+in)
+	for x := range e.Listeners {
+		if e.Listeners[x].SSLCertificate != "" {
+			refs = append(refs, refACMCertificateARN(e.Listeners[x].SSLCertificate).to(&e.Listeners[x].SSLCert))
+		}
 	}
 
-	return deps
+	return refs
+}
+
+// Dependencies : returns a list of component id's upon which the component depends
+func (e *ELB) Dependencies() []string {
+	return dependencies(e.References())
 }
 
 // Validate : validates the components values
@@ -268,6 +277,10 @@ func (e *ELB) Validate() error {
 			return errors.New("ELB Protocol must be one of http, https, tcp or ssl")
 		}
 
+		if listener.SSLCertificate != "" && listener.SSLCert == "" {
+			return fmt.Errorf("ELB listener ssl certificate (%s) does not exist", listener.SSLCertificate)
+		}
+
 		if listener.Protocol == "https" && listener.SSLCert == "" || listener.Protocol == "ssl" && listener.SSLCert == "" {
 			return errors.New("ELB listener must specify an ssl cert when protocol is https/ssl")
 		}
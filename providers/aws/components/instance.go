@@ -18,37 +18,60 @@ type InstanceVolume struct {
 	Device      string `json:"device"`
 }
 
+// InstanceRootVolume ...
+type InstanceRootVolume struct {
+	Size      *int64 `json:"size,omitempty"`
+	Type      string `json:"type"`
+	Encrypted bool   `json:"encrypted"`
+}
+
 // Instance : mapping of an instance component
 type Instance struct {
-	ProviderType        string            `json:"_provider"`
-	ComponentType       string            `json:"_component"`
-	ComponentID         string            `json:"_component_id"`
-	State               string            `json:"_state"`
-	Action              string            `json:"_action"`
-	InstanceAWSID       string            `json:"instance_aws_id"`
-	Name                string            `json:"name"`
-	Type                string            `json:"instance_type"`
-	Image               string            `json:"image"`
-	IP                  string            `json:"ip"`
-	PublicIP            string            `json:"public_ip"`
-	ElasticIP           string            `json:"elastic_ip"`
-	ElasticIPAWSID      *string           `json:"elastic_ip_aws_id,omitempty"`
-	AssignElasticIP     bool              `json:"assign_elastic_ip"`
-	KeyPair             string            `json:"key_pair"`
-	UserData            string            `json:"user_data"`
-	Network             string            `json:"network_name"`
-	NetworkAWSID        string            `json:"network_aws_id"`
-	NetworkIsPublic     bool              `json:"network_is_public"`
-	SecurityGroups      []string          `json:"security_groups"`
-	SecurityGroupAWSIDs []string          `json:"security_group_aws_ids"`
-	Volumes             []InstanceVolume  `json:"volumes"`
-	Tags                map[string]string `json:"tags"`
-	DatacenterType      string            `json:"datacenter_type,omitempty"`
-	DatacenterName      string            `json:"datacenter_name,omitempty"`
-	DatacenterRegion    string            `json:"datacenter_region"`
-	AccessKeyID         string            `json:"aws_access_key_id"`
-	SecretAccessKey     string            `json:"aws_secret_access_key"`
-	Service             string            `json:"service"`
+	ProviderType        string             `json:"_provider"`
+	ComponentType       string             `json:"_component"`
+	ComponentID         string             `json:"_component_id"`
+	State               string             `json:"_state"`
+	Action              string             `json:"_action"`
+	InstanceAWSID       string             `json:"instance_aws_id"`
+	Name                string             `json:"name"`
+	Type                string             `json:"instance_type"`
+	Image               string             `json:"image"`
+	IP                  string             `json:"ip"`
+	PublicIP            string             `json:"public_ip"`
+	ElasticIP           string             `json:"elastic_ip"`
+	ElasticIPAWSID      *string            `json:"elastic_ip_aws_id,omitempty"`
+	AssignElasticIP     bool               `json:"assign_elastic_ip"`
+	KeyPair             string             `json:"key_pair"`
+	KeyPairManaged      bool               `json:"key_pair_managed"`
+	UserData            string             `json:"user_data"`
+	LaunchTemplate      string             `json:"launch_template,omitempty"`
+	LaunchTemplateAWSID string             `json:"launch_template_aws_id,omitempty"`
+	MarketType          string             `json:"market_type"`
+	SpotMaxPrice        string             `json:"spot_max_price,omitempty"`
+	SpotInterruption    string             `json:"spot_interruption_behaviour,omitempty"`
+	SpotRequestAWSID    string             `json:"spot_request_aws_id,omitempty"`
+	OnDemandBase        int                `json:"on_demand_base,omitempty"`
+	OnDemandPercentage  int                `json:"on_demand_percentage,omitempty"`
+	AvailabilityZone    string             `json:"availability_zone"`
+	PlacementGroup      string             `json:"placement_group,omitempty"`
+	PlacementManaged    bool               `json:"placement_group_managed"`
+	Tenancy             string             `json:"tenancy"`
+	EBSOptimized        bool               `json:"ebs_optimized"`
+	Monitoring          bool               `json:"monitoring"`
+	RootVolume          InstanceRootVolume `json:"root_volume"`
+	Network             string             `json:"network_name"`
+	NetworkAWSID        string             `json:"network_aws_id"`
+	NetworkIsPublic     bool               `json:"network_is_public"`
+	SecurityGroups      []string           `json:"security_groups"`
+	SecurityGroupAWSIDs []string           `json:"security_group_aws_ids"`
+	Volumes             []InstanceVolume   `json:"volumes"`
+	Tags                map[string]string  `json:"tags"`
+	DatacenterType      string             `json:"datacenter_type,omitempty"`
+	DatacenterName      string             `json:"datacenter_name,omitempty"`
+	DatacenterRegion    string             `json:"datacenter_region"`
+	AccessKeyID         string             `json:"aws_access_key_id"`
+	SecretAccessKey     string             `json:"aws_secret_access_key"`
+	Service             string             `json:"service"`
 }
 
 // GetID : returns the component's ID
@@ -68,7 +91,7 @@ func (i *Instance) GetProvider() string {
 
 // GetProviderID returns a components provider id
 func (i *Instance) GetProviderID() string {
-	return i.NetworkAWSID
+	return i.InstanceAWSID
 }
 
 // GetType : returns the type of the component
@@ -119,6 +142,19 @@ func (i *Instance) Diff(c graph.Component) bool {
 			return true
 		}
 
+		if i.requiresReplacement(c) {
+			return true
+		}
+
+		if i.EBSOptimized != ci.EBSOptimized ||
+			i.Monitoring != ci.Monitoring {
+			return true
+		}
+
+		if i.RootVolume.Size != nil && ci.RootVolume.Size != nil && *i.RootVolume.Size != *ci.RootVolume.Size {
+			return true
+		}
+
 		for _, v := range i.Volumes {
 			if hasVolume(ci.Volumes, v.Volume) != true {
 				return true
@@ -137,6 +173,38 @@ func (i *Instance) Diff(c graph.Component) bool {
 	return false
 }
 
+// requiresReplacement : returns true if the differences to another instance
+// cannot be applied in place, and the instance must be recreated
+func (i *Instance) requiresReplacement(c graph.Component) bool {
+	ci, ok := c.(*Instance)
+	if ok {
+		if i.PlacementGroup != ci.PlacementGroup ||
+			orDefault(i.Tenancy, "default") != orDefault(ci.Tenancy, "default") {
+			return true
+		}
+
+		if i.RootVolume.Type != ci.RootVolume.Type ||
+			i.RootVolume.Encrypted != ci.RootVolume.Encrypted {
+			return true
+		}
+
+		// root volumes can only grow in place
+		if i.RootVolume.Size != nil && ci.RootVolume.Size != nil && *i.RootVolume.Size < *ci.RootVolume.Size {
+			return true
+		}
+
+		if i.SpotMaxPrice != ci.SpotMaxPrice ||
+			i.SpotInterruption != ci.SpotInterruption ||
+			i.LaunchTemplate != ci.LaunchTemplate {
+			return true
+		}
+
+		return orDefault(i.MarketType, MARKETONDEMAND) != orDefault(ci.MarketType, MARKETONDEMAND)
+	}
+
+	return false
+}
+
 // Update : updates the provider returned values of a component
 func (i *Instance) Update(c graph.Component) {
 	ci, ok := c.(*Instance)
@@ -145,6 +213,7 @@ func (i *Instance) Update(c graph.Component) {
 		i.PublicIP = ci.PublicIP
 		i.ElasticIP = ci.ElasticIP
 		i.ElasticIPAWSID = ci.ElasticIPAWSID
+		i.SpotRequestAWSID = ci.SpotRequestAWSID
 	}
 
 	i.SetDefaultVariables()
@@ -159,16 +228,28 @@ func (i *Instance) Rebuild(g *graph.Graph) {
 		}
 	}
 
-	if i.Network != "" && i.NetworkAWSID == "" {
-		i.NetworkAWSID = templSubnetID(i.Network)
+	for _, c := range g.GetComponents().ByType(TYPENETWORK) {
+		nw, ok := c.(*Network)
+		if ok && nw.Name == i.Network && i.AvailabilityZone == "" {
+			i.AvailabilityZone = nw.AvailabilityZone
+		}
 	}
 
-	if len(i.SecurityGroups) > len(i.SecurityGroupAWSIDs) {
-		for _, sg := range i.SecurityGroups {
-			i.SecurityGroupAWSIDs = append(i.SecurityGroupAWSIDs, templSecurityGroupID(sg))
+	if i.LaunchTemplate == "" && i.LaunchTemplateAWSID != "" {
+		lt := g.GetComponents().ByProviderID(i.LaunchTemplateAWSID)
+		if lt != nil {
+			i.LaunchTemplate = lt.GetName()
 		}
 	}
 
+	if i.MarketType == "" {
+		i.MarketType = MARKETONDEMAND
+	}
+
+	if i.PlacementGroup != "" {
+		i.PlacementManaged = g.HasComponent(TYPEPLACEMENTGROUP + TYPEDELIMITER + i.PlacementGroup)
+	}
+
 	if len(i.SecurityGroupAWSIDs) > len(i.SecurityGroups) {
 		for _, sgid := range i.SecurityGroupAWSIDs {
 			sg := g.GetComponents().ByProviderID(sgid)
@@ -178,6 +259,10 @@ func (i *Instance) Rebuild(g *graph.Graph) {
 		}
 	}
 
+	if i.KeyPair != "" {
+		i.KeyPairManaged = g.HasComponent(TYPEKEYPAIR + TYPEDELIMITER + i.KeyPair)
+	}
+
 	for x := 0; x < len(i.Volumes); x++ {
 		if i.Volumes[x].Volume == "" && i.Volumes[x].VolumeAWSID != "" {
 			v := g.GetComponents().ByProviderID(i.Volumes[x].VolumeAWSID)
@@ -185,30 +270,46 @@ func (i *Instance) Rebuild(g *graph.Graph) {
 				i.Volumes[x].Volume = v.GetName()
 			}
 		}
-
-		if i.Volumes[x].VolumeAWSID == "" && i.Volumes[x].Volume != "" {
-			i.Volumes[x].VolumeAWSID = templEBSVolumeID(i.Volumes[x].Volume)
-		}
 	}
 
+	applyReferences(i.References())
+
 	i.SetDefaultVariables()
 }
 
-// Dependencies : returns a list of component id's upon which the component depends
-func (i *Instance) Dependencies() []string {
-	var deps []string
+// References : returns the references the component holds to other components
+func (i *Instance) References() []Reference {
+	var refs []Reference
 
-	for _, sg := range i.SecurityGroups {
-		deps = append(deps, TYPESECURITYGROUP+TYPEDELIMITER+sg)
+	for x, sg := range i.SecurityGroups {
+		refs = append(refs, refSecurityGroupID(sg).at(&i.SecurityGroupAWSIDs, x))
 	}
 
-	for _, ebs := range i.Volumes {
-		deps = append(deps, TYPEEBSVOLUME+TYPEDELIMITER+ebs.Volume)
+	for x := range i.Volumes {
+		refs = append(refs, refEBSVolumeID(i.Volumes[x].Volume).to(&i.Volumes[x].VolumeAWSID))
 	}
 
-	deps = append(deps, TYPENETWORK+TYPEDELIMITER+i.Network)
+	// key pairs and placement groups are referenced by name, so only add a dependency
+	if i.KeyPairManaged {
+		refs = append(refs, refKeyPairName(i.KeyPair))
+	}
+
+	if i.PlacementManaged {
+		refs = append(refs, refPlacementGroupName(i.PlacementGroup))
+	}
+
+	if i.LaunchTemplate != "" {
+		refs = append(refs, refLaunchTemplateID(i.LaunchTemplate).to(&i.LaunchTemplateAWSID))
+	}
 
-	return deps
+	refs = append(refs, refSubnetID(i.Network).to(&i.NetworkAWSID))
+
+	return refs
+}
+
+// Dependencies : returns a list of component id's upon which the component depends
+func (i *Instance) Dependencies() []string {
+	return dependencies(i.References())
 }
 
 // Validate : validates the components values
@@ -233,7 +334,44 @@ func (i *Instance) Validate() error {
 		return errors.New("Instance security groups are incorrect")
 	}
 
-	return nil
+	if i.Tenancy != "" && isOneOf([]string{"default", "dedicated", "host"}, i.Tenancy) != true {
+		return errors.New("Instance tenancy should be one of default, dedicated or host")
+	}
+
+	if i.RootVolume.Type != "" && isOneOf([]string{"standard", "gp2", "gp3", "io1", "io2", "st1", "sc1"}, i.RootVolume.Type) != true {
+		return errors.New("Instance root volume type is invalid")
+	}
+
+	if i.RootVolume.Size != nil && (*i.RootVolume.Size < 1 || *i.RootVolume.Size > 16384) {
+		return errors.New("Instance root volume size should be between 1 - 16384 (GB)")
+	}
+
+	err := validateMarketType(i.MarketType)
+	if err != nil {
+		return err
+	}
+
+	if i.OnDemandBase < 0 {
+		return errors.New("Instance on demand base should be 0 or greater")
+	}
+
+	if i.OnDemandPercentage < 0 || i.OnDemandPercentage > 100 {
+		return errors.New("Instance on demand percentage should be between 0 - 100")
+	}
+
+	if i.MarketType == MARKETSPOT && i.Tenancy == "host" {
+		return errors.New("Instance spot market type is not supported with host tenancy")
+	}
+
+	if i.MarketType != MARKETSPOT {
+		if i.SpotMaxPrice != "" || i.SpotInterruption != "" {
+			return errors.New("Instance spot options are only valid for the spot market type")
+		}
+
+		return nil
+	}
+
+	return validateSpotOptions(i.Type, i.AvailabilityZone, i.SpotMaxPrice, i.SpotInterruption)
 }
 
 // IsStateful : returns true if the component needs to be actioned to be removed.
@@ -262,3 +400,12 @@ func hasVolume(vols []InstanceVolume, volume string) bool {
 
 	return false
 }
+
+// orDefault : values missing from previously stored components are treated as their default
+func orDefault(v, d string) string {
+	if v == "" {
+		return d
+	}
+
+	return v
+}
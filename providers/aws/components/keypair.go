@@ -0,0 +1,200 @@
+/* This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at http://mozilla.org/MPL/2.0/. */
+
+package components
+
+import (
+	"encoding/base64"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"strings"
+
+	graph "gopkg.in/r3labs/graph.v2"
+)
+
+// KeyPair : mapping of a key pair component
+type KeyPair struct {
+	ProviderType     string            `json:"_provider"`
+	ComponentType    string            `json:"_component"`
+	ComponentID      string            `json:"_component_id"`
+	State            string            `json:"_state"`
+	Action           string            `json:"_action"`
+	KeyPairAWSID     string            `json:"key_pair_aws_id"`
+	Name             string            `json:"name"`
+	PublicKey        string            `json:"public_key"`
+	Fingerprint      string            `json:"fingerprint"`
+	Tags             map[string]string `json:"tags"`
+	DatacenterType   string            `json:"datacenter_type,omitempty"`
+	DatacenterName   string            `json:"datacenter_name,omitempty"`
+	DatacenterRegion string            `json:"datacenter_region"`
+	AccessKeyID      string            `json:"aws_access_key_id"`
+	SecretAccessKey  string            `json:"aws_secret_access_key"`
+	Service          string            `json:"service"`
+}
+
+// GetID : returns the component's ID
+func (k *KeyPair) GetID() string {
+	return k.ComponentID
+}
+
+// GetName returns a components name
+func (k *KeyPair) GetName() string {
+	return k.Name
+}
+
+// GetProvider : returns the provider type
+func (k *KeyPair) GetProvider() string {
+	return k.ProviderType
+}
+
+// GetProviderID returns a components provider id
+func (k *KeyPair) GetProviderID() string {
+	return k.Name
+}
+
+// GetType : returns the type of the component
+func (k *KeyPair) GetType() string {
+	return k.ComponentType
+}
+
+// GetState : returns the state of the component
+func (k *KeyPair) GetState() string {
+	return k.State
+}
+
+// SetState : sets the state of the component
+func (k *KeyPair) SetState(s string) {
+	k.State = s
+}
+
+// GetAction : returns the action of the component
+func (k *KeyPair) GetAction() string {
+	return k.Action
+}
+
+// SetAction : Sets the action of the component
+func (k *KeyPair) SetAction(s string) {
+	k.Action = s
+}
+
+// GetGroup : returns the components group
+func (k *KeyPair) GetGroup() string {
+	return ""
+}
+
+// GetTags returns a components tags
+func (k *KeyPair) GetTags() map[string]string {
+	return k.Tags
+}
+
+// GetTag returns a components tag
+func (k *KeyPair) GetTag(tag string) string {
+	return k.Tags[tag]
+}
+
+// Diff : diff's the component against another component of the same type.
+// Key pairs can not be modified in place, so any change to the key material
+// results in the key pair being deleted and imported again.
+func (k *KeyPair) Diff(c graph.Component) bool {
+	ck, ok := c.(*KeyPair)
+	if ok {
+		return strings.TrimSpace(k.PublicKey) != strings.TrimSpace(ck.PublicKey)
+	}
+
+	return false
+}
+
+// Update : updates the provider returned values of a component
+func (k *KeyPair) Update(c graph.Component) {
+	ck, ok := c.(*KeyPair)
+	if ok {
+		k.KeyPairAWSID = ck.KeyPairAWSID
+		k.Fingerprint = ck.Fingerprint
+	}
+
+	k.SetDefaultVariables()
+}
+
+// Rebuild : rebuilds the component's internal state, such as templated values
+func (k *KeyPair) Rebuild(g *graph.Graph) {
+	k.SetDefaultVariables()
+}
+
+// References : returns the references the component holds to other components
+func (k *KeyPair) References() []Reference {
+	return nil
+}
+
+// Dependencies : returns a list of component id's upon which the component depends
+func (k *KeyPair) Dependencies() []string {
+	return dependencies(k.References())
+}
+
+// Validate : validates the components values
+func (k *KeyPair) Validate() error {
+	if k.Name == "" {
+		return errors.New("Key Pair name should not be null")
+	}
+
+	if len(k.Name) > 255 {
+		return errors.New("Key Pair name should not exceed 255 characters")
+	}
+
+	err := validatePublicKey(k.PublicKey)
+	if err != nil {
+		return fmt.Errorf("Key Pair public key is invalid: %s", err.Error())
+	}
+
+	return nil
+}
+
+// IsStateful : returns true if the component needs to be actioned to be removed.
+func (k *KeyPair) IsStateful() bool {
+	return true
+}
+
+// SetDefaultVariables : sets up the default template variables for a component
+func (k *KeyPair) SetDefaultVariables() {
+	k.ComponentType = TYPEKEYPAIR
+	k.ComponentID = TYPEKEYPAIR + TYPEDELIMITER + k.Name
+	k.ProviderType = PROVIDERTYPE
+	k.DatacenterName = DATACENTERNAME
+	k.DatacenterType = DATACENTERTYPE
+	k.DatacenterRegion = DATACENTERREGION
+	k.AccessKeyID = ACCESSKEYID
+	k.SecretAccessKey = SECRETACCESSKEY
+}
+
+// validatePublicKey checks a key is in the openssh authorized_keys format,
+// i.e. 'ssh-rsa AAAAB3NzaC1yc2E... comment'
+func validatePublicKey(key string) error {
+	var types = []string{"ssh-rsa", "ssh-ed25519", "ecdsa-sha2-nistp256", "ecdsa-sha2-nistp384", "ecdsa-sha2-nistp521"}
+
+	parts := strings.Fields(key)
+	if len(parts) < 2 {
+		return errors.New("key should take the form of '<type> <base64 key> [comment]'")
+	}
+
+	if isOneOf(types, parts[0]) != true {
+		return fmt.Errorf("key type must be one of %s", strings.Join(types, ", "))
+	}
+
+	data, err := base64.StdEncoding.DecodeString(parts[1])
+	if err != nil {
+		return errors.New("key data is not valid base64")
+	}
+
+	// the key blob is prefixed with its length encoded type
+	if len(data) < 4 {
+		return errors.New("key data is too short")
+	}
+
+	l := binary.BigEndian.Uint32(data[:4])
+	if uint32(len(data)-4) < l || string(data[4:4+l]) != parts[0] {
+		return errors.New("key data does not match the key type")
+	}
+
+	return nil
+}
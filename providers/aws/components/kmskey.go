@@ -0,0 +1,197 @@
+/* This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at http://mozilla.org/MPL/2.0/. */
+
+package components
+
+import (
+	"encoding/json"
+	"errors"
+	"strings"
+
+	graph "gopkg.in/r3labs/graph.v2"
+)
+
+// KMSKey : mapping of a kms key component
+type KMSKey struct {
+	ProviderType     string            `json:"_provider"`
+	ComponentType    string            `json:"_component"`
+	ComponentID      string            `json:"_component_id"`
+	State            string            `json:"_state"`
+	Action           string            `json:"_action"`
+	KMSKeyAWSID      string            `json:"kms_key_aws_id"`
+	ARN              string            `json:"kms_key_arn"`
+	Name             string            `json:"name"`
+	Alias            string            `json:"alias"`
+	Description      string            `json:"description"`
+	Policy           string            `json:"policy,omitempty"`
+	KeyRotation      bool              `json:"key_rotation"`
+	DeletionWindow   *int64            `json:"deletion_window,omitempty"`
+	Tags             map[string]string `json:"tags"`
+	DatacenterType   string            `json:"datacenter_type,omitempty"`
+	DatacenterName   string            `json:"datacenter_name,omitempty"`
+	DatacenterRegion string            `json:"datacenter_region"`
+	AccessKeyID      string            `json:"aws_access_key_id"`
+	SecretAccessKey  string            `json:"aws_secret_access_key"`
+	Service          string            `json:"service"`
+}
+
+// GetID : returns the component's ID
+func (k *KMSKey) GetID() string {
+	return k.ComponentID
+}
+
+// GetName returns a components name
+func (k *KMSKey) GetName() string {
+	return k.Name
+}
+
+// GetProvider : returns the provider type
+func (k *KMSKey) GetProvider() string {
+	return k.ProviderType
+}
+
+// GetProviderID returns a components provider id
+func (k *KMSKey) GetProviderID() string {
+	return k.ARN
+}
+
+// GetType : returns the type of the component
+func (k *KMSKey) GetType() string {
+	return k.ComponentType
+}
+
+// GetState : returns the state of the component
+func (k *KMSKey) GetState() string {
+	return k.State
+}
+
+// SetState : sets the state of the component
+func (k *KMSKey) SetState(s string) {
+	k.State = s
+}
+
+// GetAction : returns the action of the component
+func (k *KMSKey) GetAction() string {
+	return k.Action
+}
+
+// SetAction : Sets the action of the component
+func (k *KMSKey) SetAction(s string) {
+	k.Action = s
+}
+
+// GetGroup : returns the components group
+func (k *KMSKey) GetGroup() string {
+	return ""
+}
+
+// GetTags returns a components tags
+func (k *KMSKey) GetTags() map[string]string {
+	return k.Tags
+}
+
+// GetTag returns a components tag
+func (k *KMSKey) GetTag(tag string) string {
+	return k.Tags[tag]
+}
+
+// Diff : diff's the component against another component of the same type
+func (k *KMSKey) Diff(c graph.Component) bool {
+	ck, ok := c.(*KMSKey)
+	if ok {
+		if k.Alias != ck.Alias {
+			return true
+		}
+
+		if k.Description != ck.Description {
+			return true
+		}
+
+		if k.Policy != ck.Policy {
+			return true
+		}
+
+		return k.KeyRotation != ck.KeyRotation
+	}
+
+	return false
+}
+
+// Update : updates the provider returned values of a component
+func (k *KMSKey) Update(c graph.Component) {
+	ck, ok := c.(*KMSKey)
+	if ok {
+		k.KMSKeyAWSID = ck.KMSKeyAWSID
+		k.ARN = ck.ARN
+	}
+
+	k.SetDefaultVariables()
+}
+
+// Rebuild : rebuilds the component's internal state, such as templated values
+func (k *KMSKey) Rebuild(g *graph.Graph) {
+	if k.Alias == "" {
+		k.Alias = "alias/" + k.Name
+	}
+
+	k.SetDefaultVariables()
+}
+
+// References : returns the references the component holds to other components
+func (k *KMSKey) References() []Reference {
+	return nil
+}
+
+// Dependencies : returns a list of component id's upon which the component depends
+func (k *KMSKey) Dependencies() []string {
+	return dependencies(k.References())
+}
+
+// Validate : validates the components values
+func (k *KMSKey) Validate() error {
+	if k.Name == "" {
+		return errors.New("KMS Key name should not be null")
+	}
+
+	if strings.HasPrefix(k.Alias, "alias/") != true {
+		return errors.New("KMS Key alias must begin with 'alias/'")
+	}
+
+	if strings.HasPrefix(k.Alias, "alias/aws/") {
+		return errors.New("KMS Key alias must not begin with 'alias/aws/', this prefix is reserved for aws managed keys")
+	}
+
+	if len(k.Alias) > 256 {
+		return errors.New("KMS Key alias should not exceed 256 characters")
+	}
+
+	if k.Policy != "" && json.Valid([]byte(k.Policy)) != true {
+		return errors.New("KMS Key policy is not a valid json document")
+	}
+
+	if k.DeletionWindow != nil {
+		if *k.DeletionWindow < 7 || *k.DeletionWindow > 30 {
+			return errors.New("KMS Key deletion window should be between 7 and 30 days")
+		}
+	}
+
+	return nil
+}
+
+// IsStateful : returns true if the component needs to be actioned to be removed.
+func (k *KMSKey) IsStateful() bool {
+	return true
+}
+
+// SetDefaultVariables : sets up the default template variables for a component
+func (k *KMSKey) SetDefaultVariables() {
+	k.ComponentType = TYPEKMSKEY
+	k.ComponentID = TYPEKMSKEY + TYPEDELIMITER + k.Name
+	k.ProviderType = PROVIDERTYPE
+	k.DatacenterName = DATACENTERNAME
+	k.DatacenterType = DATACENTERTYPE
+	k.DatacenterRegion = DATACENTERREGION
+	k.AccessKeyID = ACCESSKEYID
+	k.SecretAccessKey = SECRETACCESSKEY
+}
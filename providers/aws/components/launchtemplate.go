@@ -0,0 +1,236 @@
+/* This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at http://mozilla.org/MPL/2.0/. */
+
+package components
+
+import (
+	"errors"
+	"reflect"
+
+	graph "gopkg.in/r3labs/graph.v2"
+)
+
+// LaunchTemplate : mapping of a launch template component
+type LaunchTemplate struct {
+	ProviderType              string            `json:"_provider"`
+	ComponentType             string            `json:"_component"`
+	ComponentID               string            `json:"_component_id"`
+	State                     string            `json:"_state"`
+	Action                    string            `json:"_action"`
+	LaunchTemplateAWSID       string            `json:"launch_template_aws_id"`
+	LatestVersion             string            `json:"latest_version"`
+	Name                      string            `json:"name"`
+	Type                      string            `json:"instance_type"`
+	Image                     string            `json:"image"`
+	KeyPair                   string            `json:"key_pair"`
+	KeyPairManaged            bool              `json:"key_pair_managed"`
+	UserData                  string            `json:"user_data"`
+	SecurityGroups            []string          `json:"security_groups"`
+	SecurityGroupAWSIDs       []string          `json:"security_group_aws_ids"`
+	MarketType                string            `json:"market_type"`
+	SpotMaxPrice              string            `json:"spot_max_price,omitempty"`
+	SpotInterruptionBehaviour string            `json:"spot_interruption_behaviour,omitempty"`
+	Tags                      map[string]string `json:"tags"`
+	DatacenterType            string            `json:"datacenter_type,omitempty"`
+	DatacenterName            string            `json:"datacenter_name,omitempty"`
+	DatacenterRegion          string            `json:"datacenter_region"`
+	AccessKeyID               string            `json:"aws_access_key_id"`
+	SecretAccessKey           string            `json:"aws_secret_access_key"`
+	Service                   string            `json:"service"`
+}
+
+// GetID : returns the component's ID
+func (l *LaunchTemplate) GetID() string {
+	return l.ComponentID
+}
+
+// GetName returns a components name
+func (l *LaunchTemplate) GetName() string {
+	return l.Name
+}
+
+// GetProvider : returns the provider type
+func (l *LaunchTemplate) GetProvider() string {
+	return l.ProviderType
+}
+
+// GetProviderID returns a components provider id
+func (l *LaunchTemplate) GetProviderID() string {
+	return l.LaunchTemplateAWSID
+}
+
+// GetType : returns the type of the component
+func (l *LaunchTemplate) GetType() string {
+	return l.ComponentType
+}
+
+// GetState : returns the state of the component
+func (l *LaunchTemplate) GetState() string {
+	return l.State
+}
+
+// SetState : sets the state of the component
+func (l *LaunchTemplate) SetState(s string) {
+	l.State = s
+}
+
+// GetAction : returns the action of the component
+func (l *LaunchTemplate) GetAction() string {
+	return l.Action
+}
+
+// SetAction : Sets the action of the component
+func (l *LaunchTemplate) SetAction(s string) {
+	l.Action = s
+}
+
+// GetGroup : returns the components group
+func (l *LaunchTemplate) GetGroup() string {
+	return ""
+}
+
+// GetTags returns a components tags
+func (l *LaunchTemplate) GetTags() map[string]string {
+	return l.Tags
+}
+
+// GetTag returns a components tag
+func (l *LaunchTemplate) GetTag(tag string) string {
+	return l.Tags[tag]
+}
+
+// Diff : diff's the component against another component of the same type.
+// Any change results in a new template version rather than a replacement
+func (l *LaunchTemplate) Diff(c graph.Component) bool {
+	cl, ok := c.(*LaunchTemplate)
+	if ok {
+		if l.Type != cl.Type ||
+			l.Image != cl.Image ||
+			l.KeyPair != cl.KeyPair ||
+			l.UserData != cl.UserData {
+			return true
+		}
+
+		if l.MarketType != cl.MarketType ||
+			l.SpotMaxPrice != cl.SpotMaxPrice ||
+			l.SpotInterruptionBehaviour != cl.SpotInterruptionBehaviour {
+			return true
+		}
+
+		return !reflect.DeepEqual(l.SecurityGroups, cl.SecurityGroups)
+	}
+
+	return false
+}
+
+// Update : updates the provider returned values of a component
+func (l *LaunchTemplate) Update(c graph.Component) {
+	cl, ok := c.(*LaunchTemplate)
+	if ok {
+		l.LaunchTemplateAWSID = cl.LaunchTemplateAWSID
+		l.LatestVersion = cl.LatestVersion
+	}
+
+	l.SetDefaultVariables()
+}
+
+// Rebuild : rebuilds the component's internal state, such as templated values
+func (l *LaunchTemplate) Rebuild(g *graph.Graph) {
+	if len(l.SecurityGroupAWSIDs) > len(l.SecurityGroups) {
+		for _, sgid := range l.SecurityGroupAWSIDs {
+			sg := g.GetComponents().ByProviderID(sgid)
+			if sg != nil {
+				l.SecurityGroups = append(l.SecurityGroups, sg.GetName())
+			}
+		}
+	}
+
+	if l.KeyPair != "" {
+		l.KeyPairManaged = g.HasComponent(TYPEKEYPAIR + TYPEDELIMITER + l.KeyPair)
+	}
+
+	if l.MarketType == "" {
+		l.MarketType = MARKETONDEMAND
+	}
+
+	applyReferences(l.References())
+
+	l.SetDefaultVariables()
+}
+
+// References : returns the references the component holds to other components
+func (l *LaunchTemplate) References() []Reference {
+	var refs []Reference
+
+	for i, sg := range l.SecurityGroups {
+		refs = append(refs, refSecurityGroupID(sg).at(&l.SecurityGroupAWSIDs, i))
+	}
+
+	// key pairs are referenced by name, so only add a dependency
+	if l.KeyPairManaged {
+		refs = append(refs, refKeyPairName(l.KeyPair))
+	}
+
+	return refs
+}
+
+// Dependencies : returns a list of component id's upon which the component depends
+func (l *LaunchTemplate) Dependencies() []string {
+	return dependencies(l.References())
+}
+
+// Validate : validates the components values
+func (l *LaunchTemplate) Validate() error {
+	if l.Name == "" {
+		return errors.New("Launch Template name should not be null")
+	}
+
+	if len(l.Name) > 128 {
+		return errors.New("Launch Template name should not exceed 128 characters")
+	}
+
+	if l.Type == "" {
+		return errors.New("Launch Template instance type should not be null")
+	}
+
+	if l.Image == "" {
+		return errors.New("Launch Template image should not be null")
+	}
+
+	if len(l.SecurityGroups) != len(l.SecurityGroupAWSIDs) {
+		return errors.New("Launch Template security groups are incorrect")
+	}
+
+	err := validateMarketType(l.MarketType)
+	if err != nil {
+		return err
+	}
+
+	if l.MarketType != MARKETSPOT {
+		if l.SpotMaxPrice != "" || l.SpotInterruptionBehaviour != "" {
+			return errors.New("Launch Template spot options are only valid for the spot market type")
+		}
+
+		return nil
+	}
+
+	return validateSpotOptions(l.Type, "", l.SpotMaxPrice, l.SpotInterruptionBehaviour)
+}
+
+// IsStateful : returns true if the component needs to be actioned to be removed.
+func (l *LaunchTemplate) IsStateful() bool {
+	return true
+}
+
+// SetDefaultVariables : sets up the default template variables for a component
+func (l *LaunchTemplate) SetDefaultVariables() {
+	l.ComponentType = TYPELAUNCHTEMPLATE
+	l.ComponentID = TYPELAUNCHTEMPLATE + TYPEDELIMITER + l.Name
+	l.ProviderType = PROVIDERTYPE
+	l.DatacenterName = DATACENTERNAME
+	l.DatacenterType = DATACENTERTYPE
+	l.DatacenterRegion = DATACENTERREGION
+	l.AccessKeyID = ACCESSKEYID
+	l.SecretAccessKey = SECRETACCESSKEY
+}
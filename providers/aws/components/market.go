@@ -0,0 +1,66 @@
+/* This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at http://mozilla.org/MPL/2.0/. */
+
+package components
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+const (
+	// MARKETONDEMAND : On demand purchasing option
+	MARKETONDEMAND = "on-demand"
+	// MARKETSPOT : Spot purchasing option
+	MARKETSPOT = "spot"
+)
+
+var (
+	// SPOTINTERRUPTIONBEHAVIOURS : Actions taken when a spot instance is interrupted
+	SPOTINTERRUPTIONBEHAVIOURS = []string{"terminate", "stop", "hibernate"}
+	// SPOTUNSUPPORTEDINSTANCEFAMILIES : Instance families that cannot be launched as spot instances
+	SPOTUNSUPPORTEDINSTANCEFAMILIES = []string{"mac1", "mac2", "u-3tb1", "u-6tb1", "u-9tb1", "u-12tb1", "u-18tb1", "u-24tb1"}
+	// SPOTUNSUPPORTEDZONES : Availability zones that do not offer spot capacity
+	SPOTUNSUPPORTEDZONES = []string{"us-east-1-wl1-bos-wlz-1", "us-east-1-wl1-nyc-wlz-1", "us-west-2-wl1-las-wlz-1", "us-west-2-wl1-sfo-wlz-1", "eu-west-2-wl1-lon-wlz-1", "ap-northeast-1-wl1-nrt-wlz-1"}
+	// HIBERNATEINSTANCEFAMILIES : Instance families that support hibernation when interrupted
+	HIBERNATEINSTANCEFAMILIES = []string{"c3", "c4", "c5", "m4", "m5", "r3", "r4", "r5", "t2", "t3"}
+)
+
+func validateMarketType(t string) error {
+	if t != "" && t != MARKETONDEMAND && t != MARKETSPOT {
+		return fmt.Errorf("Market type (%s) should be one of %s or %s", t, MARKETONDEMAND, MARKETSPOT)
+	}
+
+	return nil
+}
+
+func validateSpotOptions(instanceType, zone, price, behaviour string) error {
+	family := strings.Split(instanceType, ".")[0]
+
+	if isOneOf(SPOTUNSUPPORTEDINSTANCEFAMILIES, family) {
+		return fmt.Errorf("Spot instances are not supported for instance type (%s)", instanceType)
+	}
+
+	if zone != "" && isOneOf(SPOTUNSUPPORTEDZONES, zone) {
+		return fmt.Errorf("Spot instances are not supported in availability zone (%s)", zone)
+	}
+
+	if price != "" {
+		p, err := strconv.ParseFloat(price, 64)
+		if err != nil || p <= 0 {
+			return fmt.Errorf("Spot max price (%s) should be a positive number", price)
+		}
+	}
+
+	if behaviour != "" && isOneOf(SPOTINTERRUPTIONBEHAVIOURS, behaviour) != true {
+		return fmt.Errorf("Spot interruption behaviour (%s) should be one of %s", behaviour, strings.Join(SPOTINTERRUPTIONBEHAVIOURS, ", "))
+	}
+
+	if behaviour == "hibernate" && isOneOf(HIBERNATEINSTANCEFAMILIES, family) != true {
+		return fmt.Errorf("Spot hibernation is not supported for instance type (%s)", instanceType)
+	}
+
+	return nil
+}
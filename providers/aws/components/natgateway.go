@@ -26,6 +26,7 @@ type NatGateway struct {
 	PublicNetworkAWSID     string            `json:"public_network_aws_id"`
 	NatGatewayAllocationID string            `json:"nat_gateway_allocation_id"`
 	NatGatewayAllocationIP string            `json:"nat_gateway_allocation_ip"`
+	ElasticIP              string            `json:"elastic_ip,omitempty"`
 	DatacenterType         string            `json:"datacenter_type"`
 	DatacenterName         string            `json:"datacenter_name"`
 	DatacenterRegion       string            `json:"datacenter_region"`
@@ -126,13 +127,10 @@ func (n *NatGateway) Rebuild(g *graph.Graph) {
 		}
 	}
 
-	if n.PublicNetworkAWSID != "" && n.PublicNetwork != "" {
-		n.PublicNetworkAWSID = templSubnetID(n.PublicNetwork)
-	}
-
-	if len(n.RoutedNetworks) > len(n.RoutedNetworkAWSIDs) {
-		for _, nw := range n.RoutedNetworks {
-			n.RoutedNetworkAWSIDs = append(n.RoutedNetworkAWSIDs, templSubnetID(nw))
+	if n.ElasticIP == "" && n.NatGatewayAllocationID != "" {
+		eip := g.GetComponents().ByProviderID(n.NatGatewayAllocationID)
+		if eip != nil && eip.GetType() == TYPEELASTICIP {
+			n.ElasticIP = eip.GetName()
 		}
 	}
 
@@ -145,20 +143,31 @@ func (n *NatGateway) Rebuild(g *graph.Graph) {
 		}
 	}
 
+	applyReferences(n.References())
+
 	n.SetDefaultVariables()
 }
 
-// Dependencies : returns a list of component id's upon which the component depends
-func (n *NatGateway) Dependencies() []string {
-	var deps []string
+// References : returns the references the component holds to other components
+func (n *NatGateway) References() []Reference {
+	var refs []Reference
 
-	for _, nw := range n.RoutedNetworkAWSIDs {
-		deps = append(deps, TYPENETWORK+TYPEDELIMITER+nw)
+	for i, nw := range n.RoutedNetworks {
+		refs = append(refs, refSubnetID(nw).at(&n.RoutedNetworkAWSIDs, i))
 	}
 
-	deps = append(deps, TYPENETWORK+TYPEDELIMITER+n.PublicNetwork)
+	refs = append(refs, refSubnetID(n.PublicNetwork).to(&n.PublicNetworkAWSID))
 
-	return deps
+	if n.ElasticIP != "" {
+		refs = append(refs, refElasticIPAllocationID(n.ElasticIP).to(&n.NatGatewayAllocationID))
+	}
+
+	return refs
+}
+
+// Dependencies : returns a list of component id's upon which the component depends
+func (n *NatGateway) Dependencies() []string {
+	return dependencies(n.References())
 }
 
 // Validate : validates the components values
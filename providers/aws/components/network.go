@@ -20,6 +20,7 @@ type Network struct {
 	State            string            `json:"_state"`
 	Action           string            `json:"_action"`
 	NetworkAWSID     string            `json:"network_aws_id"`
+	RouteTableAWSID  string            `json:"route_table_aws_id"`
 	Name             string            `json:"name"`
 	Subnet           string            `json:"range"`
 	IsPublic         bool              `json:"is_public"`
@@ -110,6 +111,7 @@ func (n *Network) Update(c graph.Component) {
 	cn, ok := c.(*Network)
 	if ok {
 		n.NetworkAWSID = cn.NetworkAWSID
+		n.RouteTableAWSID = cn.RouteTableAWSID
 		n.AvailabilityZone = cn.AvailabilityZone
 	}
 
@@ -125,16 +127,19 @@ func (n *Network) Rebuild(g *graph.Graph) {
 		}
 	}
 
-	if n.Vpc != "" && n.VpcID == "" {
-		n.VpcID = templVpcID(n.Vpc)
-	}
+	applyReferences(n.References())
 
 	n.SetDefaultVariables()
 }
 
+// References : returns the references the component holds to other components
+func (n *Network) References() []Reference {
+	return []Reference{refVpcID(n.Vpc).to(&n.VpcID)}
+}
+
 // Dependencies : returns a list of component id's upon which the component depends
 func (n *Network) Dependencies() []string {
-	return []string{"vpc::" + n.Vpc}
+	return dependencies(n.References())
 }
 
 // Validate : validates the components values
@@ -148,6 +153,10 @@ func (n *Network) Validate() error {
 		return errors.New("Network name should not be null")
 	}
 
+	if n.Vpc == "" {
+		return errors.New("Network vpc should not be null")
+	}
+
 	if n.IsPublic && n.Tags["ernest.nat_gateway"] != "" {
 		return errors.New("Public Network should not specify a nat gateway")
 	}
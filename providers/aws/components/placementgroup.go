@@ -0,0 +1,184 @@
+/* This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at http://mozilla.org/MPL/2.0/. */
+
+package components
+
+import (
+	"errors"
+	"fmt"
+
+	graph "gopkg.in/r3labs/graph.v2"
+)
+
+const (
+	// PLACEMENTCLUSTER : Packs instances close together in an availability zone
+	PLACEMENTCLUSTER = "cluster"
+	// PLACEMENTSPREAD : Places instances on distinct underlying hardware
+	PLACEMENTSPREAD = "spread"
+	// PLACEMENTPARTITION : Spreads instances across logical partitions
+	PLACEMENTPARTITION = "partition"
+)
+
+// PlacementGroup : mapping of a placement group component
+type PlacementGroup struct {
+	ProviderType        string            `json:"_provider"`
+	ComponentType       string            `json:"_component"`
+	ComponentID         string            `json:"_component_id"`
+	State               string            `json:"_state"`
+	Action              string            `json:"_action"`
+	PlacementGroupAWSID string            `json:"placement_group_aws_id"`
+	Name                string            `json:"name"`
+	Strategy            string            `json:"strategy"`
+	PartitionCount      int64             `json:"partition_count,omitempty"`
+	Tags                map[string]string `json:"tags"`
+	DatacenterType      string            `json:"datacenter_type,omitempty"`
+	DatacenterName      string            `json:"datacenter_name,omitempty"`
+	DatacenterRegion    string            `json:"datacenter_region"`
+	AccessKeyID         string            `json:"aws_access_key_id"`
+	SecretAccessKey     string            `json:"aws_secret_access_key"`
+	Service             string            `json:"service"`
+}
+
+// GetID : returns the component's ID
+func (p *PlacementGroup) GetID() string {
+	return p.ComponentID
+}
+
+// GetName returns a components name
+func (p *PlacementGroup) GetName() string {
+	return p.Name
+}
+
+// GetProvider : returns the provider type
+func (p *PlacementGroup) GetProvider() string {
+	return p.ProviderType
+}
+
+// GetProviderID returns a components provider id
+func (p *PlacementGroup) GetProviderID() string {
+	return p.Name
+}
+
+// GetType : returns the type of the component
+func (p *PlacementGroup) GetType() string {
+	return p.ComponentType
+}
+
+// GetState : returns the state of the component
+func (p *PlacementGroup) GetState() string {
+	return p.State
+}
+
+// SetState : sets the state of the component
+func (p *PlacementGroup) SetState(s string) {
+	p.State = s
+}
+
+// GetAction : returns the action of the component
+func (p *PlacementGroup) GetAction() string {
+	return p.Action
+}
+
+// SetAction : Sets the action of the component
+func (p *PlacementGroup) SetAction(s string) {
+	p.Action = s
+}
+
+// GetGroup : returns the components group
+func (p *PlacementGroup) GetGroup() string {
+	return ""
+}
+
+// GetTags returns a components tags
+func (p *PlacementGroup) GetTags() map[string]string {
+	return p.Tags
+}
+
+// GetTag returns a components tag
+func (p *PlacementGroup) GetTag(tag string) string {
+	return p.Tags[tag]
+}
+
+// Diff : diff's the component against another component of the same type.
+// Placement groups cannot be modified, so any change replaces the group
+func (p *PlacementGroup) Diff(c graph.Component) bool {
+	cp, ok := c.(*PlacementGroup)
+	if ok {
+		return p.Strategy != cp.Strategy || p.PartitionCount != cp.PartitionCount
+	}
+
+	return false
+}
+
+// Update : updates the provider returned values of a component
+func (p *PlacementGroup) Update(c graph.Component) {
+	cp, ok := c.(*PlacementGroup)
+	if ok {
+		p.PlacementGroupAWSID = cp.PlacementGroupAWSID
+	}
+
+	p.SetDefaultVariables()
+}
+
+// Rebuild : rebuilds the component's internal state, such as templated values
+func (p *PlacementGroup) Rebuild(g *graph.Graph) {
+	if p.Strategy == PLACEMENTPARTITION && p.PartitionCount == 0 {
+		p.PartitionCount = 2
+	}
+
+	p.SetDefaultVariables()
+}
+
+// References : returns the references the component holds to other components
+func (p *PlacementGroup) References() []Reference {
+	return nil
+}
+
+// Dependencies : returns a list of component id's upon which the component depends
+func (p *PlacementGroup) Dependencies() []string {
+	return dependencies(p.References())
+}
+
+// Validate : validates the components values
+func (p *PlacementGroup) Validate() error {
+	if p.Name == "" {
+		return errors.New("Placement Group name should not be null")
+	}
+
+	if len(p.Name) > 255 {
+		return errors.New("Placement Group name should not exceed 255 characters")
+	}
+
+	switch p.Strategy {
+	case PLACEMENTCLUSTER, PLACEMENTSPREAD:
+		if p.PartitionCount != 0 {
+			return fmt.Errorf("Placement Group partition count is only valid for the %s strategy", PLACEMENTPARTITION)
+		}
+	case PLACEMENTPARTITION:
+		if p.PartitionCount < 1 || p.PartitionCount > 7 {
+			return errors.New("Placement Group partition count should be between 1 and 7")
+		}
+	default:
+		return fmt.Errorf("Placement Group strategy should be one of %s, %s or %s", PLACEMENTCLUSTER, PLACEMENTSPREAD, PLACEMENTPARTITION)
+	}
+
+	return nil
+}
+
+// IsStateful : returns true if the component needs to be actioned to be removed.
+func (p *PlacementGroup) IsStateful() bool {
+	return true
+}
+
+// SetDefaultVariables : sets up the default template variables for a component
+func (p *PlacementGroup) SetDefaultVariables() {
+	p.ComponentType = TYPEPLACEMENTGROUP
+	p.ComponentID = TYPEPLACEMENTGROUP + TYPEDELIMITER + p.Name
+	p.ProviderType = PROVIDERTYPE
+	p.DatacenterName = DATACENTERNAME
+	p.DatacenterType = DATACENTERTYPE
+	p.DatacenterRegion = DATACENTERREGION
+	p.AccessKeyID = ACCESSKEYID
+	p.SecretAccessKey = SECRETACCESSKEY
+}
@@ -99,9 +99,14 @@ func (q *Query) Rebuild(g *graph.Graph) {
 	q.SetDefaultVariables()
 }
 
+// References : returns the references the component holds to other components
+func (q *Query) References() []Reference {
+	return nil
+}
+
 // Dependencies : returns a list of component id's upon which the component depends
 func (q *Query) Dependencies() []string {
-	return []string{}
+	return dependencies(q.References())
 }
 
 // Validate : validates the components values
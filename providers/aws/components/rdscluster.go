@@ -35,11 +35,16 @@ type RDSCluster struct {
 	DatabaseName        string            `json:"database_name,omitempty"`
 	DatabaseUsername    string            `json:"database_username,omitempty"`
 	DatabasePassword    string            `json:"database_password,omitempty"`
+	DatabaseSecret      string            `json:"database_password_secret,omitempty"`
+	DatabaseSecretARN   string            `json:"database_password_secret_arn,omitempty"`
 	BackupRetention     *int64            `json:"backup_retention,omitempty"`
 	BackupWindow        string            `json:"backup_window,omitempty"`
 	MaintenanceWindow   string            `json:"maintenance_window,omitempty"`
 	ReplicationSource   string            `json:"replication_source,omitempty"`
 	FinalSnapshot       bool              `json:"final_snapshot"`
+	StorageEncrypted    bool              `json:"storage_encrypted"`
+	EncryptionKey       string            `json:"encryption_key,omitempty"`
+	EncryptionKeyID     string            `json:"encryption_key_id,omitempty"`
 	Tags                map[string]string `json:"tags"`
 	DatacenterType      string            `json:"datacenter_type"`
 	DatacenterName      string            `json:"datacenter_name"`
@@ -119,7 +124,7 @@ func (r *RDSCluster) Diff(c graph.Component) bool {
 			}
 		}
 
-		if r.DatabasePassword != cr.DatabasePassword {
+		if r.DatabasePassword != cr.DatabasePassword || r.DatabaseSecret != cr.DatabaseSecret {
 			return true
 		}
 
@@ -137,6 +142,15 @@ func (r *RDSCluster) Diff(c graph.Component) bool {
 			return true
 		}
 
+		if r.StorageEncrypted != cr.StorageEncrypted || r.EncryptionKey != cr.EncryptionKey {
+			return true
+		}
+
+		// keys that are not managed by ernest are only known by their id
+		if r.EncryptionKey == "" && r.EncryptionKeyID != cr.EncryptionKeyID {
+			return true
+		}
+
 		if reflect.DeepEqual(r.Networks, cr.Networks) != true {
 			return true
 		}
@@ -160,12 +174,6 @@ func (r *RDSCluster) Update(c graph.Component) {
 
 // Rebuild : rebuilds the component's internal state, such as templated values
 func (r *RDSCluster) Rebuild(g *graph.Graph) {
-	if len(r.Networks) > len(r.NetworkAWSIDs) {
-		for _, nw := range r.Networks {
-			r.NetworkAWSIDs = append(r.NetworkAWSIDs, templSubnetID(nw))
-		}
-	}
-
 	if len(r.NetworkAWSIDs) > len(r.Networks) {
 		for _, nwid := range r.NetworkAWSIDs {
 			nw := g.GetComponents().ByProviderID(nwid)
@@ -175,12 +183,6 @@ func (r *RDSCluster) Rebuild(g *graph.Graph) {
 		}
 	}
 
-	if len(r.SecurityGroups) > len(r.SecurityGroupAWSIDs) {
-		for _, sg := range r.SecurityGroups {
-			r.SecurityGroupAWSIDs = append(r.SecurityGroupAWSIDs, templSecurityGroupID(sg))
-		}
-	}
-
 	if len(r.SecurityGroupAWSIDs) > len(r.SecurityGroups) {
 		for _, sgid := range r.SecurityGroupAWSIDs {
 			sg := g.GetComponents().ByProviderID(sgid)
@@ -190,22 +192,44 @@ func (r *RDSCluster) Rebuild(g *graph.Graph) {
 		}
 	}
 
+	if r.EncryptionKey == "" && r.EncryptionKeyID != "" {
+		k := g.GetComponents().ByProviderID(r.EncryptionKeyID)
+		if k != nil {
+			r.EncryptionKey = k.GetName()
+		}
+	}
+
+	applyReferences(r.References())
+
 	r.SetDefaultVariables()
 }
 
-// Dependencies : returns a list of component id's upon which the component depends
-func (r *RDSCluster) Dependencies() []string {
-	var deps []string
+// References : returns the references the component holds to other components
+func (r *RDSCluster) References() []Reference {
+	var refs []Reference
+
+	for i, sg := range r.SecurityGroups {
+		refs = append(refs, refSecurityGroupID(sg).at(&r.SecurityGroupAWSIDs, i))
+	}
+
+	for i, nw := range r.Networks {
+		refs = append(refs, refSubnetID(nw).at(&r.NetworkAWSIDs, i))
+	}
 
-	for _, sg := range r.SecurityGroups {
-		deps = append(deps, TYPESECURITYGROUP+TYPEDELIMITER+sg)
+	if r.EncryptionKey != "" {
+		refs = append(refs, refKMSKeyARN(r.EncryptionKey).to(&r.EncryptionKeyID))
 	}
 
-	for _, nw := range r.Networks {
-		deps = append(deps, TYPENETWORK+TYPEDELIMITER+nw)
+	if r.DatabaseSecret != "" {
+		refs = append(refs, refSecretARN(r.DatabaseSecret).to(&r.DatabaseSecretARN))
 	}
 
-	return deps
+	return refs
+}
+
+// Dependencies : returns a list of component id's upon which the component depends
+func (r *RDSCluster) Dependencies() []string {
+	return dependencies(r.References())
 }
 
 // Validate : validates the components values
@@ -228,6 +252,10 @@ func (r *RDSCluster) Validate() error {
 		}
 	}
 
+	if r.StorageEncrypted != true && r.EncryptionKeyID != "" {
+		return errors.New("RDS Cluster encryption key should only be set if the cluster is encrypted")
+	}
+
 	if r.DatabaseName == "" {
 		return errors.New("RDS Cluster database name should not be null")
 	}
@@ -250,17 +278,23 @@ func (r *RDSCluster) Validate() error {
 		return errors.New("RDS Cluster database username should not exceed 16 characters")
 	}
 
-	if r.DatabasePassword == "" {
-		return errors.New("RDS Cluster database password should not be null")
+	if r.DatabasePassword != "" && r.DatabaseSecret != "" {
+		return errors.New("RDS Cluster should not specify both a database password and a database password secret")
 	}
 
-	if len(r.DatabasePassword) < 8 || len(r.DatabasePassword) > 41 {
-		return errors.New("RDS Cluster database password should be between 8 and 41 characters")
-	}
+	if r.DatabaseSecret == "" {
+		if r.DatabasePassword == "" {
+			return errors.New("RDS Cluster database password should not be null")
+		}
+
+		if len(r.DatabasePassword) < 8 || len(r.DatabasePassword) > 41 {
+			return errors.New("RDS Cluster database password should be between 8 and 41 characters")
+		}
 
-	for _, c := range r.DatabasePassword {
-		if unicode.IsSymbol(c) || unicode.IsMark(c) {
-			return fmt.Errorf("RDS Cluster database password contains an offending character: '%c'", c)
+		for _, c := range r.DatabasePassword {
+			if unicode.IsSymbol(c) || unicode.IsMark(c) {
+				return fmt.Errorf("RDS Cluster database password contains an offending character: '%c'", c)
+			}
 		}
 	}
 
@@ -0,0 +1,81 @@
+/* This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at http://mozilla.org/MPL/2.0/. */
+
+package components
+
+import "strings"
+
+// Reference : a reference to an output field of another component. A component's
+// references build both its templated values and its dependencies. A reference
+// that is not bound to a field only adds a dependency
+type Reference struct {
+	Type  string
+	Name  string
+	Field string
+	set   func(string)
+}
+
+// ComponentID : returns the id of the referenced component
+func (r Reference) ComponentID() string {
+	return r.Type + TYPEDELIMITER + r.Name
+}
+
+// Template : returns the template that resolves to the referenced field
+func (r Reference) Template() string {
+	return `$(components.#[_component_id="` + r.ComponentID() + `"].` + r.Field + `)`
+}
+
+// isResolved : returns true if a value is set and is not a template
+func isResolved(value string) bool {
+	return value != "" && strings.HasPrefix(value, "$(") != true
+}
+
+// to : binds the reference to the field that holds its templated value.
+// Fields that already hold a value are not changed
+func (r Reference) to(field *string) Reference {
+	return r.with(func(t string) {
+		if *field == "" {
+			*field = t
+		}
+	})
+}
+
+// at : binds the reference to an entry of a list that holds its templated
+// value. References bound to the same list must be applied in order
+func (r Reference) at(list *[]string, index int) Reference {
+	return r.with(func(t string) {
+		if len(*list) == index {
+			*list = append(*list, t)
+		}
+	})
+}
+
+// with : binds the reference to a function that sets its templated value
+func (r Reference) with(set func(string)) Reference {
+	r.set = set
+	return r
+}
+
+// applyReferences : sets the templated value of all bound references
+func applyReferences(refs []Reference) {
+	for _, r := range refs {
+		if r.Name != "" && r.set != nil {
+			r.set(r.Template())
+		}
+	}
+}
+
+// dependencies : returns the ids of all referenced components. References without a
+// name are left to the component's Validate
+func dependencies(refs []Reference) []string {
+	var deps []string
+
+	for _, r := range refs {
+		if r.Name != "" {
+			deps = appendUnique(deps, r.ComponentID())
+		}
+	}
+
+	return deps
+}
@@ -0,0 +1,219 @@
+/* This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at http://mozilla.org/MPL/2.0/. */
+
+package components
+
+import (
+	"errors"
+	"reflect"
+	"strings"
+
+	graph "gopkg.in/r3labs/graph.v2"
+)
+
+// SecretPasswordPolicy ...
+type SecretPasswordPolicy struct {
+	Length             int64  `json:"length"`
+	ExcludeCharacters  string `json:"exclude_characters"`
+	ExcludePunctuation bool   `json:"exclude_punctuation"`
+	ExcludeNumbers     bool   `json:"exclude_numbers"`
+	IncludeSpace       bool   `json:"include_space"`
+}
+
+// SecretRotation ...
+type SecretRotation struct {
+	LambdaARN string `json:"lambda_arn"`
+	Days      int64  `json:"days"`
+}
+
+// Secret : mapping of a secrets manager secret component
+type Secret struct {
+	ProviderType     string                `json:"_provider"`
+	ComponentType    string                `json:"_component"`
+	ComponentID      string                `json:"_component_id"`
+	State            string                `json:"_state"`
+	Action           string                `json:"_action"`
+	ARN              string                `json:"secret_arn"`
+	Name             string                `json:"name"`
+	Description      string                `json:"description"`
+	KMSKey           string                `json:"kms_key,omitempty"`
+	KMSKeyID         string                `json:"kms_key_id,omitempty"`
+	PasswordPolicy   *SecretPasswordPolicy `json:"password_policy,omitempty"`
+	Rotation         *SecretRotation       `json:"rotation,omitempty"`
+	Tags             map[string]string     `json:"tags"`
+	DatacenterType   string                `json:"datacenter_type,omitempty"`
+	DatacenterName   string                `json:"datacenter_name,omitempty"`
+	DatacenterRegion string                `json:"datacenter_region"`
+	AccessKeyID      string                `json:"aws_access_key_id"`
+	SecretAccessKey  string                `json:"aws_secret_access_key"`
+	Service          string                `json:"service"`
+}
+
+// GetID : returns the component's ID
+func (x *Secret) GetID() string {
+	return x.ComponentID
+}
+
+// GetName returns a components name
+func (x *Secret) GetName() string {
+	return x.Name
+}
+
+// GetProvider : returns the provider type
+func (x *Secret) GetProvider() string {
+	return x.ProviderType
+}
+
+// GetProviderID returns a components provider id
+func (x *Secret) GetProviderID() string {
+	return x.ARN
+}
+
+// GetType : returns the type of the component
+func (x *Secret) GetType() string {
+	return x.ComponentType
+}
+
+// GetState : returns the state of the component
+func (x *Secret) GetState() string {
+	return x.State
+}
+
+// SetState : sets the state of the component
+func (x *Secret) SetState(s string) {
+	x.State = s
+}
+
+// GetAction : returns the action of the component
+func (x *Secret) GetAction() string {
+	return x.Action
+}
+
+// SetAction : Sets the action of the component
+func (x *Secret) SetAction(s string) {
+	x.Action = s
+}
+
+// GetGroup : returns the components group
+func (x *Secret) GetGroup() string {
+	return ""
+}
+
+// GetTags returns a components tags
+func (x *Secret) GetTags() map[string]string {
+	return x.Tags
+}
+
+// GetTag returns a components tag
+func (x *Secret) GetTag(tag string) string {
+	return x.Tags[tag]
+}
+
+// Diff : diff's the component against another component of the same type
+func (x *Secret) Diff(c graph.Component) bool {
+	cx, ok := c.(*Secret)
+	if ok {
+		if x.Description != cx.Description || x.KMSKey != cx.KMSKey {
+			return true
+		}
+
+		if reflect.DeepEqual(x.PasswordPolicy, cx.PasswordPolicy) != true {
+			return true
+		}
+
+		return !reflect.DeepEqual(x.Rotation, cx.Rotation)
+	}
+
+	return false
+}
+
+// Update : updates the provider returned values of a component
+func (x *Secret) Update(c graph.Component) {
+	cx, ok := c.(*Secret)
+	if ok {
+		x.ARN = cx.ARN
+	}
+
+	x.SetDefaultVariables()
+}
+
+// Rebuild : rebuilds the component's internal state, such as templated values
+func (x *Secret) Rebuild(g *graph.Graph) {
+	if x.KMSKey == "" && x.KMSKeyID != "" {
+		k := g.GetComponents().ByProviderID(x.KMSKeyID)
+		if k != nil {
+			x.KMSKey = k.GetName()
+		}
+	}
+
+	if x.PasswordPolicy != nil && x.PasswordPolicy.Length == 0 {
+		x.PasswordPolicy.Length = 32
+	}
+
+	applyReferences(x.References())
+
+	x.SetDefaultVariables()
+}
+
+// References : returns the references the component holds to other components
+func (x *Secret) References() []Reference {
+	var refs []Reference
+
+	if x.KMSKey != "" {
+		refs = append(refs, refKMSKeyARN(x.KMSKey).to(&x.KMSKeyID))
+	}
+
+	return refs
+}
+
+// Dependencies : returns a list of component id's upon which the component depends
+func (x *Secret) Dependencies() []string {
+	return dependencies(x.References())
+}
+
+// Validate : validates the components values
+func (x *Secret) Validate() error {
+	if x.Name == "" {
+		return errors.New("Secret name should not be null")
+	}
+
+	if len(x.Name) > 512 {
+		return errors.New("Secret name should not exceed 512 characters")
+	}
+
+	if x.PasswordPolicy != nil {
+		if x.PasswordPolicy.Length < 8 || x.PasswordPolicy.Length > 4096 {
+			return errors.New("Secret password length should be between 8 and 4096 characters")
+		}
+	}
+
+	if x.Rotation != nil {
+		if strings.HasPrefix(x.Rotation.LambdaARN, "arn:aws:lambda:") != true {
+			return errors.New("Secret rotation should specify a valid lambda function arn")
+		}
+
+		if x.Rotation.Days < 1 || x.Rotation.Days > 365 {
+			return errors.New("Secret rotation should be between 1 and 365 days")
+		}
+	}
+
+	return nil
+}
+
+// IsStateful : returns true if the component needs to be actioned to be removed.
+func (x *Secret) IsStateful() bool {
+	return true
+}
+
+// SetDefaultVariables : sets up the default template variables for a component
+func (x *Secret) SetDefaultVariables() {
+	x.ComponentType = TYPESECRET
+	x.ComponentID = TYPESECRET + TYPEDELIMITER + x.Name
+	x.ProviderType = PROVIDERTYPE
+	x.DatacenterName = DATACENTERNAME
+	x.DatacenterType = DATACENTERTYPE
+	x.DatacenterRegion = DATACENTERREGION
+	x.AccessKeyID = ACCESSKEYID
+	x.SecretAccessKey = SECRETACCESSKEY
+}
@@ -146,16 +146,19 @@ func (sg *SecurityGroup) Rebuild(g *graph.Graph) {
 		}
 	}
 
-	if sg.Vpc != "" && sg.VpcID == "" {
-		sg.VpcID = templVpcID(sg.Vpc)
-	}
+	applyReferences(sg.References())
 
 	sg.SetDefaultVariables()
 }
 
+// References : returns the references the component holds to other components
+func (sg *SecurityGroup) References() []Reference {
+	return []Reference{refVpcID(sg.Vpc).to(&sg.VpcID)}
+}
+
 // Dependencies : returns a list of component id's upon which the component depends
 func (sg *SecurityGroup) Dependencies() []string {
-	return []string{"vpc::" + sg.Vpc}
+	return dependencies(sg.References())
 }
 
 // Validate : validates the components values
@@ -164,6 +167,10 @@ func (sg *SecurityGroup) Validate() error {
 		return errors.New("Security Group name should not be null")
 	}
 
+	if sg.Vpc == "" {
+		return errors.New("Security Group vpc should not be null")
+	}
+
 	for _, rule := range sg.Rules.Ingress {
 		err := rule.Validate()
 		if err != nil {
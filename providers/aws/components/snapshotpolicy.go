@@ -0,0 +1,264 @@
+/* This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at http://mozilla.org/MPL/2.0/. */
+
+package components
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+
+	graph "gopkg.in/r3labs/graph.v2"
+)
+
+// SnapshotPolicyTag ...
+type SnapshotPolicyTag struct {
+	Key   string `json:"key"`
+	Value string `json:"value"`
+}
+
+// SnapshotSchedule ...
+type SnapshotSchedule struct {
+	Name         string   `json:"name"`
+	Interval     int64    `json:"interval"`
+	IntervalUnit string   `json:"interval_unit"`
+	Times        []string `json:"times"`
+	RetainCount  int64    `json:"retain_count"`
+	CopyTags     bool     `json:"copy_tags"`
+}
+
+// SnapshotPolicy : mapping of a data lifecycle manager snapshot policy component
+type SnapshotPolicy struct {
+	ProviderType        string              `json:"_provider"`
+	ComponentType       string              `json:"_component"`
+	ComponentID         string              `json:"_component_id"`
+	State               string              `json:"_state"`
+	Action              string              `json:"_action"`
+	SnapshotPolicyAWSID string              `json:"snapshot_policy_aws_id"`
+	Name                string              `json:"name"`
+	Description         string              `json:"description"`
+	ExecutionRoleARN    string              `json:"execution_role_arn"`
+	Enabled             bool                `json:"enabled"`
+	VolumeGroups        []string            `json:"volume_groups"`
+	TargetTags          []SnapshotPolicyTag `json:"target_tags"`
+	Schedules           []SnapshotSchedule  `json:"schedules"`
+	Tags                map[string]string   `json:"tags"`
+	DatacenterType      string              `json:"datacenter_type,omitempty"`
+	DatacenterName      string              `json:"datacenter_name,omitempty"`
+	DatacenterRegion    string              `json:"datacenter_region"`
+	AccessKeyID         string              `json:"aws_access_key_id"`
+	SecretAccessKey     string              `json:"aws_secret_access_key"`
+	Service             string              `json:"service"`
+}
+
+// GetID : returns the component's ID
+func (p *SnapshotPolicy) GetID() string {
+	return p.ComponentID
+}
+
+// GetName returns a components name
+func (p *SnapshotPolicy) GetName() string {
+	return p.Name
+}
+
+// GetProvider : returns the provider type
+func (p *SnapshotPolicy) GetProvider() string {
+	return p.ProviderType
+}
+
+// GetProviderID returns a components provider id
+func (p *SnapshotPolicy) GetProviderID() string {
+	return p.SnapshotPolicyAWSID
+}
+
+// GetType : returns the type of the component
+func (p *SnapshotPolicy) GetType() string {
+	return p.ComponentType
+}
+
+// GetState : returns the state of the component
+func (p *SnapshotPolicy) GetState() string {
+	return p.State
+}
+
+// SetState : sets the state of the component
+func (p *SnapshotPolicy) SetState(s string) {
+	p.State = s
+}
+
+// GetAction : returns the action of the component
+func (p *SnapshotPolicy) GetAction() string {
+	return p.Action
+}
+
+// SetAction : Sets the action of the component
+func (p *SnapshotPolicy) SetAction(s string) {
+	p.Action = s
+}
+
+// GetGroup : returns the components group
+func (p *SnapshotPolicy) GetGroup() string {
+	return ""
+}
+
+// GetTags returns a components tags
+func (p *SnapshotPolicy) GetTags() map[string]string {
+	return p.Tags
+}
+
+// GetTag returns a components tag
+func (p *SnapshotPolicy) GetTag(tag string) string {
+	return p.Tags[tag]
+}
+
+// Diff : diff's the component against another component of the same type
+func (p *SnapshotPolicy) Diff(c graph.Component) bool {
+	cp, ok := c.(*SnapshotPolicy)
+	if ok {
+		if p.Description != cp.Description ||
+			p.ExecutionRoleARN != cp.ExecutionRoleARN ||
+			p.Enabled != cp.Enabled {
+			return true
+		}
+
+		if reflect.DeepEqual(p.VolumeGroups, cp.VolumeGroups) != true {
+			return true
+		}
+
+		return !reflect.DeepEqual(p.Schedules, cp.Schedules)
+	}
+
+	return false
+}
+
+// Update : updates the provider returned values of a component
+func (p *SnapshotPolicy) Update(c graph.Component) {
+	cp, ok := c.(*SnapshotPolicy)
+	if ok {
+		p.SnapshotPolicyAWSID = cp.SnapshotPolicyAWSID
+	}
+
+	p.SetDefaultVariables()
+}
+
+// Rebuild : rebuilds the component's internal state, such as templated values
+func (p *SnapshotPolicy) Rebuild(g *graph.Graph) {
+	if p.Description == "" {
+		p.Description = p.Name
+	}
+
+	if len(p.VolumeGroups) > len(p.TargetTags) {
+		p.TargetTags = []SnapshotPolicyTag{}
+		for _, vg := range p.VolumeGroups {
+			p.TargetTags = append(p.TargetTags, SnapshotPolicyTag{Key: GROUPEBSVOLUME, Value: vg})
+		}
+	}
+
+	if len(p.TargetTags) > len(p.VolumeGroups) {
+		for _, t := range p.TargetTags {
+			if t.Key == GROUPEBSVOLUME {
+				p.VolumeGroups = appendUnique(p.VolumeGroups, t.Value)
+			}
+		}
+	}
+
+	for i := 0; i < len(p.Schedules); i++ {
+		if p.Schedules[i].IntervalUnit == "" {
+			p.Schedules[i].IntervalUnit = "HOURS"
+		}
+	}
+
+	p.SetDefaultVariables()
+}
+
+// References : returns the references the component holds to other components
+func (p *SnapshotPolicy) References() []Reference {
+	return nil
+}
+
+// Dependencies : returns a list of component id's upon which the component depends
+func (p *SnapshotPolicy) Dependencies() []string {
+	return dependencies(p.References())
+}
+
+// Validate : validates the components values
+func (p *SnapshotPolicy) Validate() error {
+	var intervals = []int64{1, 2, 3, 4, 6, 8, 12, 24}
+
+	if p.Name == "" {
+		return errors.New("Snapshot Policy name should not be null")
+	}
+
+	if len(p.Description) > 500 {
+		return errors.New("Snapshot Policy description should not exceed 500 characters")
+	}
+
+	if strings.HasPrefix(p.ExecutionRoleARN, "arn:aws:iam::") != true {
+		return errors.New("Snapshot Policy should specify a valid execution role arn")
+	}
+
+	if len(p.VolumeGroups) < 1 {
+		return errors.New("Snapshot Policy should target at least one volume group")
+	}
+
+	if len(p.Schedules) < 1 || len(p.Schedules) > 4 {
+		return errors.New("Snapshot Policy should specify between 1 and 4 schedules")
+	}
+
+	for _, s := range p.Schedules {
+		if s.Name == "" {
+			return errors.New("Snapshot Policy schedule name should not be null")
+		}
+
+		valid := false
+		for _, i := range intervals {
+			if s.Interval == i {
+				valid = true
+			}
+		}
+
+		if valid != true {
+			return fmt.Errorf("Snapshot Policy schedule (%s) interval must be one of 1, 2, 3, 4, 6, 8, 12 or 24 hours", s.Name)
+		}
+
+		if s.IntervalUnit != "HOURS" {
+			return fmt.Errorf("Snapshot Policy schedule (%s) interval unit must be HOURS", s.Name)
+		}
+
+		if len(s.Times) > 1 {
+			return fmt.Errorf("Snapshot Policy schedule (%s) should not specify more than one start time", s.Name)
+		}
+
+		for _, t := range s.Times {
+			err := validateTimeFormat(t)
+			if err != nil {
+				return fmt.Errorf("Snapshot Policy schedule (%s): %s", s.Name, err.Error())
+			}
+		}
+
+		if s.RetainCount < 1 || s.RetainCount > 1000 {
+			return fmt.Errorf("Snapshot Policy schedule (%s) retain count should be between 1 and 1000", s.Name)
+		}
+	}
+
+	return nil
+}
+
+// IsStateful : returns true if the component needs to be actioned to be removed.
+func (p *SnapshotPolicy) IsStateful() bool {
+	return true
+}
+
+// SetDefaultVariables : sets up the default template variables for a component
+func (p *SnapshotPolicy) SetDefaultVariables() {
+	p.ComponentType = TYPESNAPSHOTPOLICY
+	p.ComponentID = TYPESNAPSHOTPOLICY + TYPEDELIMITER + p.Name
+	p.ProviderType = PROVIDERTYPE
+	p.DatacenterName = DATACENTERNAME
+	p.DatacenterType = DATACENTERTYPE
+	p.DatacenterRegion = DATACENTERREGION
+	p.AccessKeyID = ACCESSKEYID
+	p.SecretAccessKey = SECRETACCESSKEY
+}
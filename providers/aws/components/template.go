@@ -5,18 +5,41 @@
 package components
 
 const (
-	TYPEDELIMITER     = "::"
-	TYPEVPC           = "vpc"
-	TYPENETWORK       = "network"
-	TYPEINSTANCE      = "instance"
-	TYPEELB           = "elb"
-	TYPEEBSVOLUME     = "ebs_volume"
-	TYPESECURITYGROUP = "security_group"
-	TYPENATGATEWAY    = "nat"
-	TYPERDSCLUSTER    = "rds_cluster"
+	TYPEDELIMITER                = "::"
+	TYPEVPC                      = "vpc"
+	TYPENETWORK                  = "network"
+	TYPEINSTANCE                 = "instance"
+	TYPEELB                      = "elb"
+	TYPEEBSVOLUME                = "ebs_volume"
+	TYPESECURITYGROUP            = "security_group"
+	TYPENATGATEWAY               = "nat"
+	TYPERDSCLUSTER               = "rds_cluster"
+	TYPEKMSKEY                   = "kms_key"
+	TYPEACMCERTIFICATE           = "acm_certificate"
+	TYPECLOUDWATCHALARM          = "cloudwatch_alarm"
+	TYPEELASTICIP                = "elastic_ip"
+	TYPEKEYPAIR                  = "key_pair"
+	TYPEVPCENDPOINT              = "vpc_endpoint"
+	TYPEECSCLUSTER               = "ecs_cluster"
+	TYPEECSTASKDEFINITION        = "ecs_task_definition"
+	TYPEECSSERVICE               = "ecs_service"
+	TYPESECRET                   = "secret"
+	TYPESNAPSHOTPOLICY           = "snapshot_policy"
+	TYPELAUNCHTEMPLATE           = "launch_template"
+	TYPEPLACEMENTGROUP           = "placement_group"
+	TYPEVPNGATEWAY               = "vpn_gateway"
+	TYPECUSTOMERGATEWAY          = "customer_gateway"
+	TYPEVPNCONNECTION            = "vpn_connection"
+	TYPETRANSITGATEWAY           = "transit_gateway"
+	TYPETRANSITGATEWAYATTACHMENT = "transit_gateway_attachment"
+	TYPEELASTICSEARCHDOMAIN      = "elasticsearch_domain"
+	TYPEEKSCLUSTER               = "eks_cluster"
+	TYPEEKSNODEGROUP             = "eks_node_group"
 
 	GROUPINSTANCE  = "ernest.instance_group"
 	GROUPEBSVOLUME = "ernest.volume_group"
+	GROUPALARM     = "ernest.alarm_group"
+	GROUPELASTICIP = "ernest.elastic_ip_group"
 
 	PROVIDERTYPE     = `$(components.#[_component_id="credentials::aws"]._provider)`
 	DATACENTERNAME   = `$(components.#[_component_id="credentials::aws"].name)`
@@ -26,22 +49,86 @@ const (
 	DATACENTERREGION = `$(components.#[_component_id="credentials::aws"].region)`
 )
 
-func templVpcID(vpc string) string {
-	return `$(components.#[_component_id="` + "vpc::" + vpc + `"].vpc_aws_id)`
+func refVpcID(vpc string) Reference {
+	return Reference{Type: TYPEVPC, Name: vpc, Field: "vpc_aws_id"}
 }
 
-func templSecurityGroupID(sg string) string {
-	return `$(components.#[_component_id="` + "security_group::" + sg + `"].security_group_aws_id)`
+func refSecurityGroupID(sg string) Reference {
+	return Reference{Type: TYPESECURITYGROUP, Name: sg, Field: "security_group_aws_id"}
 }
 
-func templSubnetID(nw string) string {
-	return `$(components.#[_component_id="` + "network::" + nw + `"].network_aws_id)`
+func refSubnetID(nw string) Reference {
+	return Reference{Type: TYPENETWORK, Name: nw, Field: "network_aws_id"}
 }
 
-func templInstanceID(in string) string {
-	return `$(components.#[_component_id="` + "instance::" + in + `"].instance_aws_id)`
+func refRouteTableID(nw string) Reference {
+	return Reference{Type: TYPENETWORK, Name: nw, Field: "route_table_aws_id"}
 }
 
-func templEBSVolumeID(ebs string) string {
-	return `$(components.#[_component_id="` + "ebs_volume::" + ebs + `"].volume_aws_id)`
+func refInstanceID(in string) Reference {
+	return Reference{Type: TYPEINSTANCE, Name: in, Field: "instance_aws_id"}
+}
+
+func refELBName(elb string) Reference {
+	return Reference{Type: TYPEELB, Name: elb, Field: "name"}
+}
+
+func refRDSClusterName(rds string) Reference {
+	return Reference{Type: TYPERDSCLUSTER, Name: rds, Field: "name"}
+}
+
+func refEBSVolumeID(ebs string) Reference {
+	return Reference{Type: TYPEEBSVOLUME, Name: ebs, Field: "volume_aws_id"}
+}
+
+func refKMSKeyARN(key string) Reference {
+	return Reference{Type: TYPEKMSKEY, Name: key, Field: "kms_key_arn"}
+}
+
+func refACMCertificateARN(cert string) Reference {
+	return Reference{Type: TYPEACMCERTIFICATE, Name: cert, Field: "certificate_arn"}
+}
+
+func refElasticIPAllocationID(eip string) Reference {
+	return Reference{Type: TYPEELASTICIP, Name: eip, Field: "elastic_ip_aws_id"}
+}
+
+func refKeyPairName(kp string) Reference {
+	return Reference{Type: TYPEKEYPAIR, Name: kp, Field: "name"}
+}
+
+func refPlacementGroupName(pg string) Reference {
+	return Reference{Type: TYPEPLACEMENTGROUP, Name: pg, Field: "name"}
+}
+
+func refECSClusterARN(cluster string) Reference {
+	return Reference{Type: TYPEECSCLUSTER, Name: cluster, Field: "cluster_arn"}
+}
+
+func refECSTaskDefinitionARN(td string) Reference {
+	return Reference{Type: TYPEECSTASKDEFINITION, Name: td, Field: "task_definition_arn"}
+}
+
+func refLaunchTemplateID(lt string) Reference {
+	return Reference{Type: TYPELAUNCHTEMPLATE, Name: lt, Field: "launch_template_aws_id"}
+}
+
+func refVpnGatewayID(vgw string) Reference {
+	return Reference{Type: TYPEVPNGATEWAY, Name: vgw, Field: "vpn_gateway_aws_id"}
+}
+
+func refCustomerGatewayID(cgw string) Reference {
+	return Reference{Type: TYPECUSTOMERGATEWAY, Name: cgw, Field: "customer_gateway_aws_id"}
+}
+
+func refTransitGatewayID(tgw string) Reference {
+	return Reference{Type: TYPETRANSITGATEWAY, Name: tgw, Field: "transit_gateway_aws_id"}
+}
+
+func refEKSClusterName(cluster string) Reference {
+	return Reference{Type: TYPEEKSCLUSTER, Name: cluster, Field: "name"}
+}
+
+func refSecretARN(secret string) Reference {
+	return Reference{Type: TYPESECRET, Name: secret, Field: "secret_arn"}
 }
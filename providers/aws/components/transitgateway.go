@@ -0,0 +1,189 @@
+/* This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at http://mozilla.org/MPL/2.0/. */
+
+package components
+
+import (
+	"errors"
+
+	graph "gopkg.in/r3labs/graph.v2"
+)
+
+// TransitGateway : mapping of a transit gateway component
+type TransitGateway struct {
+	ProviderType                 string            `json:"_provider"`
+	ComponentType                string            `json:"_component"`
+	ComponentID                  string            `json:"_component_id"`
+	State                        string            `json:"_state"`
+	Action                       string            `json:"_action"`
+	TransitGatewayAWSID          string            `json:"transit_gateway_aws_id"`
+	Name                         string            `json:"name"`
+	Description                  string            `json:"description"`
+	AmazonSideASN                *int64            `json:"amazon_side_asn,omitempty"`
+	DNSSupport                   bool              `json:"dns_support"`
+	AutoAcceptSharedAttachments  bool              `json:"auto_accept_shared_attachments"`
+	DefaultRouteTableAssociation bool              `json:"default_route_table_association"`
+	DefaultRouteTablePropagation bool              `json:"default_route_table_propagation"`
+	AssociationRouteTableAWSID   string            `json:"association_default_route_table_aws_id"`
+	PropagationRouteTableAWSID   string            `json:"propagation_default_route_table_aws_id"`
+	Tags                         map[string]string `json:"tags"`
+	DatacenterType               string            `json:"datacenter_type,omitempty"`
+	DatacenterName               string            `json:"datacenter_name,omitempty"`
+	DatacenterRegion             string            `json:"datacenter_region"`
+	AccessKeyID                  string            `json:"aws_access_key_id"`
+	SecretAccessKey              string            `json:"aws_secret_access_key"`
+	Service                      string            `json:"service"`
+}
+
+// GetID : returns the component's ID
+func (t *TransitGateway) GetID() string {
+	return t.ComponentID
+}
+
+// GetName returns a components name
+func (t *TransitGateway) GetName() string {
+	return t.Name
+}
+
+// GetProvider : returns the provider type
+func (t *TransitGateway) GetProvider() string {
+	return t.ProviderType
+}
+
+// GetProviderID returns a components provider id
+func (t *TransitGateway) GetProviderID() string {
+	return t.TransitGatewayAWSID
+}
+
+// GetType : returns the type of the component
+func (t *TransitGateway) GetType() string {
+	return t.ComponentType
+}
+
+// GetState : returns the state of the component
+func (t *TransitGateway) GetState() string {
+	return t.State
+}
+
+// SetState : sets the state of the component
+func (t *TransitGateway) SetState(s string) {
+	t.State = s
+}
+
+// GetAction : returns the action of the component
+func (t *TransitGateway) GetAction() string {
+	return t.Action
+}
+
+// SetAction : Sets the action of the component
+func (t *TransitGateway) SetAction(s string) {
+	t.Action = s
+}
+
+// GetGroup : returns the components group
+func (t *TransitGateway) GetGroup() string {
+	return ""
+}
+
+// GetTags returns a components tags
+func (t *TransitGateway) GetTags() map[string]string {
+	return t.Tags
+}
+
+// GetTag returns a components tag
+func (t *TransitGateway) GetTag(tag string) string {
+	return t.Tags[tag]
+}
+
+// Diff : diff's the component against another component of the same type
+func (t *TransitGateway) Diff(c graph.Component) bool {
+	ct, ok := c.(*TransitGateway)
+	if ok {
+		if t.Description != ct.Description ||
+			t.DNSSupport != ct.DNSSupport ||
+			t.AutoAcceptSharedAttachments != ct.AutoAcceptSharedAttachments {
+			return true
+		}
+
+		if t.DefaultRouteTableAssociation != ct.DefaultRouteTableAssociation ||
+			t.DefaultRouteTablePropagation != ct.DefaultRouteTablePropagation {
+			return true
+		}
+
+		if t.AmazonSideASN != nil && ct.AmazonSideASN != nil {
+			return *t.AmazonSideASN != *ct.AmazonSideASN
+		}
+	}
+
+	return false
+}
+
+// Update : updates the provider returned values of a component
+func (t *TransitGateway) Update(c graph.Component) {
+	ct, ok := c.(*TransitGateway)
+	if ok {
+		t.TransitGatewayAWSID = ct.TransitGatewayAWSID
+		t.AmazonSideASN = ct.AmazonSideASN
+		t.AssociationRouteTableAWSID = ct.AssociationRouteTableAWSID
+		t.PropagationRouteTableAWSID = ct.PropagationRouteTableAWSID
+	}
+
+	t.SetDefaultVariables()
+}
+
+// Rebuild : rebuilds the component's internal state, such as templated values
+func (t *TransitGateway) Rebuild(g *graph.Graph) {
+	if t.Description == "" {
+		t.Description = t.Name
+	}
+
+	t.SetDefaultVariables()
+}
+
+// References : returns the references the component holds to other components
+func (t *TransitGateway) References() []Reference {
+	return nil
+}
+
+// Dependencies : returns a list of component id's upon which the component depends
+func (t *TransitGateway) Dependencies() []string {
+	return dependencies(t.References())
+}
+
+// Validate : validates the components values
+func (t *TransitGateway) Validate() error {
+	if t.Name == "" {
+		return errors.New("Transit Gateway name should not be null")
+	}
+
+	if len(t.Description) > 255 {
+		return errors.New("Transit Gateway description should not exceed 255 characters")
+	}
+
+	if t.AmazonSideASN != nil {
+		err := validatePrivateASN(*t.AmazonSideASN)
+		if err != nil {
+			return errors.New("Transit Gateway amazon side " + err.Error())
+		}
+	}
+
+	return nil
+}
+
+// IsStateful : returns true if the component needs to be actioned to be removed.
+func (t *TransitGateway) IsStateful() bool {
+	return true
+}
+
+// SetDefaultVariables : sets up the default template variables for a component
+func (t *TransitGateway) SetDefaultVariables() {
+	t.ComponentType = TYPETRANSITGATEWAY
+	t.ComponentID = TYPETRANSITGATEWAY + TYPEDELIMITER + t.Name
+	t.ProviderType = PROVIDERTYPE
+	t.DatacenterName = DATACENTERNAME
+	t.DatacenterType = DATACENTERTYPE
+	t.DatacenterRegion = DATACENTERREGION
+	t.AccessKeyID = ACCESSKEYID
+	t.SecretAccessKey = SECRETACCESSKEY
+}
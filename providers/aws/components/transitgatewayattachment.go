@@ -0,0 +1,252 @@
+/* This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at http://mozilla.org/MPL/2.0/. */
+
+package components
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+
+	graph "gopkg.in/r3labs/graph.v2"
+)
+
+// TransitGatewayAttachment : mapping of a transit gateway vpc attachment component
+type TransitGatewayAttachment struct {
+	ProviderType                  string            `json:"_provider"`
+	ComponentType                 string            `json:"_component"`
+	ComponentID                   string            `json:"_component_id"`
+	State                         string            `json:"_state"`
+	Action                        string            `json:"_action"`
+	TransitGatewayAttachmentAWSID string            `json:"transit_gateway_attachment_aws_id"`
+	Name                          string            `json:"name"`
+	TransitGateway                string            `json:"transit_gateway"`
+	TransitGatewayAWSID           string            `json:"transit_gateway_aws_id"`
+	Vpc                           string            `json:"vpc"`
+	VpcID                         string            `json:"vpc_id"`
+	Networks                      []string          `json:"networks"`
+	NetworkAWSIDs                 []string          `json:"network_aws_ids"`
+	DNSSupport                    bool              `json:"dns_support"`
+	DefaultRouteTableAssociation  bool              `json:"default_route_table_association"`
+	DefaultRouteTablePropagation  bool              `json:"default_route_table_propagation"`
+	AssociatedRouteTableAWSID     string            `json:"associated_route_table_aws_id"`
+	PropagatedRouteTableAWSIDs    []string          `json:"propagated_route_table_aws_ids"`
+	Tags                          map[string]string `json:"tags"`
+	DatacenterType                string            `json:"datacenter_type,omitempty"`
+	DatacenterName                string            `json:"datacenter_name,omitempty"`
+	DatacenterRegion              string            `json:"datacenter_region"`
+	AccessKeyID                   string            `json:"aws_access_key_id"`
+	SecretAccessKey               string            `json:"aws_secret_access_key"`
+	Service                       string            `json:"service"`
+}
+
+// GetID : returns the component's ID
+func (t *TransitGatewayAttachment) GetID() string {
+	return t.ComponentID
+}
+
+// GetName returns a components name
+func (t *TransitGatewayAttachment) GetName() string {
+	return t.Name
+}
+
+// GetProvider : returns the provider type
+func (t *TransitGatewayAttachment) GetProvider() string {
+	return t.ProviderType
+}
+
+// GetProviderID returns a components provider id
+func (t *TransitGatewayAttachment) GetProviderID() string {
+	return t.TransitGatewayAttachmentAWSID
+}
+
+// GetType : returns the type of the component
+func (t *TransitGatewayAttachment) GetType() string {
+	return t.ComponentType
+}
+
+// GetState : returns the state of the component
+func (t *TransitGatewayAttachment) GetState() string {
+	return t.State
+}
+
+// SetState : sets the state of the component
+func (t *TransitGatewayAttachment) SetState(s string) {
+	t.State = s
+}
+
+// GetAction : returns the action of the component
+func (t *TransitGatewayAttachment) GetAction() string {
+	return t.Action
+}
+
+// SetAction : Sets the action of the component
+func (t *TransitGatewayAttachment) SetAction(s string) {
+	t.Action = s
+}
+
+// GetGroup : returns the components group
+func (t *TransitGatewayAttachment) GetGroup() string {
+	return ""
+}
+
+// GetTags returns a components tags
+func (t *TransitGatewayAttachment) GetTags() map[string]string {
+	return t.Tags
+}
+
+// GetTag returns a components tag
+func (t *TransitGatewayAttachment) GetTag(tag string) string {
+	return t.Tags[tag]
+}
+
+// Diff : diff's the component against another component of the same type
+func (t *TransitGatewayAttachment) Diff(c graph.Component) bool {
+	ct, ok := c.(*TransitGatewayAttachment)
+	if ok {
+		if t.TransitGatewayAWSID != ct.TransitGatewayAWSID && t.TransitGateway != ct.TransitGateway {
+			return true
+		}
+
+		if t.Vpc != ct.Vpc ||
+			t.DNSSupport != ct.DNSSupport ||
+			t.DefaultRouteTableAssociation != ct.DefaultRouteTableAssociation ||
+			t.DefaultRouteTablePropagation != ct.DefaultRouteTablePropagation ||
+			t.AssociatedRouteTableAWSID != ct.AssociatedRouteTableAWSID {
+			return true
+		}
+
+		if reflect.DeepEqual(t.Networks, ct.Networks) != true {
+			return true
+		}
+
+		return !reflect.DeepEqual(t.PropagatedRouteTableAWSIDs, ct.PropagatedRouteTableAWSIDs)
+	}
+
+	return false
+}
+
+// Update : updates the provider returned values of a component
+func (t *TransitGatewayAttachment) Update(c graph.Component) {
+	ct, ok := c.(*TransitGatewayAttachment)
+	if ok {
+		t.TransitGatewayAttachmentAWSID = ct.TransitGatewayAttachmentAWSID
+	}
+
+	t.SetDefaultVariables()
+}
+
+// Rebuild : rebuilds the component's internal state, such as templated values
+func (t *TransitGatewayAttachment) Rebuild(g *graph.Graph) {
+	// an unmanaged transit gateway id refers to a hub shared from another account or service
+	if t.TransitGateway == "" && t.TransitGatewayAWSID != "" {
+		tgw := g.GetComponents().ByProviderID(t.TransitGatewayAWSID)
+		if tgw != nil {
+			t.TransitGateway = tgw.GetName()
+		}
+	}
+
+	if t.Vpc == "" && t.VpcID != "" {
+		vpc := g.GetComponents().ByProviderID(t.VpcID)
+		if vpc != nil {
+			t.Vpc = vpc.GetName()
+		}
+	}
+
+	if len(t.NetworkAWSIDs) > len(t.Networks) {
+		for _, nwid := range t.NetworkAWSIDs {
+			nw := g.GetComponents().ByProviderID(nwid)
+			if nw != nil {
+				t.Networks = append(t.Networks, nw.GetName())
+			}
+		}
+	}
+
+	applyReferences(t.References())
+
+	t.SetDefaultVariables()
+}
+
+// References : returns the references the component holds to other components
+func (t *TransitGatewayAttachment) References() []Reference {
+	var refs []Reference
+
+	for i, nw := range t.Networks {
+		refs = append(refs, refSubnetID(nw).at(&t.NetworkAWSIDs, i))
+	}
+
+	if t.TransitGateway != "" {
+		refs = append(refs, refTransitGatewayID(t.TransitGateway).to(&t.TransitGatewayAWSID))
+	}
+
+	refs = append(refs, refVpcID(t.Vpc).to(&t.VpcID))
+
+	return refs
+}
+
+// Dependencies : returns a list of component id's upon which the component depends
+func (t *TransitGatewayAttachment) Dependencies() []string {
+	return dependencies(t.References())
+}
+
+// Validate : validates the components values
+func (t *TransitGatewayAttachment) Validate() error {
+	if t.Name == "" {
+		return errors.New("Transit Gateway Attachment name should not be null")
+	}
+
+	if t.TransitGateway == "" && t.TransitGatewayAWSID == "" {
+		return errors.New("Transit Gateway Attachment should specify a transit gateway or an existing transit gateway id")
+	}
+
+	if t.TransitGateway == "" && strings.HasPrefix(t.TransitGatewayAWSID, "tgw-") != true {
+		return fmt.Errorf("Transit Gateway Attachment transit gateway id (%s) is not valid", t.TransitGatewayAWSID)
+	}
+
+	if t.Vpc == "" {
+		return errors.New("Transit Gateway Attachment vpc should not be null")
+	}
+
+	if len(t.Networks) < 1 {
+		return errors.New("Transit Gateway Attachment should specify at least one network")
+	}
+
+	if len(t.Networks) != len(t.NetworkAWSIDs) {
+		return errors.New("Transit Gateway Attachment networks are incorrect")
+	}
+
+	if t.DefaultRouteTableAssociation && t.AssociatedRouteTableAWSID != "" {
+		return errors.New("Transit Gateway Attachment cannot be associated with both the default and a specific route table")
+	}
+
+	if t.AssociatedRouteTableAWSID != "" && strings.HasPrefix(t.AssociatedRouteTableAWSID, "tgw-rtb-") != true {
+		return fmt.Errorf("Transit Gateway Attachment route table id (%s) is not valid", t.AssociatedRouteTableAWSID)
+	}
+
+	for _, rtb := range t.PropagatedRouteTableAWSIDs {
+		if strings.HasPrefix(rtb, "tgw-rtb-") != true {
+			return fmt.Errorf("Transit Gateway Attachment route table id (%s) is not valid", rtb)
+		}
+	}
+
+	return nil
+}
+
+// IsStateful : returns true if the component needs to be actioned to be removed.
+func (t *TransitGatewayAttachment) IsStateful() bool {
+	return true
+}
+
+// SetDefaultVariables : sets up the default template variables for a component
+func (t *TransitGatewayAttachment) SetDefaultVariables() {
+	t.ComponentType = TYPETRANSITGATEWAYATTACHMENT
+	t.ComponentID = TYPETRANSITGATEWAYATTACHMENT + TYPEDELIMITER + t.Name
+	t.ProviderType = PROVIDERTYPE
+	t.DatacenterName = DATACENTERNAME
+	t.DatacenterType = DATACENTERTYPE
+	t.DatacenterRegion = DATACENTERREGION
+	t.AccessKeyID = ACCESSKEYID
+	t.SecretAccessKey = SECRETACCESSKEY
+}
@@ -7,6 +7,7 @@ package components
 import (
 	"errors"
 	"fmt"
+	"net"
 	"strconv"
 	"strings"
 )
@@ -127,3 +128,31 @@ func isOneOf(values []string, value string) bool {
 	}
 	return false
 }
+
+// validatePrivateASN : checks an amazon side asn is within the 16 or 32 bit private ranges
+func validatePrivateASN(asn int64) error {
+	if (asn >= 64512 && asn <= 65534) || (asn >= 4200000000 && asn <= 4294967294) {
+		return nil
+	}
+
+	return fmt.Errorf("ASN (%d) should be within the private ranges 64512 - 65534 or 4200000000 - 4294967294", asn)
+}
+
+// validatePublicIPv4 : checks an address is a routable public ipv4 address
+func validatePublicIPv4(address string) error {
+	var reserved = []string{"0.0.0.0/8", "10.0.0.0/8", "100.64.0.0/10", "127.0.0.0/8", "169.254.0.0/16", "172.16.0.0/12", "192.0.0.0/24", "192.0.2.0/24", "192.168.0.0/16", "198.18.0.0/15", "198.51.100.0/24", "203.0.113.0/24", "224.0.0.0/3"}
+
+	ip := net.ParseIP(address)
+	if ip == nil || ip.To4() == nil {
+		return fmt.Errorf("IP address (%s) should be a valid ipv4 address", address)
+	}
+
+	for _, r := range reserved {
+		_, cidr, _ := net.ParseCIDR(r)
+		if cidr.Contains(ip) {
+			return fmt.Errorf("IP address (%s) should be a public ipv4 address", address)
+		}
+	}
+
+	return nil
+}
@@ -5,27 +5,58 @@
 package components
 
 import (
+	"errors"
+	"fmt"
+	"net"
+	"reflect"
+	"strings"
+
 	graph "gopkg.in/r3labs/graph.v2"
 )
 
+// VpcDHCPOptions ...
+type VpcDHCPOptions struct {
+	DomainName        string   `json:"domain_name"`
+	DomainNameServers []string `json:"domain_name_servers"`
+}
+
+// VpcFlowLogs ...
+type VpcFlowLogs struct {
+	Destination string `json:"destination"`
+	LogGroup    string `json:"log_group,omitempty"`
+	BucketARN   string `json:"bucket_arn,omitempty"`
+	IAMRoleARN  string `json:"iam_role_arn,omitempty"`
+	TrafficType string `json:"traffic_type"`
+}
+
 // Vpc : mapping of an instance component
 type Vpc struct {
-	ProviderType     string            `json:"_provider"`
-	ComponentType    string            `json:"_component"`
-	ComponentID      string            `json:"_component_id"`
-	State            string            `json:"_state"`
-	Action           string            `json:"_action"`
-	VpcAWSID         string            `json:"vpc_aws_id"`
-	Name             string            `json:"name"`
-	Subnet           string            `json:"subnet"`
-	AutoRemove       bool              `json:"auto_remove"`
-	Tags             map[string]string `json:"tags"`
-	DatacenterType   string            `json:"datacenter_type,omitempty"`
-	DatacenterName   string            `json:"datacenter_name,omitempty"`
-	DatacenterRegion string            `json:"datacenter_region"`
-	AccessKeyID      string            `json:"aws_access_key_id"`
-	SecretAccessKey  string            `json:"aws_secret_access_key"`
-	Service          string            `json:"service"`
+	ProviderType       string            `json:"_provider"`
+	ComponentType      string            `json:"_component"`
+	ComponentID        string            `json:"_component_id"`
+	State              string            `json:"_state"`
+	Action             string            `json:"_action"`
+	VpcAWSID           string            `json:"vpc_aws_id"`
+	Name               string            `json:"name"`
+	Subnet             string            `json:"subnet"`
+	AutoRemove         bool              `json:"auto_remove"`
+	EnableDNSSupport   *bool             `json:"enable_dns_support"`
+	EnableDNSHostnames bool              `json:"enable_dns_hostnames"`
+	InstanceTenancy    string            `json:"instance_tenancy"`
+	SecondarySubnets   []string          `json:"secondary_subnets"`
+	IPv6               bool              `json:"ipv6"`
+	IPv6Subnet         string            `json:"ipv6_subnet"`
+	DHCPOptions        *VpcDHCPOptions   `json:"dhcp_options,omitempty"`
+	DHCPOptionsAWSID   string            `json:"dhcp_options_aws_id"`
+	FlowLogs           *VpcFlowLogs      `json:"flow_logs,omitempty"`
+	FlowLogAWSID       string            `json:"flow_log_aws_id"`
+	Tags               map[string]string `json:"tags"`
+	DatacenterType     string            `json:"datacenter_type,omitempty"`
+	DatacenterName     string            `json:"datacenter_name,omitempty"`
+	DatacenterRegion   string            `json:"datacenter_region"`
+	AccessKeyID        string            `json:"aws_access_key_id"`
+	SecretAccessKey    string            `json:"aws_secret_access_key"`
+	Service            string            `json:"service"`
 }
 
 // GetID : returns the component's ID
@@ -90,26 +121,144 @@ func (v *Vpc) GetTag(tag string) string {
 
 // Diff : diff's the component against another component of the same type
 func (v *Vpc) Diff(c graph.Component) bool {
+	cv, ok := c.(*Vpc)
+	if ok {
+		// vpcs stored before these values were mapped hold no dns support or tenancy
+		if v.DNSSupportEnabled() != cv.DNSSupportEnabled() ||
+			v.EnableDNSHostnames != cv.EnableDNSHostnames ||
+			orDefault(v.InstanceTenancy, "default") != orDefault(cv.InstanceTenancy, "default") ||
+			v.IPv6 != cv.IPv6 {
+			return true
+		}
+
+		if len(v.SecondarySubnets) != len(cv.SecondarySubnets) {
+			return true
+		}
+
+		for _, s := range v.SecondarySubnets {
+			if isOneOf(cv.SecondarySubnets, s) != true {
+				return true
+			}
+		}
+
+		if reflect.DeepEqual(v.DHCPOptions, cv.DHCPOptions) != true {
+			return true
+		}
+
+		return !reflect.DeepEqual(v.FlowLogs, cv.FlowLogs)
+	}
+
 	return false
 }
 
 // Update : updates the provider returned values of a component
 func (v *Vpc) Update(c graph.Component) {
+	cv, ok := c.(*Vpc)
+	if ok {
+		v.VpcAWSID = cv.VpcAWSID
+		v.IPv6Subnet = cv.IPv6Subnet
+		v.DHCPOptionsAWSID = cv.DHCPOptionsAWSID
+		v.FlowLogAWSID = cv.FlowLogAWSID
+	}
+
 	v.SetDefaultVariables()
 }
 
 // Rebuild : rebuilds the component's internal state, such as templated values
 func (v *Vpc) Rebuild(g *graph.Graph) {
+	if v.InstanceTenancy == "" {
+		v.InstanceTenancy = "default"
+	}
+
+	if v.FlowLogs != nil && v.FlowLogs.TrafficType == "" {
+		v.FlowLogs.TrafficType = "ALL"
+	}
+
 	v.SetDefaultVariables()
 }
 
+// References : returns the references the component holds to other components
+func (v *Vpc) References() []Reference {
+	return nil
+}
+
 // Dependencies : returns a list of component id's upon which the component depends
 func (v *Vpc) Dependencies() []string {
-	return []string{}
+	return dependencies(v.References())
 }
 
 // Validate : validates the components values
 func (v *Vpc) Validate() error {
+	if v.Name == "" {
+		return errors.New("VPC name should not be null")
+	}
+
+	// existing vpc's can be referenced by their id alone
+	if v.Subnet != "" || v.VpcAWSID == "" {
+		err := validateVpcCIDR(v.Subnet)
+		if err != nil {
+			return err
+		}
+	}
+
+	for _, s := range v.SecondarySubnets {
+		err := validateVpcCIDR(s)
+		if err != nil {
+			return fmt.Errorf("VPC secondary subnet: %s", err.Error())
+		}
+
+		if v.Subnet != "" && cidrOverlaps(v.Subnet, s) {
+			return fmt.Errorf("VPC secondary subnet (%s) overlaps with the vpc subnet (%s)", s, v.Subnet)
+		}
+	}
+
+	if len(v.SecondarySubnets) > 4 {
+		return errors.New("VPC should not specify more than 4 secondary subnets")
+	}
+
+	if v.InstanceTenancy != "default" && v.InstanceTenancy != "dedicated" {
+		return errors.New("VPC instance tenancy must be one of default or dedicated")
+	}
+
+	if v.EnableDNSHostnames && v.DNSSupportEnabled() != true {
+		return errors.New("VPC dns hostnames can only be enabled if dns support is enabled")
+	}
+
+	if v.DHCPOptions != nil {
+		if len(v.DHCPOptions.DomainNameServers) > 4 {
+			return errors.New("VPC dhcp options should not specify more than 4 domain name servers")
+		}
+
+		for _, ns := range v.DHCPOptions.DomainNameServers {
+			if ns != "AmazonProvidedDNS" && net.ParseIP(ns) == nil {
+				return fmt.Errorf("VPC dhcp options domain name server (%s) should be an ip address or AmazonProvidedDNS", ns)
+			}
+		}
+	}
+
+	if v.FlowLogs != nil {
+		if isOneOf([]string{"ACCEPT", "REJECT", "ALL"}, v.FlowLogs.TrafficType) != true {
+			return errors.New("VPC flow logs traffic type must be one of ACCEPT, REJECT or ALL")
+		}
+
+		switch v.FlowLogs.Destination {
+		case "cloudwatch":
+			if v.FlowLogs.LogGroup == "" {
+				return errors.New("VPC flow logs to cloudwatch should specify a log group")
+			}
+
+			if strings.HasPrefix(v.FlowLogs.IAMRoleARN, "arn:aws:iam::") != true {
+				return errors.New("VPC flow logs to cloudwatch should specify a valid iam role arn")
+			}
+		case "s3":
+			if strings.HasPrefix(v.FlowLogs.BucketARN, "arn:aws:s3:::") != true {
+				return errors.New("VPC flow logs to s3 should specify a valid bucket arn")
+			}
+		default:
+			return errors.New("VPC flow logs destination must be one of cloudwatch or s3")
+		}
+	}
+
 	return nil
 }
 
@@ -118,6 +267,12 @@ func (v *Vpc) IsStateful() bool {
 	return true
 }
 
+// DNSSupportEnabled : returns true if dns support is enabled, which
+// is the default when it has not been set
+func (v *Vpc) DNSSupportEnabled() bool {
+	return v.EnableDNSSupport == nil || *v.EnableDNSSupport
+}
+
 // SetDefaultVariables : sets up the default template variables for a component
 func (v *Vpc) SetDefaultVariables() {
 	v.ComponentType = TYPEVPC
@@ -128,3 +283,28 @@ func (v *Vpc) SetDefaultVariables() {
 	v.AccessKeyID = ACCESSKEYID
 	v.SecretAccessKey = SECRETACCESSKEY
 }
+
+// validateVpcCIDR checks a cidr is a valid ipv4 block within the /16 - /28 size limits
+func validateVpcCIDR(cidr string) error {
+	ip, n, err := net.ParseCIDR(cidr)
+	if err != nil || ip.To4() == nil {
+		return fmt.Errorf("VPC CIDR (%s) is not valid", cidr)
+	}
+
+	size, _ := n.Mask.Size()
+	if size < 16 || size > 28 {
+		return fmt.Errorf("VPC CIDR (%s) block size must be between /16 and /28", cidr)
+	}
+
+	return nil
+}
+
+func cidrOverlaps(a, b string) bool {
+	_, an, aerr := net.ParseCIDR(a)
+	_, bn, berr := net.ParseCIDR(b)
+	if aerr != nil || berr != nil {
+		return false
+	}
+
+	return an.Contains(bn.IP) || bn.Contains(an.IP)
+}
@@ -0,0 +1,349 @@
+/* This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at http://mozilla.org/MPL/2.0/. */
+
+package components
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+
+	graph "gopkg.in/r3labs/graph.v2"
+)
+
+const (
+	// ENDPOINTGATEWAY : gateway endpoint type
+	ENDPOINTGATEWAY = "Gateway"
+	// ENDPOINTINTERFACE : interface endpoint type
+	ENDPOINTINTERFACE = "Interface"
+)
+
+// ENDPOINTGATEWAYSERVICES : services that can be reached through a gateway endpoint
+var ENDPOINTGATEWAYSERVICES = []string{"s3", "dynamodb"}
+
+// ENDPOINTINTERFACESERVICES : services that can be reached through an interface endpoint
+var ENDPOINTINTERFACESERVICES = []string{
+	"s3", "ec2", "ec2messages", "ssm", "ssmmessages", "kms", "logs", "monitoring", "events",
+	"sns", "sqs", "secretsmanager", "sts", "ecr.api", "ecr.dkr", "ecs", "ecs-agent", "ecs-telemetry",
+	"elasticloadbalancing", "autoscaling", "cloudformation", "codebuild", "kinesis-streams",
+	"execute-api", "elasticfilesystem", "rds", "lambda",
+}
+
+// AWSREGIONS : regions that vpc endpoint services can be resolved against
+var AWSREGIONS = []string{
+	"us-east-1", "us-east-2", "us-west-1", "us-west-2", "ca-central-1", "sa-east-1",
+	"eu-west-1", "eu-west-2", "eu-west-3", "eu-central-1", "eu-north-1", "eu-south-1",
+	"ap-south-1", "ap-northeast-1", "ap-northeast-2", "ap-northeast-3", "ap-southeast-1",
+	"ap-southeast-2", "ap-east-1", "me-south-1", "af-south-1",
+}
+
+// ENDPOINTUNAVAILABLESERVICES : interface endpoint services that are not offered in a region
+var ENDPOINTUNAVAILABLESERVICES = map[string][]string{
+	"ap-northeast-3": {"codebuild", "ecs", "ecs-agent", "ecs-telemetry", "elasticfilesystem"},
+	"af-south-1":     {"codebuild", "elasticfilesystem"},
+	"eu-south-1":     {"codebuild"},
+	"me-south-1":     {"elasticfilesystem"},
+}
+
+// ENDPOINTREGIONSERVICES : interface endpoint services offered in each region
+var ENDPOINTREGIONSERVICES = endpointRegionServices()
+
+func endpointRegionServices() map[string][]string {
+	services := make(map[string][]string)
+
+	for _, region := range AWSREGIONS {
+		for _, service := range ENDPOINTINTERFACESERVICES {
+			if isOneOf(ENDPOINTUNAVAILABLESERVICES[region], service) != true {
+				services[region] = append(services[region], service)
+			}
+		}
+	}
+
+	return services
+}
+
+// VpcEndpoint : mapping of a vpc endpoint component
+type VpcEndpoint struct {
+	ProviderType        string            `json:"_provider"`
+	ComponentType       string            `json:"_component"`
+	ComponentID         string            `json:"_component_id"`
+	State               string            `json:"_state"`
+	Action              string            `json:"_action"`
+	VpcEndpointAWSID    string            `json:"vpc_endpoint_aws_id"`
+	Name                string            `json:"name"`
+	AWSService          string            `json:"aws_service"`
+	ServiceName         string            `json:"service_name"`
+	EndpointType        string            `json:"endpoint_type"`
+	PrivateDNS          bool              `json:"private_dns"`
+	Policy              string            `json:"policy,omitempty"`
+	Networks            []string          `json:"networks"`
+	NetworkAWSIDs       []string          `json:"network_aws_ids"`
+	RouteTableAWSIDs    []string          `json:"route_table_aws_ids"`
+	SecurityGroups      []string          `json:"security_groups"`
+	SecurityGroupAWSIDs []string          `json:"security_group_aws_ids"`
+	DNSNames            []string          `json:"dns_names"`
+	Tags                map[string]string `json:"tags"`
+	DatacenterType      string            `json:"datacenter_type,omitempty"`
+	DatacenterName      string            `json:"datacenter_name,omitempty"`
+	DatacenterRegion    string            `json:"datacenter_region"`
+	AccessKeyID         string            `json:"aws_access_key_id"`
+	SecretAccessKey     string            `json:"aws_secret_access_key"`
+	Vpc                 string            `json:"vpc"`
+	VpcID               string            `json:"vpc_id"`
+	Service             string            `json:"service"`
+}
+
+// GetID : returns the component's ID
+func (v *VpcEndpoint) GetID() string {
+	return v.ComponentID
+}
+
+// GetName returns a components name
+func (v *VpcEndpoint) GetName() string {
+	return v.Name
+}
+
+// GetProvider : returns the provider type
+func (v *VpcEndpoint) GetProvider() string {
+	return v.ProviderType
+}
+
+// GetProviderID returns a components provider id
+func (v *VpcEndpoint) GetProviderID() string {
+	return v.VpcEndpointAWSID
+}
+
+// GetType : returns the type of the component
+func (v *VpcEndpoint) GetType() string {
+	return v.ComponentType
+}
+
+// GetState : returns the state of the component
+func (v *VpcEndpoint) GetState() string {
+	return v.State
+}
+
+// SetState : sets the state of the component
+func (v *VpcEndpoint) SetState(s string) {
+	v.State = s
+}
+
+// GetAction : returns the action of the component
+func (v *VpcEndpoint) GetAction() string {
+	return v.Action
+}
+
+// SetAction : Sets the action of the component
+func (v *VpcEndpoint) SetAction(s string) {
+	v.Action = s
+}
+
+// GetGroup : returns the components group
+func (v *VpcEndpoint) GetGroup() string {
+	return ""
+}
+
+// GetTags returns a components tags
+func (v *VpcEndpoint) GetTags() map[string]string {
+	return v.Tags
+}
+
+// GetTag returns a components tag
+func (v *VpcEndpoint) GetTag(tag string) string {
+	return v.Tags[tag]
+}
+
+// Diff : diff's the component against another component of the same type
+func (v *VpcEndpoint) Diff(c graph.Component) bool {
+	cv, ok := c.(*VpcEndpoint)
+	if ok {
+		if v.Policy != cv.Policy || v.PrivateDNS != cv.PrivateDNS {
+			return true
+		}
+
+		if reflect.DeepEqual(v.Networks, cv.Networks) != true {
+			return true
+		}
+
+		return !reflect.DeepEqual(v.SecurityGroups, cv.SecurityGroups)
+	}
+
+	return false
+}
+
+// Update : updates the provider returned values of a component
+func (v *VpcEndpoint) Update(c graph.Component) {
+	cv, ok := c.(*VpcEndpoint)
+	if ok {
+		v.VpcEndpointAWSID = cv.VpcEndpointAWSID
+		v.DNSNames = cv.DNSNames
+	}
+
+	v.SetDefaultVariables()
+}
+
+// Rebuild : rebuilds the component's internal state, such as templated values
+func (v *VpcEndpoint) Rebuild(g *graph.Graph) {
+	if v.Vpc == "" && v.VpcID != "" {
+		vpc := g.GetComponents().ByProviderID(v.VpcID)
+		if vpc != nil {
+			v.Vpc = vpc.GetName()
+		}
+	}
+
+	if v.EndpointType == "" {
+		v.EndpointType = ENDPOINTGATEWAY
+		if isOneOf(ENDPOINTGATEWAYSERVICES, v.AWSService) != true {
+			v.EndpointType = ENDPOINTINTERFACE
+		}
+	}
+
+	if v.ServiceName == "" {
+		v.ServiceName = "com.amazonaws." + DATACENTERREGION + "." + v.AWSService
+	}
+
+	if len(v.NetworkAWSIDs) > len(v.Networks) {
+		for _, nwid := range v.NetworkAWSIDs {
+			nw := g.GetComponents().ByProviderID(nwid)
+			if nw != nil {
+				v.Networks = append(v.Networks, nw.GetName())
+			}
+		}
+	}
+
+	if len(v.SecurityGroupAWSIDs) > len(v.SecurityGroups) {
+		for _, sgid := range v.SecurityGroupAWSIDs {
+			sg := g.GetComponents().ByProviderID(sgid)
+			if sg != nil {
+				v.SecurityGroups = append(v.SecurityGroups, sg.GetName())
+			}
+		}
+	}
+
+	applyReferences(v.References())
+
+	v.SetDefaultVariables()
+}
+
+// References : returns the references the component holds to other components
+func (v *VpcEndpoint) References() []Reference {
+	var refs []Reference
+
+	for i, sg := range v.SecurityGroups {
+		refs = append(refs, refSecurityGroupID(sg).at(&v.SecurityGroupAWSIDs, i))
+	}
+
+	for i, nw := range v.Networks {
+		switch v.EndpointType {
+		case ENDPOINTGATEWAY:
+			refs = append(refs, refRouteTableID(nw).at(&v.RouteTableAWSIDs, i))
+		case ENDPOINTINTERFACE:
+			refs = append(refs, refSubnetID(nw).at(&v.NetworkAWSIDs, i))
+		}
+	}
+
+	refs = append(refs, refVpcID(v.Vpc).to(&v.VpcID))
+
+	return refs
+}
+
+// Dependencies : returns a list of component id's upon which the component depends
+func (v *VpcEndpoint) Dependencies() []string {
+	return dependencies(v.References())
+}
+
+// Validate : validates the components values
+func (v *VpcEndpoint) Validate() error {
+	if v.Name == "" {
+		return errors.New("VPC Endpoint name should not be null")
+	}
+
+	if v.Vpc == "" {
+		return errors.New("VPC Endpoint vpc should not be null")
+	}
+
+	region, err := validateEndpointServiceName(v.ServiceName, v.AWSService, v.DatacenterRegion)
+	if err != nil {
+		return err
+	}
+
+	switch v.EndpointType {
+	case ENDPOINTGATEWAY:
+		if isOneOf(ENDPOINTGATEWAYSERVICES, v.AWSService) != true {
+			return fmt.Errorf("VPC Endpoint service (%s) does not support gateway endpoints, must be one of %s", v.AWSService, strings.Join(ENDPOINTGATEWAYSERVICES, ", "))
+		}
+
+		if len(v.SecurityGroups) > 0 {
+			return errors.New("VPC Endpoint of type gateway should not specify security groups")
+		}
+
+		if v.PrivateDNS {
+			return errors.New("VPC Endpoint of type gateway does not support private dns")
+		}
+	case ENDPOINTINTERFACE:
+		if isOneOf(ENDPOINTINTERFACESERVICES, v.AWSService) != true {
+			return fmt.Errorf("VPC Endpoint service (%s) does not support interface endpoints", v.AWSService)
+		}
+
+		if len(v.Networks) < 1 {
+			return errors.New("VPC Endpoint of type interface should specify at least one network")
+		}
+
+		if region != "" && isOneOf(ENDPOINTREGIONSERVICES[region], v.AWSService) != true {
+			return fmt.Errorf("VPC Endpoint service (%s) is not offered in region (%s)", v.AWSService, region)
+		}
+	default:
+		return errors.New("VPC Endpoint type must be one of gateway or interface")
+	}
+
+	if v.Policy != "" && json.Valid([]byte(v.Policy)) != true {
+		return errors.New("VPC Endpoint policy is not a valid json document")
+	}
+
+	return nil
+}
+
+// IsStateful : returns true if the component needs to be actioned to be removed.
+func (v *VpcEndpoint) IsStateful() bool {
+	return true
+}
+
+// SetDefaultVariables : sets up the default template variables for a component
+func (v *VpcEndpoint) SetDefaultVariables() {
+	v.ComponentType = TYPEVPCENDPOINT
+	v.ComponentID = TYPEVPCENDPOINT + TYPEDELIMITER + v.Name
+	v.ProviderType = PROVIDERTYPE
+	v.DatacenterName = DATACENTERNAME
+	v.DatacenterType = DATACENTERTYPE
+	v.DatacenterRegion = DATACENTERREGION
+	v.AccessKeyID = ACCESSKEYID
+	v.SecretAccessKey = SECRETACCESSKEY
+}
+
+// validateEndpointServiceName checks a service name resolves to a known
+// service in either the credentials region or an explicitly named region,
+// returning the region. The credentials region is only returned once it
+// has been resolved
+func validateEndpointServiceName(name, service, credentialsRegion string) (string, error) {
+	if name == "com.amazonaws."+DATACENTERREGION+"."+service {
+		name = "com.amazonaws." + credentialsRegion + "." + service
+		if isResolved(credentialsRegion) != true {
+			return "", nil
+		}
+	}
+
+	prefix := "com.amazonaws."
+	if strings.HasPrefix(name, prefix) != true || strings.HasSuffix(name, "."+service) != true {
+		return "", fmt.Errorf("VPC Endpoint service name (%s) does not match service (%s)", name, service)
+	}
+
+	region := strings.TrimSuffix(strings.TrimPrefix(name, prefix), "."+service)
+	if isOneOf(AWSREGIONS, region) != true {
+		return "", fmt.Errorf("VPC Endpoint service name (%s) specifies an unknown region (%s)", name, region)
+	}
+
+	return region, nil
+}
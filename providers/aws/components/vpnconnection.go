@@ -0,0 +1,231 @@
+/* This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at http://mozilla.org/MPL/2.0/. */
+
+package components
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"reflect"
+
+	graph "gopkg.in/r3labs/graph.v2"
+)
+
+// VpnTunnel ...
+type VpnTunnel struct {
+	OutsideIPAddress string `json:"outside_ip_address"`
+	InsideCIDR       string `json:"inside_cidr"`
+}
+
+// VpnConnection : mapping of a site to site vpn connection component
+type VpnConnection struct {
+	ProviderType         string            `json:"_provider"`
+	ComponentType        string            `json:"_component"`
+	ComponentID          string            `json:"_component_id"`
+	State                string            `json:"_state"`
+	Action               string            `json:"_action"`
+	VpnConnectionAWSID   string            `json:"vpn_connection_aws_id"`
+	Name                 string            `json:"name"`
+	VpnGateway           string            `json:"vpn_gateway"`
+	VpnGatewayAWSID      string            `json:"vpn_gateway_aws_id"`
+	CustomerGateway      string            `json:"customer_gateway"`
+	CustomerGatewayAWSID string            `json:"customer_gateway_aws_id"`
+	StaticRoutesOnly     bool              `json:"static_routes_only"`
+	StaticRoutes         []string          `json:"static_routes"`
+	Networks             []string          `json:"networks"`
+	RouteTableAWSIDs     []string          `json:"route_table_aws_ids"`
+	Tunnels              []VpnTunnel       `json:"tunnels"`
+	Tags                 map[string]string `json:"tags"`
+	DatacenterType       string            `json:"datacenter_type,omitempty"`
+	DatacenterName       string            `json:"datacenter_name,omitempty"`
+	DatacenterRegion     string            `json:"datacenter_region"`
+	AccessKeyID          string            `json:"aws_access_key_id"`
+	SecretAccessKey      string            `json:"aws_secret_access_key"`
+	Service              string            `json:"service"`
+}
+
+// GetID : returns the component's ID
+func (v *VpnConnection) GetID() string {
+	return v.ComponentID
+}
+
+// GetName returns a components name
+func (v *VpnConnection) GetName() string {
+	return v.Name
+}
+
+// GetProvider : returns the provider type
+func (v *VpnConnection) GetProvider() string {
+	return v.ProviderType
+}
+
+// GetProviderID returns a components provider id
+func (v *VpnConnection) GetProviderID() string {
+	return v.VpnConnectionAWSID
+}
+
+// GetType : returns the type of the component
+func (v *VpnConnection) GetType() string {
+	return v.ComponentType
+}
+
+// GetState : returns the state of the component
+func (v *VpnConnection) GetState() string {
+	return v.State
+}
+
+// SetState : sets the state of the component
+func (v *VpnConnection) SetState(s string) {
+	v.State = s
+}
+
+// GetAction : returns the action of the component
+func (v *VpnConnection) GetAction() string {
+	return v.Action
+}
+
+// SetAction : Sets the action of the component
+func (v *VpnConnection) SetAction(s string) {
+	v.Action = s
+}
+
+// GetGroup : returns the components group
+func (v *VpnConnection) GetGroup() string {
+	return ""
+}
+
+// GetTags returns a components tags
+func (v *VpnConnection) GetTags() map[string]string {
+	return v.Tags
+}
+
+// GetTag returns a components tag
+func (v *VpnConnection) GetTag(tag string) string {
+	return v.Tags[tag]
+}
+
+// Diff : diff's the component against another component of the same type
+func (v *VpnConnection) Diff(c graph.Component) bool {
+	cv, ok := c.(*VpnConnection)
+	if ok {
+		if v.VpnGateway != cv.VpnGateway ||
+			v.CustomerGateway != cv.CustomerGateway ||
+			v.StaticRoutesOnly != cv.StaticRoutesOnly {
+			return true
+		}
+
+		if reflect.DeepEqual(v.StaticRoutes, cv.StaticRoutes) != true {
+			return true
+		}
+
+		return !reflect.DeepEqual(v.Networks, cv.Networks)
+	}
+
+	return false
+}
+
+// Update : updates the provider returned values of a component
+func (v *VpnConnection) Update(c graph.Component) {
+	cv, ok := c.(*VpnConnection)
+	if ok {
+		v.VpnConnectionAWSID = cv.VpnConnectionAWSID
+		v.Tunnels = cv.Tunnels
+	}
+
+	v.SetDefaultVariables()
+}
+
+// Rebuild : rebuilds the component's internal state, such as templated values
+func (v *VpnConnection) Rebuild(g *graph.Graph) {
+	if v.VpnGateway == "" && v.VpnGatewayAWSID != "" {
+		vgw := g.GetComponents().ByProviderID(v.VpnGatewayAWSID)
+		if vgw != nil {
+			v.VpnGateway = vgw.GetName()
+		}
+	}
+
+	if v.CustomerGateway == "" && v.CustomerGatewayAWSID != "" {
+		cgw := g.GetComponents().ByProviderID(v.CustomerGatewayAWSID)
+		if cgw != nil {
+			v.CustomerGateway = cgw.GetName()
+		}
+	}
+
+	applyReferences(v.References())
+
+	v.SetDefaultVariables()
+}
+
+// References : returns the references the component holds to other components
+func (v *VpnConnection) References() []Reference {
+	var refs []Reference
+
+	for i, nw := range v.Networks {
+		refs = append(refs, refRouteTableID(nw).at(&v.RouteTableAWSIDs, i))
+	}
+
+	refs = append(refs, refVpnGatewayID(v.VpnGateway).to(&v.VpnGatewayAWSID))
+	refs = append(refs, refCustomerGatewayID(v.CustomerGateway).to(&v.CustomerGatewayAWSID))
+
+	return refs
+}
+
+// Dependencies : returns a list of component id's upon which the component depends
+func (v *VpnConnection) Dependencies() []string {
+	return dependencies(v.References())
+}
+
+// Validate : validates the components values
+func (v *VpnConnection) Validate() error {
+	if v.Name == "" {
+		return errors.New("VPN Connection name should not be null")
+	}
+
+	if v.VpnGateway == "" {
+		return errors.New("VPN Connection vpn gateway should not be null")
+	}
+
+	if v.CustomerGateway == "" {
+		return errors.New("VPN Connection customer gateway should not be null")
+	}
+
+	if v.StaticRoutesOnly != true && len(v.StaticRoutes) > 0 {
+		return errors.New("VPN Connection static routes can only be specified when static routing is enabled")
+	}
+
+	if v.StaticRoutesOnly && len(v.StaticRoutes) < 1 {
+		return errors.New("VPN Connection should specify at least one static route when static routing is enabled")
+	}
+
+	for _, r := range v.StaticRoutes {
+		_, _, err := net.ParseCIDR(r)
+		if err != nil {
+			return fmt.Errorf("VPN Connection static route (%s) is not a valid CIDR", r)
+		}
+	}
+
+	if len(v.Networks) != len(v.RouteTableAWSIDs) {
+		return errors.New("VPN Connection networks are incorrect")
+	}
+
+	return nil
+}
+
+// IsStateful : returns true if the component needs to be actioned to be removed.
+func (v *VpnConnection) IsStateful() bool {
+	return true
+}
+
+// SetDefaultVariables : sets up the default template variables for a component
+func (v *VpnConnection) SetDefaultVariables() {
+	v.ComponentType = TYPEVPNCONNECTION
+	v.ComponentID = TYPEVPNCONNECTION + TYPEDELIMITER + v.Name
+	v.ProviderType = PROVIDERTYPE
+	v.DatacenterName = DATACENTERNAME
+	v.DatacenterType = DATACENTERTYPE
+	v.DatacenterRegion = DATACENTERREGION
+	v.AccessKeyID = ACCESSKEYID
+	v.SecretAccessKey = SECRETACCESSKEY
+}
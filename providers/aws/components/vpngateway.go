@@ -0,0 +1,180 @@
+/* This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at http://mozilla.org/MPL/2.0/. */
+
+package components
+
+import (
+	"errors"
+
+	graph "gopkg.in/r3labs/graph.v2"
+)
+
+// VpnGateway : mapping of a virtual private gateway component
+type VpnGateway struct {
+	ProviderType     string            `json:"_provider"`
+	ComponentType    string            `json:"_component"`
+	ComponentID      string            `json:"_component_id"`
+	State            string            `json:"_state"`
+	Action           string            `json:"_action"`
+	VpnGatewayAWSID  string            `json:"vpn_gateway_aws_id"`
+	Name             string            `json:"name"`
+	AmazonSideASN    *int64            `json:"amazon_side_asn,omitempty"`
+	Vpc              string            `json:"vpc"`
+	VpcID            string            `json:"vpc_id"`
+	Tags             map[string]string `json:"tags"`
+	DatacenterType   string            `json:"datacenter_type,omitempty"`
+	DatacenterName   string            `json:"datacenter_name,omitempty"`
+	DatacenterRegion string            `json:"datacenter_region"`
+	AccessKeyID      string            `json:"aws_access_key_id"`
+	SecretAccessKey  string            `json:"aws_secret_access_key"`
+	Service          string            `json:"service"`
+}
+
+// GetID : returns the component's ID
+func (v *VpnGateway) GetID() string {
+	return v.ComponentID
+}
+
+// GetName returns a components name
+func (v *VpnGateway) GetName() string {
+	return v.Name
+}
+
+// GetProvider : returns the provider type
+func (v *VpnGateway) GetProvider() string {
+	return v.ProviderType
+}
+
+// GetProviderID returns a components provider id
+func (v *VpnGateway) GetProviderID() string {
+	return v.VpnGatewayAWSID
+}
+
+// GetType : returns the type of the component
+func (v *VpnGateway) GetType() string {
+	return v.ComponentType
+}
+
+// GetState : returns the state of the component
+func (v *VpnGateway) GetState() string {
+	return v.State
+}
+
+// SetState : sets the state of the component
+func (v *VpnGateway) SetState(s string) {
+	v.State = s
+}
+
+// GetAction : returns the action of the component
+func (v *VpnGateway) GetAction() string {
+	return v.Action
+}
+
+// SetAction : Sets the action of the component
+func (v *VpnGateway) SetAction(s string) {
+	v.Action = s
+}
+
+// GetGroup : returns the components group
+func (v *VpnGateway) GetGroup() string {
+	return ""
+}
+
+// GetTags returns a components tags
+func (v *VpnGateway) GetTags() map[string]string {
+	return v.Tags
+}
+
+// GetTag returns a components tag
+func (v *VpnGateway) GetTag(tag string) string {
+	return v.Tags[tag]
+}
+
+// Diff : diff's the component against another component of the same type
+func (v *VpnGateway) Diff(c graph.Component) bool {
+	cv, ok := c.(*VpnGateway)
+	if ok {
+		if v.Vpc != cv.Vpc {
+			return true
+		}
+
+		if v.AmazonSideASN != nil && cv.AmazonSideASN != nil {
+			return *v.AmazonSideASN != *cv.AmazonSideASN
+		}
+	}
+
+	return false
+}
+
+// Update : updates the provider returned values of a component
+func (v *VpnGateway) Update(c graph.Component) {
+	cv, ok := c.(*VpnGateway)
+	if ok {
+		v.VpnGatewayAWSID = cv.VpnGatewayAWSID
+		v.AmazonSideASN = cv.AmazonSideASN
+	}
+
+	v.SetDefaultVariables()
+}
+
+// Rebuild : rebuilds the component's internal state, such as templated values
+func (v *VpnGateway) Rebuild(g *graph.Graph) {
+	if v.Vpc == "" && v.VpcID != "" {
+		vpc := g.GetComponents().ByProviderID(v.VpcID)
+		if vpc != nil {
+			v.Vpc = vpc.GetName()
+		}
+	}
+
+	applyReferences(v.References())
+
+	v.SetDefaultVariables()
+}
+
+// References : returns the references the component holds to other components
+func (v *VpnGateway) References() []Reference {
+	return []Reference{refVpcID(v.Vpc).to(&v.VpcID)}
+}
+
+// Dependencies : returns a list of component id's upon which the component depends
+func (v *VpnGateway) Dependencies() []string {
+	return dependencies(v.References())
+}
+
+// Validate : validates the components values
+func (v *VpnGateway) Validate() error {
+	if v.Name == "" {
+		return errors.New("VPN Gateway name should not be null")
+	}
+
+	if v.Vpc == "" {
+		return errors.New("VPN Gateway vpc should not be null")
+	}
+
+	if v.AmazonSideASN != nil {
+		err := validatePrivateASN(*v.AmazonSideASN)
+		if err != nil {
+			return errors.New("VPN Gateway amazon side " + err.Error())
+		}
+	}
+
+	return nil
+}
+
+// IsStateful : returns true if the component needs to be actioned to be removed.
+func (v *VpnGateway) IsStateful() bool {
+	return true
+}
+
+// SetDefaultVariables : sets up the default template variables for a component
+func (v *VpnGateway) SetDefaultVariables() {
+	v.ComponentType = TYPEVPNGATEWAY
+	v.ComponentID = TYPEVPNGATEWAY + TYPEDELIMITER + v.Name
+	v.ProviderType = PROVIDERTYPE
+	v.DatacenterName = DATACENTERNAME
+	v.DatacenterType = DATACENTERTYPE
+	v.DatacenterRegion = DATACENTERREGION
+	v.AccessKeyID = ACCESSKEYID
+	v.SecretAccessKey = SECRETACCESSKEY
+}
@@ -0,0 +1,17 @@
+/* This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at http://mozilla.org/MPL/2.0/. */
+
+package definition
+
+// ACMCertificate ...
+type ACMCertificate struct {
+	Name             string   `json:"name"`
+	DomainName       string   `json:"domain_name"`
+	AlternativeNames []string `json:"alternative_names"`
+	Validation       string   `json:"validation"`
+	HostedZoneID     string   `json:"hosted_zone_id"`
+	CertificateBody  string   `json:"certificate_body"`
+	CertificateChain string   `json:"certificate_chain"`
+	PrivateKey       string   `json:"private_key"`
+}
@@ -0,0 +1,18 @@
+/* This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at http://mozilla.org/MPL/2.0/. */
+
+package definition
+
+// CloudWatchAlarm ...
+type CloudWatchAlarm struct {
+	Name              string   `json:"name"`
+	Metric            string   `json:"metric"`
+	Namespace         string   `json:"namespace"`
+	Statistic         string   `json:"statistic"`
+	Comparison        string   `json:"comparison"`
+	Threshold         float64  `json:"threshold"`
+	Period            int64    `json:"period"`
+	EvaluationPeriods int64    `json:"evaluation_periods"`
+	Actions           []string `json:"actions"`
+}
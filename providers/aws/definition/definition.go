@@ -12,16 +12,36 @@ import (
 
 // Definition ...
 type Definition struct {
-	Name           string          `json:"name"`
-	Datacenter     string          `json:"datacenter"`
-	Vpcs           []Vpc           `json:"vpcs,omitempty"`
-	Networks       []Network       `json:"networks,omitempty"`
-	Instances      []Instance      `json:"instances,omitempty"`
-	SecurityGroups []SecurityGroup `json:"security_groups,omitempty"`
-	ELBs           []ELB           `json:"loadbalancers,omitempty"`
-	EBSVolumes     []EBSVolume     `json:"ebs_volumes,omitempty"`
-	NatGateways    []NatGateway    `json:"nat_gateways,omitempty"`
-	RDSClusters    []RDSCluster    `json:"rds_clusters,omitempty"`
+	Name                      string                     `json:"name"`
+	Datacenter                string                     `json:"datacenter"`
+	Vpcs                      []Vpc                      `json:"vpcs,omitempty"`
+	Networks                  []Network                  `json:"networks,omitempty"`
+	Instances                 []Instance                 `json:"instances,omitempty"`
+	SecurityGroups            []SecurityGroup            `json:"security_groups,omitempty"`
+	ELBs                      []ELB                      `json:"loadbalancers,omitempty"`
+	EBSVolumes                []EBSVolume                `json:"ebs_volumes,omitempty"`
+	NatGateways               []NatGateway               `json:"nat_gateways,omitempty"`
+	RDSClusters               []RDSCluster               `json:"rds_clusters,omitempty"`
+	KMSKeys                   []KMSKey                   `json:"kms_keys,omitempty"`
+	ACMCertificates           []ACMCertificate           `json:"acm_certificates,omitempty"`
+	ElasticIPs                []ElasticIP                `json:"elastic_ips,omitempty"`
+	KeyPairs                  []KeyPair                  `json:"key_pairs,omitempty"`
+	VpcEndpoints              []VpcEndpoint              `json:"vpc_endpoints,omitempty"`
+	ECSClusters               []ECSCluster               `json:"ecs_clusters,omitempty"`
+	ECSTaskDefinitions        []ECSTaskDefinition        `json:"ecs_task_definitions,omitempty"`
+	ECSServices               []ECSService               `json:"ecs_services,omitempty"`
+	Secrets                   []Secret                   `json:"secrets,omitempty"`
+	SnapshotPolicies          []SnapshotPolicy           `json:"snapshot_policies,omitempty"`
+	LaunchTemplates           []LaunchTemplate           `json:"launch_templates,omitempty"`
+	PlacementGroups           []PlacementGroup           `json:"placement_groups,omitempty"`
+	VpnGateways               []VpnGateway               `json:"vpn_gateways,omitempty"`
+	CustomerGateways          []CustomerGateway          `json:"customer_gateways,omitempty"`
+	VpnConnections            []VpnConnection            `json:"vpn_connections,omitempty"`
+	TransitGateways           []TransitGateway           `json:"transit_gateways,omitempty"`
+	TransitGatewayAttachments []TransitGatewayAttachment `json:"transit_gateway_attachments,omitempty"`
+	ElasticsearchDomains      []ElasticsearchDomain      `json:"elasticsearch_domains,omitempty"`
+	EKSClusters               []EKSCluster               `json:"eks_clusters,omitempty"`
+	EKSNodeGroups             []EKSNodeGroup             `json:"eks_node_groups,omitempty"`
 	//S3Buckets         []S3            `json:"s3_buckets,omitempty"`
 	//Route53Zones      []Route53Zone   `json:"route53_zones,omitempty"`
 	//RDSInstances      []RDSInstance   `json:"rds_instances,omitempty"`
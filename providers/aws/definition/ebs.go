@@ -12,6 +12,9 @@ type EBSVolume struct {
 	Iops             *int64  `json:"iops"`
 	Count            int     `json:"count"`
 	Encrypted        bool    `json:"encrypted"`
+	EncryptionKey    string  `json:"encryption_key"`
 	EncryptionKeyID  *string `json:"encryption_key_id"`
 	AvailabilityZone string  `json:"availability_zone"`
+	SnapshotID       string  `json:"snapshot_id"`
+	SnapshotSize     *int64  `json:"snapshot_size"`
 }
@@ -0,0 +1,11 @@
+/* This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at http://mozilla.org/MPL/2.0/. */
+
+package definition
+
+// ECSCluster ...
+type ECSCluster struct {
+	Name              string `json:"name"`
+	ContainerInsights bool   `json:"container_insights"`
+}
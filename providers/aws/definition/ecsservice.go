@@ -0,0 +1,25 @@
+/* This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at http://mozilla.org/MPL/2.0/. */
+
+package definition
+
+// ECSLoadBalancer ...
+type ECSLoadBalancer struct {
+	ELB           string `json:"elb"`
+	ContainerName string `json:"container_name"`
+	ContainerPort int    `json:"container_port"`
+}
+
+// ECSService ...
+type ECSService struct {
+	Name           string            `json:"name"`
+	Cluster        string            `json:"cluster"`
+	TaskDefinition string            `json:"task_definition"`
+	LaunchType     string            `json:"launch_type"`
+	DesiredCount   int64             `json:"desired_count"`
+	Networks       []string          `json:"networks"`
+	SecurityGroups []string          `json:"security_groups"`
+	PublicIP       bool              `json:"public_ip"`
+	LoadBalancers  []ECSLoadBalancer `json:"load_balancers"`
+}
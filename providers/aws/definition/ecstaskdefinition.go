@@ -0,0 +1,44 @@
+/* This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at http://mozilla.org/MPL/2.0/. */
+
+package definition
+
+// ECSPortMapping ...
+type ECSPortMapping struct {
+	ContainerPort int    `json:"container_port"`
+	HostPort      int    `json:"host_port"`
+	Protocol      string `json:"protocol"`
+}
+
+// ECSLogConfiguration ...
+type ECSLogConfiguration struct {
+	Driver  string            `json:"driver"`
+	Options map[string]string `json:"options"`
+}
+
+// ECSContainer ...
+type ECSContainer struct {
+	Name              string               `json:"name"`
+	Image             string               `json:"image"`
+	CPU               int64                `json:"cpu"`
+	Memory            int64                `json:"memory"`
+	MemoryReservation int64                `json:"memory_reservation"`
+	Essential         *bool                `json:"essential"`
+	Command           []string             `json:"command"`
+	Ports             []ECSPortMapping     `json:"ports"`
+	Environment       map[string]string    `json:"environment"`
+	Log               *ECSLogConfiguration `json:"log"`
+}
+
+// ECSTaskDefinition ...
+type ECSTaskDefinition struct {
+	Name          string         `json:"name"`
+	LaunchType    string         `json:"launch_type"`
+	NetworkMode   string         `json:"network_mode"`
+	CPU           int64          `json:"cpu"`
+	Memory        int64          `json:"memory"`
+	ExecutionRole string         `json:"execution_role"`
+	TaskRole      string         `json:"task_role"`
+	Containers    []ECSContainer `json:"containers"`
+}
@@ -0,0 +1,44 @@
+/* This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at http://mozilla.org/MPL/2.0/. */
+
+package definition
+
+// EKSCluster ...
+type EKSCluster struct {
+	Name              string   `json:"name"`
+	Version           string   `json:"version"`
+	Role              string   `json:"role"`
+	Networks          []string `json:"networks"`
+	SecurityGroups    []string `json:"security_groups"`
+	PrivateAccess     bool     `json:"private_access"`
+	PublicAccess      *bool    `json:"public_access"`
+	PublicAccessCIDRs []string `json:"public_access_cidrs"`
+}
+
+// EKSTaint ...
+type EKSTaint struct {
+	Key    string `json:"key"`
+	Value  string `json:"value"`
+	Effect string `json:"effect"`
+}
+
+// EKSScaling ...
+type EKSScaling struct {
+	Min     int64 `json:"min"`
+	Max     int64 `json:"max"`
+	Desired int64 `json:"desired"`
+}
+
+// EKSNodeGroup ...
+type EKSNodeGroup struct {
+	Name          string            `json:"name"`
+	Cluster       string            `json:"cluster"`
+	Role          string            `json:"role"`
+	InstanceTypes []string          `json:"instance_types"`
+	DiskSize      *int64            `json:"disk_size"`
+	Scaling       EKSScaling        `json:"scaling"`
+	Networks      []string          `json:"networks"`
+	Labels        map[string]string `json:"labels"`
+	Taints        []EKSTaint        `json:"taints"`
+}
@@ -0,0 +1,13 @@
+/* This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at http://mozilla.org/MPL/2.0/. */
+
+package definition
+
+// ElasticIP ...
+type ElasticIP struct {
+	ID         string `json:"id"`
+	Name       string `json:"name"`
+	Instance   string `json:"instance"`
+	NatGateway string `json:"nat_gateway"`
+}
@@ -0,0 +1,34 @@
+/* This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at http://mozilla.org/MPL/2.0/. */
+
+package definition
+
+// ElasticsearchMasters ...
+type ElasticsearchMasters struct {
+	Type  string `json:"type"`
+	Count int64  `json:"count"`
+}
+
+// ElasticsearchStorage ...
+type ElasticsearchStorage struct {
+	Type string `json:"type"`
+	Size *int64 `json:"size"`
+	Iops *int64 `json:"iops"`
+}
+
+// ElasticsearchDomain ...
+type ElasticsearchDomain struct {
+	Name             string                `json:"name"`
+	Version          string                `json:"version"`
+	InstanceType     string                `json:"instance_type"`
+	InstanceCount    int64                 `json:"instance_count"`
+	DedicatedMasters *ElasticsearchMasters `json:"dedicated_masters"`
+	ZoneAwareness    bool                  `json:"zone_awareness"`
+	Storage          *ElasticsearchStorage `json:"storage"`
+	Networks         []string              `json:"networks"`
+	SecurityGroups   []string              `json:"security_groups"`
+	Encrypted        bool                  `json:"encrypted"`
+	EncryptionKey    string                `json:"encryption_key"`
+	AccessPolicy     string                `json:"access_policy"`
+}
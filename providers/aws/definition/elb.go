@@ -6,18 +6,20 @@ package definition
 
 // ELBListener ...
 type ELBListener struct {
-	FromPort int    `json:"from_port"`
-	ToPort   int    `json:"to_port"`
-	Protocol string `json:"protocol"`
-	SSLCert  string `json:"ssl_cert"`
+	FromPort       int    `json:"from_port"`
+	ToPort         int    `json:"to_port"`
+	Protocol       string `json:"protocol"`
+	SSLCert        string `json:"ssl_cert"`
+	SSLCertificate string `json:"ssl_certificate"`
 }
 
 // ELB ...
 type ELB struct {
-	Name           string        `json:"name"`
-	Private        bool          `json:"private"`
-	Subnets        []string      `json:"networks"`
-	Instances      []string      `json:"instances"`
-	SecurityGroups []string      `json:"security_groups"`
-	Listeners      []ELBListener `json:"listeners"`
+	Name           string            `json:"name"`
+	Private        bool              `json:"private"`
+	Subnets        []string          `json:"networks"`
+	Instances      []string          `json:"instances"`
+	SecurityGroups []string          `json:"security_groups"`
+	Listeners      []ELBListener     `json:"listeners"`
+	Alarms         []CloudWatchAlarm `json:"alarms"`
 }
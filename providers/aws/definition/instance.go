@@ -6,21 +6,47 @@ package definition
 
 // InstanceVolume ...
 type InstanceVolume struct {
-	Volume string `json:"volume"`
-	Device string `json:"device"`
+	Volume       string `json:"volume"`
+	Device       string `json:"device"`
+	SnapshotID   string `json:"snapshot_id"`
+	SnapshotSize *int64 `json:"snapshot_size"`
+}
+
+// InstanceRootVolume ...
+type InstanceRootVolume struct {
+	Size      *int64 `json:"size"`
+	Type      string `json:"type"`
+	Encrypted bool   `json:"encrypted"`
+}
+
+// InstanceMarket ...
+type InstanceMarket struct {
+	Type                  string `json:"type"`
+	MaxPrice              string `json:"max_price"`
+	InterruptionBehaviour string `json:"interruption_behaviour"`
+	OnDemandBase          int    `json:"on_demand_base"`
+	OnDemandPercentage    int    `json:"on_demand_percentage"`
 }
 
 // Instance ...
 type Instance struct {
-	Name           string           `json:"name"`
-	Type           string           `json:"type"`
-	Image          string           `json:"image"`
-	Count          int              `json:"count"`
-	Network        string           `json:"network"`
-	StartIP        string           `json:"start_ip"`
-	KeyPair        string           `json:"key_pair"`
-	ElasticIP      bool             `json:"elastic_ip"`
-	SecurityGroups []string         `json:"security_groups"`
-	Volumes        []InstanceVolume `json:"volumes"`
-	UserData       string           `json:"user_data"`
+	Name           string             `json:"name"`
+	Type           string             `json:"type"`
+	Image          string             `json:"image"`
+	Count          int                `json:"count"`
+	Network        string             `json:"network"`
+	StartIP        string             `json:"start_ip"`
+	KeyPair        string             `json:"key_pair"`
+	ElasticIP      bool               `json:"elastic_ip"`
+	SecurityGroups []string           `json:"security_groups"`
+	Volumes        []InstanceVolume   `json:"volumes"`
+	UserData       string             `json:"user_data"`
+	LaunchTemplate string             `json:"launch_template"`
+	Market         InstanceMarket     `json:"market"`
+	PlacementGroup string             `json:"placement_group"`
+	Tenancy        string             `json:"tenancy"`
+	EBSOptimized   bool               `json:"ebs_optimized"`
+	Monitoring     bool               `json:"monitoring"`
+	RootVolume     InstanceRootVolume `json:"root_volume"`
+	Alarms         []CloudWatchAlarm  `json:"alarms"`
 }
@@ -0,0 +1,15 @@
+/* This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at http://mozilla.org/MPL/2.0/. */
+
+package definition
+
+// KMSKey ...
+type KMSKey struct {
+	Name           string `json:"name"`
+	Alias          string `json:"alias"`
+	Description    string `json:"description"`
+	Policy         string `json:"policy"`
+	Rotation       bool   `json:"rotation"`
+	DeletionWindow *int64 `json:"deletion_window"`
+}
@@ -0,0 +1,16 @@
+/* This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at http://mozilla.org/MPL/2.0/. */
+
+package definition
+
+// LaunchTemplate ...
+type LaunchTemplate struct {
+	Name           string         `json:"name"`
+	Type           string         `json:"type"`
+	Image          string         `json:"image"`
+	KeyPair        string         `json:"key_pair"`
+	SecurityGroups []string       `json:"security_groups"`
+	UserData       string         `json:"user_data"`
+	Market         InstanceMarket `json:"market"`
+}
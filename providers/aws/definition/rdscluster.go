@@ -12,18 +12,23 @@ type RDSBackup struct {
 
 // RDSCluster ...
 type RDSCluster struct {
-	Name              string    `json:"name"`
-	Engine            string    `json:"engine"`
-	EngineVersion     string    `json:"engine_version"`
-	Port              *int64    `json:"port"`
-	AvailabilityZones []string  `json:"availability_zones"`
-	SecurityGroups    []string  `json:"security_groups"`
-	Networks          []string  `json:"networks"`
-	DatabaseName      string    `json:"database_name"`
-	DatabaseUsername  string    `json:"database_username"`
-	DatabasePassword  string    `json:"database_password"`
-	Backups           RDSBackup `json:"backups"`
-	MaintenanceWindow string    `json:"maintenance_window"`
-	ReplicationSource string    `json:"replication_source"`
-	FinalSnapshot     bool      `json:"final_snapshot"`
+	Name              string            `json:"name"`
+	Engine            string            `json:"engine"`
+	EngineVersion     string            `json:"engine_version"`
+	Port              *int64            `json:"port"`
+	AvailabilityZones []string          `json:"availability_zones"`
+	SecurityGroups    []string          `json:"security_groups"`
+	Networks          []string          `json:"networks"`
+	DatabaseName      string            `json:"database_name"`
+	DatabaseUsername  string            `json:"database_username"`
+	DatabasePassword  string            `json:"database_password"`
+	DatabaseSecret    string            `json:"database_password_secret"`
+	Backups           RDSBackup         `json:"backups"`
+	MaintenanceWindow string            `json:"maintenance_window"`
+	ReplicationSource string            `json:"replication_source"`
+	FinalSnapshot     bool              `json:"final_snapshot"`
+	Encrypted         bool              `json:"encrypted"`
+	EncryptionKey     string            `json:"encryption_key"`
+	EncryptionKeyID   *string           `json:"encryption_key_id"`
+	Alarms            []CloudWatchAlarm `json:"alarms"`
 }
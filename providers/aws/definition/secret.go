@@ -0,0 +1,29 @@
+/* This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at http://mozilla.org/MPL/2.0/. */
+
+package definition
+
+// SecretPassword ...
+type SecretPassword struct {
+	Length             int64  `json:"length"`
+	ExcludeCharacters  string `json:"exclude_characters"`
+	ExcludePunctuation bool   `json:"exclude_punctuation"`
+	ExcludeNumbers     bool   `json:"exclude_numbers"`
+	IncludeSpace       bool   `json:"include_space"`
+}
+
+// SecretRotation ...
+type SecretRotation struct {
+	Lambda string `json:"lambda"`
+	Days   int64  `json:"days"`
+}
+
+// Secret ...
+type Secret struct {
+	Name        string          `json:"name"`
+	Description string          `json:"description"`
+	KMSKey      string          `json:"kms_key"`
+	Password    *SecretPassword `json:"password"`
+	Rotation    *SecretRotation `json:"rotation"`
+}
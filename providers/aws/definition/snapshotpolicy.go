@@ -0,0 +1,24 @@
+/* This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at http://mozilla.org/MPL/2.0/. */
+
+package definition
+
+// SnapshotSchedule ...
+type SnapshotSchedule struct {
+	Name     string   `json:"name"`
+	Interval int64    `json:"interval"`
+	Times    []string `json:"times"`
+	Retain   int64    `json:"retain"`
+	CopyTags bool     `json:"copy_tags"`
+}
+
+// SnapshotPolicy ...
+type SnapshotPolicy struct {
+	Name          string             `json:"name"`
+	Description   string             `json:"description"`
+	ExecutionRole string             `json:"execution_role"`
+	Disabled      bool               `json:"disabled"`
+	Volumes       []string           `json:"volumes"`
+	Schedules     []SnapshotSchedule `json:"schedules"`
+}
@@ -0,0 +1,30 @@
+/* This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at http://mozilla.org/MPL/2.0/. */
+
+package definition
+
+// TransitGateway ...
+type TransitGateway struct {
+	Name                         string `json:"name"`
+	Description                  string `json:"description"`
+	AmazonSideASN                *int64 `json:"amazon_side_asn"`
+	DNSSupport                   *bool  `json:"dns_support"`
+	AutoAcceptSharedAttachments  bool   `json:"auto_accept_shared_attachments"`
+	DefaultRouteTableAssociation *bool  `json:"default_route_table_association"`
+	DefaultRouteTablePropagation *bool  `json:"default_route_table_propagation"`
+}
+
+// TransitGatewayAttachment ...
+type TransitGatewayAttachment struct {
+	Name                         string   `json:"name"`
+	TransitGateway               string   `json:"transit_gateway"`
+	TransitGatewayID             string   `json:"transit_gateway_id"`
+	Vpc                          string   `json:"vpc"`
+	Networks                     []string `json:"networks"`
+	DNSSupport                   *bool    `json:"dns_support"`
+	DefaultRouteTableAssociation *bool    `json:"default_route_table_association"`
+	DefaultRouteTablePropagation *bool    `json:"default_route_table_propagation"`
+	RouteTable                   string   `json:"route_table"`
+	PropagateTo                  []string `json:"propagate_to"`
+}
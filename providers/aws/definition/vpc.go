@@ -4,9 +4,31 @@
 
 package definition
 
+// VpcDHCPOptions ...
+type VpcDHCPOptions struct {
+	DomainName  string   `json:"domain_name"`
+	NameServers []string `json:"name_servers"`
+}
+
+// VpcFlowLogs ...
+type VpcFlowLogs struct {
+	Destination string `json:"destination"`
+	LogGroup    string `json:"log_group"`
+	Bucket      string `json:"bucket"`
+	IAMRole     string `json:"iam_role"`
+	TrafficType string `json:"traffic_type"`
+}
+
 type Vpc struct {
-	ID         string `json:"id"`
-	Name       string `json:"name"`
-	Subnet     string `json:"subnet"`
-	AutoRemove bool   `json:"auto_remove"`
+	ID               string          `json:"id"`
+	Name             string          `json:"name"`
+	Subnet           string          `json:"subnet"`
+	AutoRemove       bool            `json:"auto_remove"`
+	DNSSupport       *bool           `json:"dns_support"`
+	DNSHostnames     bool            `json:"dns_hostnames"`
+	Tenancy          string          `json:"tenancy"`
+	SecondarySubnets []string        `json:"secondary_subnets"`
+	IPv6             bool            `json:"ipv6"`
+	DHCPOptions      *VpcDHCPOptions `json:"dhcp_options"`
+	FlowLogs         *VpcFlowLogs    `json:"flow_logs"`
 }
@@ -0,0 +1,18 @@
+/* This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at http://mozilla.org/MPL/2.0/. */
+
+package definition
+
+// VpcEndpoint ...
+type VpcEndpoint struct {
+	Name           string   `json:"name"`
+	Vpc            string   `json:"vpc"`
+	Service        string   `json:"service"`
+	ServiceName    string   `json:"service_name"`
+	Type           string   `json:"type"`
+	Networks       []string `json:"networks"`
+	SecurityGroups []string `json:"security_groups"`
+	PrivateDNS     bool     `json:"private_dns"`
+	Policy         string   `json:"policy"`
+}
@@ -0,0 +1,28 @@
+/* This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at http://mozilla.org/MPL/2.0/. */
+
+package definition
+
+// VpnGateway ...
+type VpnGateway struct {
+	Name          string `json:"name"`
+	Vpc           string `json:"vpc"`
+	AmazonSideASN *int64 `json:"amazon_side_asn"`
+}
+
+// CustomerGateway ...
+type CustomerGateway struct {
+	Name      string `json:"name"`
+	IPAddress string `json:"ip_address"`
+	BGPASN    int64  `json:"bgp_asn"`
+}
+
+// VpnConnection ...
+type VpnConnection struct {
+	Name            string   `json:"name"`
+	VpnGateway      string   `json:"vpn_gateway"`
+	CustomerGateway string   `json:"customer_gateway"`
+	StaticRoutes    []string `json:"static_routes"`
+	Networks        []string `json:"propagate_to_networks"`
+}
@@ -0,0 +1,29 @@
+/* This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at http://mozilla.org/MPL/2.0/. */
+
+package fake
+
+import (
+	"github.com/ernestio/libmapper"
+	"github.com/ernestio/libmapper/providers/aws/mapper"
+	graph "gopkg.in/r3labs/graph.v2"
+)
+
+// Mapper : an aws mapper whose graphs can be applied against an in memory simulator
+type Mapper struct {
+	mapper.Mapper
+	Simulator *Simulator
+}
+
+// New : returns a new fake aws mapper
+func New() libmapper.Mapper {
+	return &Mapper{
+		Simulator: NewSimulator(),
+	}
+}
+
+// Apply : applies a graph built by the mapper against its simulator
+func (m *Mapper) Apply(g *graph.Graph) error {
+	return m.Simulator.Apply(g)
+}
@@ -0,0 +1,223 @@
+/* This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at http://mozilla.org/MPL/2.0/. */
+
+package fake
+
+import (
+	"crypto/sha1"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"strconv"
+
+	"github.com/ernestio/libmapper/providers/aws/components"
+	graph "gopkg.in/r3labs/graph.v2"
+)
+
+// generator : builds a deterministic value for a component's output field
+type generator func(seed string, v map[string]interface{}) interface{}
+
+// output : a field the aws connector would populate
+type output struct {
+	field string
+	value generator
+}
+
+// outputs : the fields populated for each component type once applied
+var outputs = map[string][]output{
+	"vpc": {
+		{"vpc_aws_id", awsID("vpc-")},
+		{"dhcp_options_aws_id", awsID("dopt-")},
+		{"flow_log_aws_id", awsID("fl-")},
+	},
+	"network": {
+		{"network_aws_id", awsID("subnet-")},
+		{"route_table_aws_id", awsID("rtb-")},
+		{"availability_zone", zone},
+	},
+	"instance":         {{"instance_aws_id", awsID("i-")}},
+	"security_group":   {{"security_group_aws_id", awsID("sg-")}},
+	"nat":              {{"nat_gateway_aws_id", awsID("nat-")}, {"nat_gateway_allocation_id", awsID("eipalloc-")}, {"nat_gateway_allocation_ip", publicIP}},
+	"elb":              {{"dns_name", dnsName("elb.amazonaws.com")}},
+	"ebs_volume":       {{"volume_aws_id", awsID("vol-")}},
+	"rds_cluster":      {{"arn", arn("rds", "cluster:")}, {"endpoint", dnsName("rds.amazonaws.com")}},
+	"kms_key":          {{"kms_key_aws_id", uuid}, {"kms_key_arn", arn("kms", "key/")}},
+	"acm_certificate":  {{"certificate_arn", arn("acm", "certificate/")}, {"status", constant("ISSUED")}},
+	"cloudwatch_alarm": {{"alarm_arn", arn("cloudwatch", "alarm:")}},
+	"elastic_ip":       {{"elastic_ip_aws_id", awsID("eipalloc-")}, {"association_id", awsID("eipassoc-")}, {"ip", publicIP}},
+	"key_pair":         {{"key_pair_aws_id", awsID("key-")}, {"fingerprint", fingerprint}},
+	"vpc_endpoint":     {{"vpc_endpoint_aws_id", awsID("vpce-")}},
+	"ecs_cluster":      {{"cluster_arn", arn("ecs", "cluster/")}},
+	"ecs_task_definition": {
+		{"task_definition_arn", arn("ecs", "task-definition/")},
+		{"revision", constant(1)},
+	},
+	"ecs_service":      {{"service_arn", arn("ecs", "service/")}},
+	"secret":           {{"secret_arn", arn("secretsmanager", "secret:")}},
+	"snapshot_policy":  {{"snapshot_policy_aws_id", awsID("policy-")}},
+	"launch_template":  {{"launch_template_aws_id", awsID("lt-")}, {"latest_version", constant("1")}},
+	"placement_group":  {{"placement_group_aws_id", awsID("pg-")}},
+	"vpn_gateway":      {{"vpn_gateway_aws_id", awsID("vgw-")}, {"amazon_side_asn", constant(64512)}},
+	"customer_gateway": {{"customer_gateway_aws_id", awsID("cgw-")}},
+	"vpn_connection":   {{"vpn_connection_aws_id", awsID("vpn-")}},
+	"transit_gateway": {
+		{"transit_gateway_aws_id", awsID("tgw-")},
+		{"amazon_side_asn", constant(64512)},
+		{"association_default_route_table_aws_id", awsID("tgw-rtb-")},
+		{"propagation_default_route_table_aws_id", awsID("tgw-rtb-")},
+	},
+	"transit_gateway_attachment": {{"transit_gateway_attachment_aws_id", awsID("tgw-attach-")}},
+	"elasticsearch_domain": {
+		{"domain_arn", arn("es", "domain/")},
+		{"domain_id", domainID},
+		{"endpoint", dnsName("es.amazonaws.com")},
+	},
+	"eks_cluster": {
+		{"cluster_arn", arn("eks", "cluster/")},
+		{"endpoint", eksEndpoint},
+		{"certificate_authority", certificateAuthority},
+	},
+	"eks_node_group": {{"node_group_arn", arn("eks", "nodegroup/")}},
+}
+
+// assignOutputs : populates any unset output fields of a resolved component
+func (s *Simulator) assignOutputs(g *graph.Graph, c graph.Component, v map[string]interface{}) {
+	for _, o := range outputs[c.GetType()] {
+		if isEmpty(v[o.field]) != true {
+			continue
+		}
+
+		v[o.field] = o.value(c.GetID()+"/"+o.field, v)
+	}
+
+	if c.GetType() == "instance" && isEmpty(v["public_ip"]) && hasPublicIP(g, c.(*components.Instance)) {
+		v["public_ip"] = publicIP(c.GetID()+"/public_ip", v)
+	}
+
+	if c.GetType() == "vpn_connection" {
+		tunnels, _ := v["tunnels"].([]interface{})
+		for i, t := range tunnels {
+			tunnel, ok := t.(map[string]interface{})
+			if ok && isEmpty(tunnel["outside_ip_address"]) {
+				tunnel["outside_ip_address"] = publicIP(c.GetID()+"/tunnel/"+strconv.Itoa(i), v)
+			}
+		}
+	}
+}
+
+// hasPublicIP : instances are only given a public ip when their network is
+// public, or when an elastic ip is associated with them
+func hasPublicIP(g *graph.Graph, i *components.Instance) bool {
+	for _, c := range g.GetComponents().ByType(components.TYPENETWORK) {
+		n, ok := c.(*components.Network)
+		if ok && n.Name == i.Network && n.IsPublic {
+			return true
+		}
+	}
+
+	for _, c := range g.GetComponents().ByType(components.TYPEELASTICIP) {
+		e, ok := c.(*components.ElasticIP)
+		if ok && e.Instance == i.Name {
+			return true
+		}
+	}
+
+	return false
+}
+
+func isEmpty(v interface{}) bool {
+	switch x := v.(type) {
+	case nil:
+		return true
+	case string:
+		return x == ""
+	case float64:
+		return x == 0
+	}
+
+	return false
+}
+
+func hash(seed string) string {
+	h := sha1.Sum([]byte(seed))
+	return hex.EncodeToString(h[:])
+}
+
+func region(v map[string]interface{}) string {
+	r, _ := v["datacenter_region"].(string)
+	if r == "" {
+		return DEFAULTREGION
+	}
+	return r
+}
+
+func name(v map[string]interface{}) string {
+	n, _ := v["name"].(string)
+	return n
+}
+
+func awsID(prefix string) generator {
+	return func(seed string, v map[string]interface{}) interface{} {
+		return prefix + hash(seed)[:17]
+	}
+}
+
+func arn(service, resource string) generator {
+	return func(seed string, v map[string]interface{}) interface{} {
+		return fmt.Sprintf("arn:aws:%s:%s:%s:%s%s", service, region(v), ACCOUNTID, resource, name(v))
+	}
+}
+
+func dnsName(suffix string) generator {
+	return func(seed string, v map[string]interface{}) interface{} {
+		return fmt.Sprintf("%s-%s.%s.%s", name(v), hash(seed)[:10], region(v), suffix)
+	}
+}
+
+func constant(value interface{}) generator {
+	return func(seed string, v map[string]interface{}) interface{} {
+		return value
+	}
+}
+
+// publicIP : returns an address from the documentation range 203.0.113.0/24
+func publicIP(seed string, v map[string]interface{}) interface{} {
+	h := sha1.Sum([]byte(seed))
+	return fmt.Sprintf("203.0.113.%d", int(h[0])%254+1)
+}
+
+func zone(seed string, v map[string]interface{}) interface{} {
+	return region(v) + "a"
+}
+
+func uuid(seed string, v map[string]interface{}) interface{} {
+	h := hash(seed)
+	return h[0:8] + "-" + h[8:12] + "-" + h[12:16] + "-" + h[16:20] + "-" + h[20:32]
+}
+
+func fingerprint(seed string, v map[string]interface{}) interface{} {
+	h := hash(seed)
+
+	var fp string
+	for i := 0; i < 32; i += 2 {
+		if i > 0 {
+			fp += ":"
+		}
+		fp += h[i : i+2]
+	}
+
+	return fp
+}
+
+func domainID(seed string, v map[string]interface{}) interface{} {
+	return ACCOUNTID + "/" + name(v)
+}
+
+func eksEndpoint(seed string, v map[string]interface{}) interface{} {
+	return fmt.Sprintf("https://%s.gr7.%s.eks.amazonaws.com", hash(seed)[:32], region(v))
+}
+
+func certificateAuthority(seed string, v map[string]interface{}) interface{} {
+	return base64.StdEncoding.EncodeToString([]byte("fake certificate authority " + hash(seed)))
+}
@@ -0,0 +1,211 @@
+/* This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at http://mozilla.org/MPL/2.0/. */
+
+package fake
+
+import (
+	"errors"
+
+	"github.com/ernestio/libmapper"
+	graph "gopkg.in/r3labs/graph.v2"
+)
+
+const (
+	// ACCOUNTID : the account all simulated resources belong to
+	ACCOUNTID = "123456789012"
+	// DEFAULTREGION : the region used when the graph carries no aws credentials
+	DEFAULTREGION = "eu-west-1"
+)
+
+// Simulator : deterministically applies aws graphs in memory. Every component
+// is resolved, assigned fake aws identifiers and outputs, then updated as if
+// it had been returned by the aws connector
+type Simulator struct {
+	Region string
+}
+
+// NewSimulator : returns a new simulator
+func NewSimulator() *Simulator {
+	return &Simulator{
+		Region: DEFAULTREGION,
+	}
+}
+
+// Apply : applies all components of a graph in dependency order, then removes the
+// components deleted by its changes. Identifiers that have already been assigned
+// are kept, so a graph can be applied more than once
+func (s *Simulator) Apply(g *graph.Graph) error {
+	r := libmapper.NewResolver()
+
+	r.Set("credentials::aws", map[string]interface{}{
+		"_provider":             "fake",
+		"name":                  "fake",
+		"region":                s.Region,
+		"aws_access_key_id":     "fake",
+		"aws_secret_access_key": "fake",
+	})
+
+	stages, err := libmapper.BuildStages(plan(g), nil)
+	if err != nil {
+		return err
+	}
+
+	for _, c := range g.Components {
+		v, err := libmapper.ToMap(c)
+		if err != nil {
+			return err
+		}
+		r.Set(c.GetID(), v)
+	}
+
+	// components being deleted only exist in the changes
+	for _, c := range g.Changes {
+		if c.GetAction() != "delete" {
+			continue
+		}
+
+		v, err := libmapper.ToMap(c)
+		if err != nil {
+			return err
+		}
+		r.Set(c.GetID(), v)
+	}
+
+	values := make(map[string]map[string]interface{})
+
+	for _, stage := range stages {
+		for _, id := range stage {
+			c := g.Component(id)
+
+			_, generic := c.(*graph.GenericComponent)
+			if generic {
+				continue
+			}
+
+			rv, err := r.Resolve(id)
+			if err != nil {
+				return errors.New(id + ": " + err.Error())
+			}
+
+			// values such as the region are only known once resolved, so the
+			// component is validated again as the aws connector would receive it
+			vc, err := libmapper.FromMap(c, rv)
+			if err != nil {
+				return err
+			}
+
+			err = vc.Validate()
+			if err != nil {
+				return errors.New(id + ": " + err.Error())
+			}
+
+			s.assignOutputs(g, c, rv)
+
+			// feed the simulated provider response back through the component
+			rc, err := libmapper.FromMap(c, rv)
+			if err != nil {
+				return err
+			}
+
+			c.Update(rc)
+			c.SetState("completed")
+
+			// outputs are only known once applied, so the component's fields are resolved again
+			r.Set(id, rv)
+			values[id] = rv
+		}
+	}
+
+	err = s.delete(g, r)
+	if err != nil {
+		return err
+	}
+
+	for _, c := range g.Changes {
+		v, ok := values[c.GetID()]
+		if ok != true {
+			continue
+		}
+
+		rc, err := libmapper.FromMap(c, v)
+		if err != nil {
+			return err
+		}
+
+		c.Update(rc)
+		c.SetState("completed")
+	}
+
+	return nil
+}
+
+// delete : removes the graph's deleted components, with each component removed
+// before the components it depends on. Components that are kept cannot depend
+// on a deleted component, and stateful components must have been created
+func (s *Simulator) delete(g *graph.Graph, r *libmapper.Resolver) error {
+	deleted := make(map[string]graph.Component)
+
+	for _, c := range g.Changes {
+		if c.GetAction() == "delete" {
+			deleted[c.GetID()] = c
+		}
+	}
+
+	for _, c := range g.Components {
+		for _, dep := range c.Dependencies() {
+			if deleted[dep] != nil {
+				return errors.New(c.GetID() + ": depends on deleted component " + dep)
+			}
+		}
+	}
+
+	stages, err := libmapper.BuildStages(g, nil)
+	if err != nil {
+		return err
+	}
+
+	for _, stage := range stages {
+		for _, id := range stage {
+			c := deleted[id]
+			if c == nil {
+				continue
+			}
+
+			_, err := r.Resolve(id)
+			if err != nil {
+				return errors.New(id + ": " + err.Error())
+			}
+
+			if c.IsStateful() && c.GetProviderID() == "" {
+				return errors.New(id + ": could not delete a component that has not been created")
+			}
+
+			c.SetState("completed")
+		}
+	}
+
+	return nil
+}
+
+// applied : a component that is applied whatever its planned action
+type applied struct {
+	graph.Component
+}
+
+// GetAction : returns the action the component is staged with
+func (a applied) GetAction() string {
+	return "create"
+}
+
+// plan : returns a graph whose changes apply all of the graph's components, so
+// they can be staged after their dependencies
+func plan(g *graph.Graph) *graph.Graph {
+	p := graph.New()
+
+	for _, c := range g.Components {
+		p.Changes = append(p.Changes, applied{c})
+	}
+
+	return p
+}
@@ -0,0 +1,184 @@
+/* This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at http://mozilla.org/MPL/2.0/. */
+
+package fake
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/ernestio/libmapper/providers/aws/components"
+	"github.com/ernestio/libmapper/providers/aws/definition"
+	. "github.com/smartystreets/goconvey/convey"
+	graph "gopkg.in/r3labs/graph.v2"
+)
+
+const testDefinition = `{"name":"test",
+	"vpcs":[{"name":"vpc","subnet":"10.0.0.0/16"}],
+	"networks":[
+		{"name":"web","subnet":"10.0.1.0/24","vpc":"vpc","availability_zone":"eu-west-1a","public":true},
+		{"name":"db","subnet":"10.0.2.0/24","vpc":"vpc","availability_zone":"eu-west-1a"}
+	],
+	"security_groups":[{"name":"sg","vpc":"vpc","ingress":[{"ip":"0.0.0.0/0","from_port":"80","to_port":"80","protocol":"tcp"}]}],
+	"instances":[
+		{"name":"web","type":"t2.micro","image":"ami-12345678","count":2,"network":"web","start_ip":"10.0.1.10","security_groups":["sg"]},
+		{"name":"db","type":"t2.micro","image":"ami-12345678","count":1,"network":"db","start_ip":"10.0.2.10","security_groups":["sg"]}
+	]}`
+
+// testApply : converts the test definition and applies it against a new simulator
+func testApply() (*Mapper, *graph.Graph) {
+	d := definition.New()
+	err := d.LoadJSON([]byte(testDefinition))
+	So(err, ShouldBeNil)
+
+	m := New().(*Mapper)
+
+	g, err := m.ConvertDefinition(d)
+	So(err, ShouldBeNil)
+
+	err = m.Apply(g)
+	So(err, ShouldBeNil)
+
+	return m, g
+}
+
+// testDelete : moves components from the graph's components to its changes, planned for deletion
+func testDelete(g *graph.Graph, ids ...string) {
+	for _, id := range ids {
+		c := g.Component(id)
+
+		for i := len(g.Components) - 1; i >= 0; i-- {
+			if g.Components[i] == c {
+				g.Components = append(g.Components[:i], g.Components[i+1:]...)
+			}
+		}
+
+		c.SetAction("delete")
+		c.SetState("")
+		g.Changes = append(g.Changes, c)
+	}
+}
+
+func TestSimulatorApply(t *testing.T) {
+	Convey("Given a definition converted by the fake mapper", t, func() {
+		d := definition.New()
+		err := d.LoadJSON([]byte(testDefinition))
+		So(err, ShouldBeNil)
+
+		m := New().(*Mapper)
+
+		g, err := m.ConvertDefinition(d)
+		So(err, ShouldBeNil)
+
+		Convey("When applying the graph", func() {
+			err := m.Apply(g)
+
+			Convey("It should assign aws identifiers to every component", func() {
+				So(err, ShouldBeNil)
+				So(g.Component("vpc::vpc").GetProviderID(), ShouldStartWith, "vpc-")
+				So(g.Component("network::web").GetProviderID(), ShouldStartWith, "subnet-")
+				So(g.Component("security_group::sg").GetProviderID(), ShouldStartWith, "sg-")
+				So(g.Component("instance::web-1").GetProviderID(), ShouldStartWith, "i-")
+				So(g.Component("instance::db-1").GetProviderID(), ShouldStartWith, "i-")
+				So(g.Component("instance::web-1").GetState(), ShouldEqual, "completed")
+			})
+
+			Convey("It should only assign public ips to instances on a public network", func() {
+				So(g.Component("instance::web-1").(*components.Instance).PublicIP, ShouldStartWith, "203.0.113.")
+				So(g.Component("instance::db-1").(*components.Instance).PublicIP, ShouldEqual, "")
+			})
+
+			Convey("And when converting the graph back to a definition", func() {
+				nd, err := m.ConvertGraph(g)
+
+				Convey("It should map the assigned identifiers", func() {
+					So(err, ShouldBeNil)
+					So(nd.(definition.Definition).Vpcs[0].ID, ShouldEqual, g.Component("vpc::vpc").GetProviderID())
+				})
+			})
+		})
+	})
+
+	Convey("Given an applied graph", t, func() {
+		m, g := testApply()
+
+		Convey("When an instance is updated and the graph applied again", func() {
+			i := g.Component("instance::web-1").(*components.Instance)
+			id := i.InstanceAWSID
+			ip := i.PublicIP
+
+			i.Type = "t2.large"
+			i.SetAction("update")
+			i.SetState("")
+
+			err := m.Apply(g)
+
+			Convey("It should keep the identifiers and outputs already assigned", func() {
+				So(err, ShouldBeNil)
+				So(i.InstanceAWSID, ShouldEqual, id)
+				So(i.PublicIP, ShouldEqual, ip)
+			})
+
+			Convey("It should keep the updated values", func() {
+				So(i.Type, ShouldEqual, "t2.large")
+				So(i.GetState(), ShouldEqual, "completed")
+			})
+		})
+	})
+
+	Convey("Given an applied graph with an instance planned for deletion", t, func() {
+		m, g := testApply()
+		testDelete(g, "instance::web-2")
+
+		Convey("When applying the graph", func() {
+			err := m.Apply(g)
+
+			Convey("It should delete the instance", func() {
+				So(err, ShouldBeNil)
+				So(g.Changes[0].GetState(), ShouldEqual, "completed")
+				So(g.HasComponent("instance::web-2"), ShouldBeFalse)
+			})
+		})
+	})
+
+	Convey("Given an applied graph with a security group still in use planned for deletion", t, func() {
+		m, g := testApply()
+		testDelete(g, "security_group::sg")
+
+		Convey("When applying the graph", func() {
+			err := m.Apply(g)
+
+			Convey("It should not delete the security group", func() {
+				So(err, ShouldNotBeNil)
+				So(strings.HasSuffix(err.Error(), "depends on deleted component security_group::sg"), ShouldBeTrue)
+			})
+		})
+	})
+
+	Convey("Given an applied graph with all of its components planned for deletion", t, func() {
+		m, g := testApply()
+
+		var ids []string
+		for _, c := range g.Components {
+			_, generic := c.(*graph.GenericComponent)
+			if generic != true {
+				ids = append(ids, c.GetID())
+			}
+		}
+
+		testDelete(g, ids...)
+
+		Convey("When applying the graph", func() {
+			err := m.Apply(g)
+
+			Convey("It should delete every component", func() {
+				So(err, ShouldBeNil)
+
+				for _, c := range g.Changes {
+					So(c.GetState(), ShouldEqual, "completed")
+				}
+			})
+		})
+	})
+}
@@ -0,0 +1,60 @@
+/* This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at http://mozilla.org/MPL/2.0/. */
+
+package mapper
+
+import (
+	"strings"
+
+	"github.com/ernestio/libmapper/providers/aws/components"
+	"github.com/ernestio/libmapper/providers/aws/definition"
+	graph "gopkg.in/r3labs/graph.v2"
+)
+
+// MapACMCertificates : Maps the acm certificates from a given input payload.
+func MapACMCertificates(d *definition.Definition) []*components.ACMCertificate {
+	var certs []*components.ACMCertificate
+
+	for _, cert := range d.ACMCertificates {
+		c := &components.ACMCertificate{
+			Name:             cert.Name,
+			DomainName:       cert.DomainName,
+			AlternativeNames: cert.AlternativeNames,
+			ValidationMethod: strings.ToUpper(cert.Validation),
+			HostedZoneID:     cert.HostedZoneID,
+			CertificateBody:  cert.CertificateBody,
+			CertificateChain: cert.CertificateChain,
+			PrivateKey:       cert.PrivateKey,
+			Tags:             mapTags(cert.Name, d.Name),
+		}
+
+		c.SetDefaultVariables()
+
+		certs = append(certs, c)
+	}
+
+	return certs
+}
+
+// MapDefinitionACMCertificates : Maps components acm certificates into a definition defined acm certificates
+func MapDefinitionACMCertificates(g *graph.Graph) []definition.ACMCertificate {
+	var certs []definition.ACMCertificate
+
+	for _, c := range g.GetComponents().ByType("acm_certificate") {
+		cert := c.(*components.ACMCertificate)
+
+		certs = append(certs, definition.ACMCertificate{
+			Name:             cert.Name,
+			DomainName:       cert.DomainName,
+			AlternativeNames: cert.AlternativeNames,
+			Validation:       strings.ToLower(cert.ValidationMethod),
+			HostedZoneID:     cert.HostedZoneID,
+			CertificateBody:  cert.CertificateBody,
+			CertificateChain: cert.CertificateChain,
+			PrivateKey:       cert.PrivateKey,
+		})
+	}
+
+	return certs
+}
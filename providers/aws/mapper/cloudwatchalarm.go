@@ -0,0 +1,127 @@
+/* This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at http://mozilla.org/MPL/2.0/. */
+
+package mapper
+
+import (
+	"strconv"
+
+	"github.com/ernestio/libmapper/providers/aws/components"
+	"github.com/ernestio/libmapper/providers/aws/definition"
+	graph "gopkg.in/r3labs/graph.v2"
+)
+
+// MapCloudWatchAlarms : Maps the alarms attached to instances, elbs and rds clusters from a given input payload.
+func MapCloudWatchAlarms(d *definition.Definition) []*components.CloudWatchAlarm {
+	var alarms []*components.CloudWatchAlarm
+
+	for _, instance := range d.Instances {
+		for i := 0; i < instance.Count; i++ {
+			name := instance.Name + "-" + strconv.Itoa(i+1)
+
+			for _, alarm := range instance.Alarms {
+				a := mapAlarm(alarm, "instance", name, d.Name, "AWS/EC2")
+				a.Instance = name
+				a.SetDefaultVariables()
+
+				alarms = append(alarms, a)
+			}
+		}
+	}
+
+	for _, elb := range d.ELBs {
+		for _, alarm := range elb.Alarms {
+			a := mapAlarm(alarm, "elb", elb.Name, d.Name, "AWS/ELB")
+			a.ELB = elb.Name
+			a.SetDefaultVariables()
+
+			alarms = append(alarms, a)
+		}
+	}
+
+	for _, cluster := range d.RDSClusters {
+		for _, alarm := range cluster.Alarms {
+			a := mapAlarm(alarm, "rds_cluster", cluster.Name, d.Name, "AWS/RDS")
+			a.RDSCluster = cluster.Name
+			a.SetDefaultVariables()
+
+			alarms = append(alarms, a)
+		}
+	}
+
+	return alarms
+}
+
+// MapDefinitionAlarms : Maps the alarms attached to a component into definition defined alarms
+func MapDefinitionAlarms(g *graph.Graph, ctype, name string) []definition.CloudWatchAlarm {
+	var alarms []definition.CloudWatchAlarm
+
+	for _, c := range g.GetComponents().ByType("cloudwatch_alarm") {
+		a := c.(*components.CloudWatchAlarm)
+
+		switch ctype {
+		case "instance":
+			if a.Instance != name {
+				continue
+			}
+		case "elb":
+			if a.ELB != name {
+				continue
+			}
+		case "rds_cluster":
+			if a.RDSCluster != name {
+				continue
+			}
+		default:
+			continue
+		}
+
+		alarms = append(alarms, definition.CloudWatchAlarm{
+			Name:              a.GetGroup(),
+			Metric:            a.MetricName,
+			Namespace:         a.Namespace,
+			Statistic:         a.Statistic,
+			Comparison:        a.ComparisonOperator,
+			Threshold:         a.Threshold,
+			Period:            a.Period,
+			EvaluationPeriods: a.EvaluationPeriods,
+			Actions:           a.Actions,
+		})
+	}
+
+	return alarms
+}
+
+// mapAlarm : alarms are named after the type and name of their target, so alarms
+// with the same name on different types of component do not collide
+func mapAlarm(alarm definition.CloudWatchAlarm, ttype, target, service, namespace string) *components.CloudWatchAlarm {
+	name := ttype + "-" + target + "-" + alarm.Name
+
+	if alarm.Namespace != "" {
+		namespace = alarm.Namespace
+	}
+
+	return &components.CloudWatchAlarm{
+		Name:               name,
+		MetricName:         alarm.Metric,
+		Namespace:          namespace,
+		Statistic:          alarm.Statistic,
+		ComparisonOperator: alarm.Comparison,
+		Threshold:          alarm.Threshold,
+		Period:             alarm.Period,
+		EvaluationPeriods:  alarm.EvaluationPeriods,
+		Actions:            alarm.Actions,
+		Tags:               mapAlarmTags(name, service, alarm.Name),
+	}
+}
+
+func mapAlarmTags(name, service, alarmGroup string) map[string]string {
+	tags := make(map[string]string)
+
+	tags["Name"] = name
+	tags["ernest.service"] = service
+	tags["ernest.alarm_group"] = alarmGroup
+
+	return tags
+}
@@ -20,6 +20,8 @@ func MapEBSVolumes(d *definition.Definition) []*components.EBSVolume {
 		for i := 0; i < vol.Count; i++ {
 			name := vol.Name + "-" + strconv.Itoa(i+1)
 
+			snapshotID, snapshotSize := mapEBSSnapshot(d, vol, name)
+
 			v := &components.EBSVolume{
 				Name:             name,
 				AvailabilityZone: vol.AvailabilityZone,
@@ -27,7 +29,10 @@ func MapEBSVolumes(d *definition.Definition) []*components.EBSVolume {
 				Size:             vol.Size,
 				Iops:             vol.Iops,
 				Encrypted:        vol.Encrypted,
+				EncryptionKey:    vol.EncryptionKey,
 				EncryptionKeyID:  vol.EncryptionKeyID,
+				SnapshotID:       snapshotID,
+				SnapshotSize:     snapshotSize,
 				Tags:             mapEBSTags(name, d.Name, vol.Name),
 			}
 
@@ -40,6 +45,22 @@ func MapEBSVolumes(d *definition.Definition) []*components.EBSVolume {
 	return volumes
 }
 
+// mapEBSSnapshot : returns the id and size of the snapshot a volume is restored from.
+// An instance's volume group may restore its attached volume from a different snapshot
+func mapEBSSnapshot(d *definition.Definition, vol definition.EBSVolume, name string) (string, *int64) {
+	for _, instance := range d.Instances {
+		for i := 0; i < instance.Count; i++ {
+			for _, iv := range instance.Volumes {
+				if iv.Volume == vol.Name && iv.SnapshotID != "" && iv.Volume+"-"+strconv.Itoa(i+1) == name {
+					return iv.SnapshotID, iv.SnapshotSize
+				}
+			}
+		}
+	}
+
+	return vol.SnapshotID, vol.SnapshotSize
+}
+
 // MapDefinitionEBSVolumes : Maps components ebs volumes into a definition defined ebs volumes
 func MapDefinitionEBSVolumes(g *graph.Graph) []definition.EBSVolume {
 	var vols []definition.EBSVolume
@@ -54,22 +75,58 @@ func MapDefinitionEBSVolumes(g *graph.Graph) []definition.EBSVolume {
 		}
 
 		firstVolume := vs[0].(*components.EBSVolume)
+		snapshotID, snapshotSize := mapDefinitionEBSSnapshot(g, vg)
 
-		vols = append(vols, definition.EBSVolume{
+		v := definition.EBSVolume{
 			Name:             vg,
 			Type:             firstVolume.VolumeType,
 			Size:             firstVolume.Size,
 			Iops:             firstVolume.Iops,
 			AvailabilityZone: firstVolume.AvailabilityZone,
 			Encrypted:        firstVolume.Encrypted,
-			EncryptionKeyID:  firstVolume.EncryptionKeyID,
+			EncryptionKey:    firstVolume.EncryptionKey,
+			SnapshotID:       snapshotID,
+			SnapshotSize:     snapshotSize,
 			Count:            len(vs),
-		})
+		}
+
+		// keys managed by ernest are referenced by name only
+		if firstVolume.EncryptionKey == "" {
+			v.EncryptionKeyID = firstVolume.EncryptionKeyID
+		}
+
+		vols = append(vols, v)
 
 	}
 
 	return vols
 }
+
+// mapDefinitionEBSSnapshot : returns the snapshot a volume group is restored from. Volumes
+// attached to an instance may be restored from the instance's own snapshot, so only the
+// first unattached volume is used. If all volumes are attached, the snapshot of each is
+// kept on its instance's volumes
+func mapDefinitionEBSSnapshot(g *graph.Graph, volumeGroup string) (string, *int64) {
+	attached := make(map[string]bool)
+
+	for _, c := range g.GetComponents().ByType("instance") {
+		for _, vol := range c.(*components.Instance).Volumes {
+			if vol.VolumeAWSID != "" {
+				attached[vol.VolumeAWSID] = true
+			}
+		}
+	}
+
+	for _, c := range g.GetComponents().ByType("ebs_volume").ByGroup("ernest.volume_group", volumeGroup) {
+		v := c.(*components.EBSVolume)
+		if attached[v.VolumeAWSID] != true {
+			return v.SnapshotID, v.SnapshotSize
+		}
+	}
+
+	return "", nil
+}
+
 func mapEBSTags(name, service, volumeGroup string) map[string]string {
 	tags := make(map[string]string)
 
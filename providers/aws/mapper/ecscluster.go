@@ -0,0 +1,46 @@
+/* This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at http://mozilla.org/MPL/2.0/. */
+
+package mapper
+
+import (
+	"github.com/ernestio/libmapper/providers/aws/components"
+	"github.com/ernestio/libmapper/providers/aws/definition"
+	graph "gopkg.in/r3labs/graph.v2"
+)
+
+// MapECSClusters : Maps the ecs clusters from a given input payload.
+func MapECSClusters(d *definition.Definition) []*components.ECSCluster {
+	var clusters []*components.ECSCluster
+
+	for _, cluster := range d.ECSClusters {
+		c := &components.ECSCluster{
+			Name:              cluster.Name,
+			ContainerInsights: cluster.ContainerInsights,
+			Tags:              mapTags(cluster.Name, d.Name),
+		}
+
+		c.SetDefaultVariables()
+
+		clusters = append(clusters, c)
+	}
+
+	return clusters
+}
+
+// MapDefinitionECSClusters : Maps components ecs clusters into a definition defined ecs clusters
+func MapDefinitionECSClusters(g *graph.Graph) []definition.ECSCluster {
+	var clusters []definition.ECSCluster
+
+	for _, c := range g.GetComponents().ByType("ecs_cluster") {
+		cluster := c.(*components.ECSCluster)
+
+		clusters = append(clusters, definition.ECSCluster{
+			Name:              cluster.Name,
+			ContainerInsights: cluster.ContainerInsights,
+		})
+	}
+
+	return clusters
+}
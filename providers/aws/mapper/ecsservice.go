@@ -0,0 +1,78 @@
+/* This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at http://mozilla.org/MPL/2.0/. */
+
+package mapper
+
+import (
+	"strings"
+
+	"github.com/ernestio/libmapper/providers/aws/components"
+	"github.com/ernestio/libmapper/providers/aws/definition"
+	graph "gopkg.in/r3labs/graph.v2"
+)
+
+// MapECSServices : Maps the ecs services from a given input payload.
+func MapECSServices(d *definition.Definition) []*components.ECSService {
+	var services []*components.ECSService
+
+	for _, service := range d.ECSServices {
+		s := &components.ECSService{
+			Name:           service.Name,
+			Cluster:        service.Cluster,
+			TaskDefinition: service.TaskDefinition,
+			LaunchType:     strings.ToUpper(service.LaunchType),
+			DesiredCount:   service.DesiredCount,
+			Networks:       service.Networks,
+			SecurityGroups: service.SecurityGroups,
+			AssignPublicIP: service.PublicIP,
+			Tags:           mapTags(service.Name, d.Name),
+		}
+
+		for _, lb := range service.LoadBalancers {
+			s.LoadBalancers = append(s.LoadBalancers, components.ECSLoadBalancer{
+				ELB:           lb.ELB,
+				ContainerName: lb.ContainerName,
+				ContainerPort: lb.ContainerPort,
+			})
+		}
+
+		s.SetDefaultVariables()
+
+		services = append(services, s)
+	}
+
+	return services
+}
+
+// MapDefinitionECSServices : Maps components ecs services into a definition defined ecs services
+func MapDefinitionECSServices(g *graph.Graph) []definition.ECSService {
+	var services []definition.ECSService
+
+	for _, c := range g.GetComponents().ByType("ecs_service") {
+		service := c.(*components.ECSService)
+
+		s := definition.ECSService{
+			Name:           service.Name,
+			Cluster:        service.Cluster,
+			TaskDefinition: service.TaskDefinition,
+			LaunchType:     strings.ToLower(service.LaunchType),
+			DesiredCount:   service.DesiredCount,
+			Networks:       service.Networks,
+			SecurityGroups: service.SecurityGroups,
+			PublicIP:       service.AssignPublicIP,
+		}
+
+		for _, lb := range service.LoadBalancers {
+			s.LoadBalancers = append(s.LoadBalancers, definition.ECSLoadBalancer{
+				ELB:           lb.ELB,
+				ContainerName: lb.ContainerName,
+				ContainerPort: lb.ContainerPort,
+			})
+		}
+
+		services = append(services, s)
+	}
+
+	return services
+}
@@ -0,0 +1,122 @@
+/* This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at http://mozilla.org/MPL/2.0/. */
+
+package mapper
+
+import (
+	"strings"
+
+	"github.com/ernestio/libmapper/providers/aws/components"
+	"github.com/ernestio/libmapper/providers/aws/definition"
+	graph "gopkg.in/r3labs/graph.v2"
+)
+
+// MapECSTaskDefinitions : Maps the ecs task definitions from a given input payload.
+func MapECSTaskDefinitions(d *definition.Definition) []*components.ECSTaskDefinition {
+	var tds []*components.ECSTaskDefinition
+
+	for _, td := range d.ECSTaskDefinitions {
+		t := &components.ECSTaskDefinition{
+			Name:             td.Name,
+			LaunchType:       strings.ToUpper(td.LaunchType),
+			NetworkMode:      td.NetworkMode,
+			CPU:              td.CPU,
+			Memory:           td.Memory,
+			ExecutionRoleARN: td.ExecutionRole,
+			TaskRoleARN:      td.TaskRole,
+			Tags:             mapTags(td.Name, d.Name),
+		}
+
+		for _, container := range td.Containers {
+			c := components.ECSContainerDefinition{
+				Name:              container.Name,
+				Image:             container.Image,
+				CPU:               container.CPU,
+				Memory:            container.Memory,
+				MemoryReservation: container.MemoryReservation,
+				Essential:         container.Essential == nil || *container.Essential,
+				Command:           container.Command,
+				Environment:       container.Environment,
+			}
+
+			for _, port := range container.Ports {
+				c.PortMappings = append(c.PortMappings, components.ECSPortMapping{
+					ContainerPort: port.ContainerPort,
+					HostPort:      port.HostPort,
+					Protocol:      strings.ToLower(port.Protocol),
+				})
+			}
+
+			if container.Log != nil {
+				c.LogConfiguration = &components.ECSLogConfiguration{
+					Driver:  container.Log.Driver,
+					Options: container.Log.Options,
+				}
+			}
+
+			t.Containers = append(t.Containers, c)
+		}
+
+		t.SetDefaultVariables()
+
+		tds = append(tds, t)
+	}
+
+	return tds
+}
+
+// MapDefinitionECSTaskDefinitions : Maps components ecs task definitions into a definition defined ecs task definitions
+func MapDefinitionECSTaskDefinitions(g *graph.Graph) []definition.ECSTaskDefinition {
+	var tds []definition.ECSTaskDefinition
+
+	for _, c := range g.GetComponents().ByType("ecs_task_definition") {
+		td := c.(*components.ECSTaskDefinition)
+
+		t := definition.ECSTaskDefinition{
+			Name:          td.Name,
+			LaunchType:    strings.ToLower(td.LaunchType),
+			NetworkMode:   td.NetworkMode,
+			CPU:           td.CPU,
+			Memory:        td.Memory,
+			ExecutionRole: td.ExecutionRoleARN,
+			TaskRole:      td.TaskRoleARN,
+		}
+
+		for _, container := range td.Containers {
+			essential := container.Essential
+
+			dc := definition.ECSContainer{
+				Name:              container.Name,
+				Image:             container.Image,
+				CPU:               container.CPU,
+				Memory:            container.Memory,
+				MemoryReservation: container.MemoryReservation,
+				Essential:         &essential,
+				Command:           container.Command,
+				Environment:       container.Environment,
+			}
+
+			for _, pm := range container.PortMappings {
+				dc.Ports = append(dc.Ports, definition.ECSPortMapping{
+					ContainerPort: pm.ContainerPort,
+					HostPort:      pm.HostPort,
+					Protocol:      pm.Protocol,
+				})
+			}
+
+			if container.LogConfiguration != nil {
+				dc.Log = &definition.ECSLogConfiguration{
+					Driver:  container.LogConfiguration.Driver,
+					Options: container.LogConfiguration.Options,
+				}
+			}
+
+			t.Containers = append(t.Containers, dc)
+		}
+
+		tds = append(tds, t)
+	}
+
+	return tds
+}
@@ -0,0 +1,130 @@
+/* This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at http://mozilla.org/MPL/2.0/. */
+
+package mapper
+
+import (
+	"github.com/ernestio/libmapper/providers/aws/components"
+	"github.com/ernestio/libmapper/providers/aws/definition"
+	graph "gopkg.in/r3labs/graph.v2"
+)
+
+// MapEKSClusters : Maps the eks clusters from a given input payload.
+func MapEKSClusters(d *definition.Definition) []*components.EKSCluster {
+	var clusters []*components.EKSCluster
+
+	for _, cluster := range d.EKSClusters {
+		e := &components.EKSCluster{
+			Name:              cluster.Name,
+			Version:           cluster.Version,
+			RoleARN:           cluster.Role,
+			Networks:          cluster.Networks,
+			SecurityGroups:    cluster.SecurityGroups,
+			PrivateAccess:     cluster.PrivateAccess,
+			PublicAccess:      cluster.PublicAccess == nil || *cluster.PublicAccess,
+			PublicAccessCIDRs: cluster.PublicAccessCIDRs,
+			Tags:              mapTags(cluster.Name, d.Name),
+		}
+
+		e.SetDefaultVariables()
+
+		clusters = append(clusters, e)
+	}
+
+	return clusters
+}
+
+// MapEKSNodeGroups : Maps the eks node groups from a given input payload.
+func MapEKSNodeGroups(d *definition.Definition) []*components.EKSNodeGroup {
+	var groups []*components.EKSNodeGroup
+
+	for _, group := range d.EKSNodeGroups {
+		e := &components.EKSNodeGroup{
+			Name:          group.Name,
+			Cluster:       group.Cluster,
+			RoleARN:       group.Role,
+			InstanceTypes: group.InstanceTypes,
+			DiskSize:      group.DiskSize,
+			MinSize:       group.Scaling.Min,
+			MaxSize:       group.Scaling.Max,
+			DesiredSize:   group.Scaling.Desired,
+			Networks:      group.Networks,
+			Labels:        group.Labels,
+			Tags:          mapTags(group.Name, d.Name),
+		}
+
+		for _, taint := range group.Taints {
+			e.Taints = append(e.Taints, components.EKSTaint{
+				Key:    taint.Key,
+				Value:  taint.Value,
+				Effect: taint.Effect,
+			})
+		}
+
+		e.SetDefaultVariables()
+
+		groups = append(groups, e)
+	}
+
+	return groups
+}
+
+// MapDefinitionEKSClusters : Maps components eks clusters into a definition defined eks clusters
+func MapDefinitionEKSClusters(g *graph.Graph) []definition.EKSCluster {
+	var clusters []definition.EKSCluster
+
+	for _, c := range g.GetComponents().ByType("eks_cluster") {
+		e := c.(*components.EKSCluster)
+		public := e.PublicAccess
+
+		clusters = append(clusters, definition.EKSCluster{
+			Name:              e.Name,
+			Version:           e.Version,
+			Role:              e.RoleARN,
+			Networks:          e.Networks,
+			SecurityGroups:    e.SecurityGroups,
+			PrivateAccess:     e.PrivateAccess,
+			PublicAccess:      &public,
+			PublicAccessCIDRs: e.PublicAccessCIDRs,
+		})
+	}
+
+	return clusters
+}
+
+// MapDefinitionEKSNodeGroups : Maps components eks node groups into a definition defined eks node groups
+func MapDefinitionEKSNodeGroups(g *graph.Graph) []definition.EKSNodeGroup {
+	var groups []definition.EKSNodeGroup
+
+	for _, c := range g.GetComponents().ByType("eks_node_group") {
+		e := c.(*components.EKSNodeGroup)
+
+		group := definition.EKSNodeGroup{
+			Name:          e.Name,
+			Cluster:       e.Cluster,
+			Role:          e.RoleARN,
+			InstanceTypes: e.InstanceTypes,
+			DiskSize:      e.DiskSize,
+			Networks:      e.Networks,
+			Labels:        e.Labels,
+			Scaling: definition.EKSScaling{
+				Min:     e.MinSize,
+				Max:     e.MaxSize,
+				Desired: e.DesiredSize,
+			},
+		}
+
+		for _, taint := range e.Taints {
+			group.Taints = append(group.Taints, definition.EKSTaint{
+				Key:    taint.Key,
+				Value:  taint.Value,
+				Effect: taint.Effect,
+			})
+		}
+
+		groups = append(groups, group)
+	}
+
+	return groups
+}
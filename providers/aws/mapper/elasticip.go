@@ -0,0 +1,151 @@
+/* This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at http://mozilla.org/MPL/2.0/. */
+
+package mapper
+
+import (
+	"strconv"
+
+	"github.com/ernestio/libmapper/providers/aws/components"
+	"github.com/ernestio/libmapper/providers/aws/definition"
+	graph "gopkg.in/r3labs/graph.v2"
+)
+
+// MapElasticIPs : Maps the elastic ips from a given input payload, including the ones requested by instance groups.
+func MapElasticIPs(d *definition.Definition) []*components.ElasticIP {
+	var eips []*components.ElasticIP
+
+	for _, eip := range d.ElasticIPs {
+		e := &components.ElasticIP{
+			Name:           eip.Name,
+			ElasticIPAWSID: eip.ID,
+			Instance:       eip.Instance,
+			Tags:           mapTags(eip.Name, d.Name),
+		}
+
+		e.SetDefaultVariables()
+
+		eips = append(eips, e)
+	}
+
+	for _, instance := range d.Instances {
+		if instance.ElasticIP != true {
+			continue
+		}
+
+		for i := 0; i < instance.Count; i++ {
+			name := instance.Name + "-" + strconv.Itoa(i+1)
+
+			e := &components.ElasticIP{
+				Name:     mapElasticIPName(name),
+				Instance: name,
+				Tags:     mapElasticIPTags(mapElasticIPName(name), d.Name, instance.Name),
+			}
+
+			e.SetDefaultVariables()
+
+			eips = append(eips, e)
+		}
+	}
+
+	return eips
+}
+
+// MapDefinitionElasticIPs : Maps components elastic ips into a definition defined elastic ips
+func MapDefinitionElasticIPs(g *graph.Graph) []definition.ElasticIP {
+	var eips []definition.ElasticIP
+
+	for _, c := range g.GetComponents().ByType("elastic_ip") {
+		e := c.(*components.ElasticIP)
+
+		// elastic ips requested by an instance group are mapped on the instance
+		if e.GetGroup() != "" {
+			continue
+		}
+
+		eips = append(eips, definition.ElasticIP{
+			ID:         e.ElasticIPAWSID,
+			Name:       e.Name,
+			Instance:   e.Instance,
+			NatGateway: mapDefinitionElasticIPNat(g, e.Name),
+		})
+	}
+
+	return eips
+}
+
+// migrateElasticIPs : moves elastic ips allocated inline on instances onto
+// their own elastic ip component, so the allocation is kept when the
+// instance is replaced
+func migrateElasticIPs(g *graph.Graph) error {
+	for _, c := range g.GetComponents().ByType("instance") {
+		i := c.(*components.Instance)
+
+		if i.ElasticIPAWSID == nil || *i.ElasticIPAWSID == "" {
+			continue
+		}
+
+		name := mapElasticIPName(i.Name)
+
+		if g.HasComponent(components.TYPEELASTICIP + components.TYPEDELIMITER + name) {
+			continue
+		}
+
+		e := &components.ElasticIP{
+			State:          i.State,
+			ElasticIPAWSID: *i.ElasticIPAWSID,
+			Name:           name,
+			IP:             i.ElasticIP,
+			Instance:       i.Name,
+			InstanceAWSID:  i.InstanceAWSID,
+			Tags:           mapElasticIPTags(name, i.GetTag("ernest.service"), i.GetGroup()),
+		}
+
+		e.SetDefaultVariables()
+
+		err := g.AddComponent(e)
+		if err != nil {
+			return err
+		}
+
+		err = g.Connect(i.GetID(), e.GetID())
+		if err != nil {
+			return err
+		}
+
+		i.ElasticIP = ""
+		i.ElasticIPAWSID = nil
+		i.AssignElasticIP = false
+	}
+
+	return nil
+}
+
+// mapDefinitionElasticIPNat : returns the nat gateway the elastic ip is allocated to
+func mapDefinitionElasticIPNat(g *graph.Graph, name string) string {
+	for _, c := range g.GetComponents().ByType("nat") {
+		n := c.(*components.NatGateway)
+		if n.ElasticIP == name {
+			return n.Name
+		}
+	}
+
+	return ""
+}
+
+// mapElasticIPName : names the elastic ip of an instance group's instance, so it
+// does not collide with standalone elastic ips named after the instance
+func mapElasticIPName(instance string) string {
+	return instance + "-eip"
+}
+
+func mapElasticIPTags(name, service, instanceGroup string) map[string]string {
+	tags := make(map[string]string)
+
+	tags["Name"] = name
+	tags["ernest.service"] = service
+	tags["ernest.elastic_ip_group"] = instanceGroup
+
+	return tags
+}
@@ -0,0 +1,66 @@
+/* This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at http://mozilla.org/MPL/2.0/. */
+
+package mapper
+
+import (
+	"testing"
+
+	"github.com/ernestio/libmapper/providers/aws/definition"
+	. "github.com/smartystreets/goconvey/convey"
+	graph "gopkg.in/r3labs/graph.v2"
+)
+
+func TestMapElasticIPs(t *testing.T) {
+	Convey("Given a definition with an instance group and a standalone elastic ip named after its instance", t, func() {
+		d := definition.New()
+		err := d.LoadJSON([]byte(`{"name":"test",
+			"instances":[{"name":"web","type":"t2.micro","image":"ami-12345678","count":1,"network":"web","start_ip":"10.0.1.10","elastic_ip":true}],
+			"elastic_ips":[{"name":"web-1","instance":"web-1"}]
+		}`))
+		So(err, ShouldBeNil)
+
+		Convey("When mapping its elastic ips", func() {
+			eips := MapElasticIPs(d)
+
+			Convey("It should name the instance group's elastic ips apart from standalone ones", func() {
+				So(eips, ShouldHaveLength, 2)
+				So(eips[0].GetID(), ShouldEqual, "elastic_ip::web-1")
+				So(eips[1].GetID(), ShouldEqual, "elastic_ip::web-1-eip")
+				So(eips[1].Instance, ShouldEqual, "web-1")
+			})
+		})
+	})
+
+	Convey("Given a graph with an elastic ip allocated to a nat gateway", t, func() {
+		d := definition.New()
+		err := d.LoadJSON([]byte(`{"name":"test",
+			"nat_gateways":[{"name":"nat","public_network":"web"}],
+			"elastic_ips":[{"name":"nat-ip","nat_gateway":"nat"}]
+		}`))
+		So(err, ShouldBeNil)
+
+		g := graph.New()
+
+		for _, n := range MapNats(d) {
+			g.AddComponent(n)
+		}
+
+		for _, e := range MapElasticIPs(d) {
+			g.AddComponent(e)
+		}
+
+		for _, c := range g.Components {
+			c.Rebuild(g)
+		}
+
+		Convey("When mapping it back to a definition", func() {
+			eips := MapDefinitionElasticIPs(g)
+
+			Convey("It should map the nat gateway that holds it", func() {
+				So(eips, ShouldResemble, []definition.ElasticIP{{Name: "nat-ip", NatGateway: "nat"}})
+			})
+		})
+	})
+}
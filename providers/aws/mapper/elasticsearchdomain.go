@@ -0,0 +1,92 @@
+/* This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at http://mozilla.org/MPL/2.0/. */
+
+package mapper
+
+import (
+	"github.com/ernestio/libmapper/providers/aws/components"
+	"github.com/ernestio/libmapper/providers/aws/definition"
+	graph "gopkg.in/r3labs/graph.v2"
+)
+
+// MapElasticsearchDomains : Maps the elasticsearch domains from a given input payload.
+func MapElasticsearchDomains(d *definition.Definition) []*components.ElasticsearchDomain {
+	var domains []*components.ElasticsearchDomain
+
+	for _, domain := range d.ElasticsearchDomains {
+		e := &components.ElasticsearchDomain{
+			Name:             domain.Name,
+			Version:          domain.Version,
+			InstanceType:     domain.InstanceType,
+			InstanceCount:    domain.InstanceCount,
+			ZoneAwareness:    domain.ZoneAwareness,
+			Networks:         domain.Networks,
+			SecurityGroups:   domain.SecurityGroups,
+			EncryptionAtRest: domain.Encrypted,
+			EncryptionKey:    domain.EncryptionKey,
+			AccessPolicy:     domain.AccessPolicy,
+			Tags:             mapTags(domain.Name, d.Name),
+		}
+
+		if domain.DedicatedMasters != nil {
+			e.DedicatedMasterEnabled = true
+			e.DedicatedMasterType = domain.DedicatedMasters.Type
+			e.DedicatedMasterCount = domain.DedicatedMasters.Count
+		}
+
+		if domain.Storage != nil {
+			e.EBSEnabled = true
+			e.VolumeType = domain.Storage.Type
+			e.VolumeSize = domain.Storage.Size
+			e.Iops = domain.Storage.Iops
+		}
+
+		e.SetDefaultVariables()
+
+		domains = append(domains, e)
+	}
+
+	return domains
+}
+
+// MapDefinitionElasticsearchDomains : Maps components elasticsearch domains into a definition defined elasticsearch domains
+func MapDefinitionElasticsearchDomains(g *graph.Graph) []definition.ElasticsearchDomain {
+	var domains []definition.ElasticsearchDomain
+
+	for _, c := range g.GetComponents().ByType("elasticsearch_domain") {
+		e := c.(*components.ElasticsearchDomain)
+
+		domain := definition.ElasticsearchDomain{
+			Name:           e.Name,
+			Version:        e.Version,
+			InstanceType:   e.InstanceType,
+			InstanceCount:  e.InstanceCount,
+			ZoneAwareness:  e.ZoneAwareness,
+			Networks:       e.Networks,
+			SecurityGroups: e.SecurityGroups,
+			Encrypted:      e.EncryptionAtRest,
+			EncryptionKey:  e.EncryptionKey,
+			AccessPolicy:   e.AccessPolicy,
+		}
+
+		if e.DedicatedMasterEnabled {
+			domain.DedicatedMasters = &definition.ElasticsearchMasters{
+				Type:  e.DedicatedMasterType,
+				Count: e.DedicatedMasterCount,
+			}
+		}
+
+		if e.EBSEnabled {
+			domain.Storage = &definition.ElasticsearchStorage{
+				Type: e.VolumeType,
+				Size: e.VolumeSize,
+				Iops: e.Iops,
+			}
+		}
+
+		domains = append(domains, domain)
+	}
+
+	return domains
+}
@@ -28,10 +28,11 @@ func MapELBs(d *definition.Definition) []*components.ELB {
 
 		for _, listener := range elb.Listeners {
 			e.Listeners = append(e.Listeners, components.ELBListener{
-				FromPort: listener.FromPort,
-				ToPort:   listener.ToPort,
-				Protocol: strings.ToUpper(listener.Protocol),
-				SSLCert:  listener.SSLCert,
+				FromPort:       listener.FromPort,
+				ToPort:         listener.ToPort,
+				Protocol:       strings.ToUpper(listener.Protocol),
+				SSLCert:        listener.SSLCert,
+				SSLCertificate: listener.SSLCertificate,
 			})
 		}
 
@@ -56,15 +57,23 @@ func MapDefinitionELBs(g *graph.Graph) []definition.ELB {
 			Subnets:        elb.Networks,
 			Instances:      elb.Instances,
 			SecurityGroups: elb.SecurityGroups,
+			Alarms:         MapDefinitionAlarms(g, "elb", elb.Name),
 		}
 
 		for _, l := range elb.Listeners {
-			e.Listeners = append(e.Listeners, definition.ELBListener{
-				FromPort: l.FromPort,
-				ToPort:   l.ToPort,
-				Protocol: strings.ToLower(l.Protocol),
-				SSLCert:  l.SSLCert,
-			})
+			dl := definition.ELBListener{
+				FromPort:       l.FromPort,
+				ToPort:         l.ToPort,
+				Protocol:       strings.ToLower(l.Protocol),
+				SSLCertificate: l.SSLCertificate,
+			}
+
+			// certificates managed by ernest are referenced by name only
+			if l.SSLCertificate == "" {
+				dl.SSLCert = l.SSLCert
+			}
+
+			e.Listeners = append(e.Listeners, dl)
 		}
 
 		elbs = append(elbs, e)
@@ -5,7 +5,9 @@
 package mapper
 
 import (
+	"math"
 	"net"
+	"reflect"
 	"strconv"
 
 	"github.com/ernestio/libmapper/providers/aws/components"
@@ -21,20 +23,44 @@ func MapInstances(d *definition.Definition) []*components.Instance {
 		ip := make(net.IP, net.IPv4len)
 		copy(ip, net.ParseIP(instance.StartIP))
 
+		mapInstanceLaunchTemplate(d, &instance)
+		ondemand := mapOnDemandCount(instance.Count, instance.Market)
+
 		for i := 0; i < instance.Count; i++ {
 			name := instance.Name + "-" + strconv.Itoa(i+1)
 
 			ci := &components.Instance{
-				Name:            name,
-				Type:            instance.Type,
-				Image:           instance.Image,
-				Network:         instance.Network,
-				IP:              ip.String(),
-				KeyPair:         instance.KeyPair,
-				AssignElasticIP: instance.ElasticIP,
-				SecurityGroups:  instance.SecurityGroups,
-				UserData:        instance.UserData,
-				Tags:            mapInstanceTags(name, d.Name, instance.Name),
+				Name:           name,
+				Type:           instance.Type,
+				Image:          instance.Image,
+				Network:        instance.Network,
+				IP:             ip.String(),
+				KeyPair:        instance.KeyPair,
+				SecurityGroups: instance.SecurityGroups,
+				UserData:       instance.UserData,
+				LaunchTemplate: instance.LaunchTemplate,
+				PlacementGroup: instance.PlacementGroup,
+				Tenancy:        instance.Tenancy,
+				EBSOptimized:   instance.EBSOptimized,
+				Monitoring:     instance.Monitoring,
+				RootVolume: components.InstanceRootVolume{
+					Size:      instance.RootVolume.Size,
+					Type:      instance.RootVolume.Type,
+					Encrypted: instance.RootVolume.Encrypted,
+				},
+				Tags: mapInstanceTags(name, d.Name, instance.Name),
+			}
+
+			ci.MarketType = instance.Market.Type
+			ci.SpotMaxPrice = instance.Market.MaxPrice
+			ci.SpotInterruption = instance.Market.InterruptionBehaviour
+			ci.OnDemandBase = instance.Market.OnDemandBase
+			ci.OnDemandPercentage = instance.Market.OnDemandPercentage
+
+			if instance.Market.Type == components.MARKETSPOT && i < ondemand {
+				ci.MarketType = components.MARKETONDEMAND
+				ci.SpotMaxPrice = ""
+				ci.SpotInterruption = ""
 			}
 
 			for _, vol := range instance.Volumes {
@@ -72,11 +98,7 @@ func MapDefinitionInstances(g *graph.Graph) []definition.Instance {
 		}
 
 		firstInstance := is[0].(*components.Instance)
-		elastic := false
-
-		if firstInstance.ElasticIP != "" {
-			elastic = true
-		}
+		elastic := len(g.GetComponents().ByType("elastic_ip").ByGroup("ernest.elastic_ip_group", ig)) > 0
 
 		instance := definition.Instance{
 			Name:           ig,
@@ -86,20 +108,63 @@ func MapDefinitionInstances(g *graph.Graph) []definition.Instance {
 			StartIP:        firstInstance.IP,
 			KeyPair:        firstInstance.KeyPair,
 			SecurityGroups: firstInstance.SecurityGroups,
+			UserData:       firstInstance.UserData,
 			ElasticIP:      elastic,
-			Count:          len(is),
+			LaunchTemplate: firstInstance.LaunchTemplate,
+			PlacementGroup: firstInstance.PlacementGroup,
+			Tenancy:        firstInstance.Tenancy,
+			EBSOptimized:   firstInstance.EBSOptimized,
+			Monitoring:     firstInstance.Monitoring,
+			RootVolume: definition.InstanceRootVolume{
+				Size:      firstInstance.RootVolume.Size,
+				Type:      firstInstance.RootVolume.Type,
+				Encrypted: firstInstance.RootVolume.Encrypted,
+			},
+			Count:  len(is),
+			Alarms: MapDefinitionAlarms(g, "instance", firstInstance.Name),
+		}
+
+		// all instances of a spot group hold its on demand split, as the
+		// group may be launched entirely on demand
+		for _, c := range is {
+			i := c.(*components.Instance)
+			if i.MarketType != components.MARKETSPOT && i.OnDemandBase < 1 && i.OnDemandPercentage < 1 {
+				continue
+			}
+
+			instance.Market.Type = components.MARKETSPOT
+			instance.Market.OnDemandBase = i.OnDemandBase
+			instance.Market.OnDemandPercentage = i.OnDemandPercentage
+
+			if i.MarketType == components.MARKETSPOT {
+				instance.Market.MaxPrice = i.SpotMaxPrice
+				instance.Market.InterruptionBehaviour = i.SpotInterruption
+			}
 		}
 
+		mapDefinitionInstanceLaunchTemplate(g, &instance)
+
 		for _, vol := range firstInstance.Volumes {
 			vc := g.GetComponents().ByProviderID(vol.VolumeAWSID)
 			if vc == nil {
 				continue
 			}
 
-			instance.Volumes = append(instance.Volumes, definition.InstanceVolume{
+			iv := definition.InstanceVolume{
 				Device: vol.Device,
 				Volume: vc.GetTag("ernest.volume_group"),
-			})
+			}
+
+			// volumes restored from their own snapshot keep it on the instance's volume
+			snapshotID, _ := mapDefinitionEBSSnapshot(g, iv.Volume)
+
+			ev, ok := vc.(*components.EBSVolume)
+			if ok && ev.SnapshotID != snapshotID {
+				iv.SnapshotID = ev.SnapshotID
+				iv.SnapshotSize = ev.SnapshotSize
+			}
+
+			instance.Volumes = append(instance.Volumes, iv)
 		}
 
 		instances = append(instances, instance)
@@ -109,6 +174,95 @@ func MapDefinitionInstances(g *graph.Graph) []definition.Instance {
 	return instances
 }
 
+// mapInstanceLaunchTemplate : fills any unset instance values from its launch template
+func mapInstanceLaunchTemplate(d *definition.Definition, instance *definition.Instance) {
+	for _, lt := range d.LaunchTemplates {
+		if lt.Name != instance.LaunchTemplate {
+			continue
+		}
+
+		if instance.Type == "" {
+			instance.Type = lt.Type
+		}
+
+		if instance.Image == "" {
+			instance.Image = lt.Image
+		}
+
+		if instance.KeyPair == "" {
+			instance.KeyPair = lt.KeyPair
+		}
+
+		if len(instance.SecurityGroups) < 1 {
+			instance.SecurityGroups = lt.SecurityGroups
+		}
+
+		if instance.UserData == "" {
+			instance.UserData = lt.UserData
+		}
+
+		if instance.Market.Type == "" {
+			instance.Market = lt.Market
+		}
+	}
+}
+
+// mapDefinitionInstanceLaunchTemplate : unsets any instance values that are
+// filled from its launch template
+func mapDefinitionInstanceLaunchTemplate(g *graph.Graph, instance *definition.Instance) {
+	for _, c := range g.GetComponents().ByType("launch_template") {
+		lt := c.(*components.LaunchTemplate)
+		if lt.Name != instance.LaunchTemplate {
+			continue
+		}
+
+		if instance.Type == lt.Type {
+			instance.Type = ""
+		}
+
+		if instance.Image == lt.Image {
+			instance.Image = ""
+		}
+
+		if instance.KeyPair == lt.KeyPair {
+			instance.KeyPair = ""
+		}
+
+		if reflect.DeepEqual(instance.SecurityGroups, lt.SecurityGroups) {
+			instance.SecurityGroups = nil
+		}
+
+		if instance.UserData == lt.UserData {
+			instance.UserData = ""
+		}
+
+		market := definition.InstanceMarket{
+			Type:                  lt.MarketType,
+			MaxPrice:              lt.SpotMaxPrice,
+			InterruptionBehaviour: lt.SpotInterruptionBehaviour,
+		}
+
+		if instance.Market == market {
+			instance.Market = definition.InstanceMarket{}
+		}
+	}
+}
+
+// mapOnDemandCount : returns how many instances of a group are launched on demand,
+// the rest being launched as spot instances
+func mapOnDemandCount(count int, market definition.InstanceMarket) int {
+	if market.Type != components.MARKETSPOT {
+		return count
+	}
+
+	base := market.OnDemandBase
+	if base > count {
+		base = count
+	}
+
+	return base + int(math.Ceil(float64(count-base)*float64(market.OnDemandPercentage)/100))
+}
+
 func mapInstanceTags(name, service, instanceGroup string) map[string]string {
 	tags := make(map[string]string)
 
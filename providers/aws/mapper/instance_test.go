@@ -0,0 +1,93 @@
+/* This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at http://mozilla.org/MPL/2.0/. */
+
+package mapper
+
+import (
+	"testing"
+
+	"github.com/ernestio/libmapper/providers/aws/definition"
+	. "github.com/smartystreets/goconvey/convey"
+	graph "gopkg.in/r3labs/graph.v2"
+)
+
+// testInstanceGraph : maps the instances and launch templates of a definition into a graph
+func testInstanceGraph(data string) *graph.Graph {
+	d := definition.New()
+	err := d.LoadJSON([]byte(data))
+	So(err, ShouldBeNil)
+
+	g := graph.New()
+
+	for _, lt := range MapLaunchTemplates(d) {
+		g.AddComponent(lt)
+	}
+
+	for _, i := range MapInstances(d) {
+		g.AddComponent(i)
+	}
+
+	return g
+}
+
+func TestMapDefinitionInstances(t *testing.T) {
+	Convey("Given a spot instance group with part of it launched on demand", t, func() {
+		g := testInstanceGraph(`{"name":"test","instances":[
+			{"name":"web","type":"t2.micro","image":"ami-12345678","count":4,"network":"web","start_ip":"10.0.1.10",
+			 "market":{"type":"spot","max_price":"0.05","on_demand_base":1,"on_demand_percentage":50}}
+		]}`)
+
+		Convey("When mapping it back to a definition", func() {
+			instances := MapDefinitionInstances(g)
+
+			Convey("It should keep the group's on demand split", func() {
+				So(instances, ShouldHaveLength, 1)
+				So(instances[0].Market, ShouldResemble, definition.InstanceMarket{
+					Type:               "spot",
+					MaxPrice:           "0.05",
+					OnDemandBase:       1,
+					OnDemandPercentage: 50,
+				})
+			})
+		})
+	})
+
+	Convey("Given a spot instance group launched entirely on demand", t, func() {
+		g := testInstanceGraph(`{"name":"test","instances":[
+			{"name":"web","type":"t2.micro","image":"ami-12345678","count":2,"network":"web","start_ip":"10.0.1.10",
+			 "market":{"type":"spot","on_demand_base":2}}
+		]}`)
+
+		Convey("When mapping it back to a definition", func() {
+			instances := MapDefinitionInstances(g)
+
+			Convey("It should keep the group on the spot market", func() {
+				So(instances[0].Market.Type, ShouldEqual, "spot")
+				So(instances[0].Market.OnDemandBase, ShouldEqual, 2)
+			})
+		})
+	})
+
+	Convey("Given an instance group launched from a launch template", t, func() {
+		g := testInstanceGraph(`{"name":"test",
+			"launch_templates":[{"name":"lt","type":"t2.micro","image":"ami-12345678","key_pair":"key",
+			 "security_groups":["sg"],"user_data":"echo","market":{"type":"spot","max_price":"0.05"}}],
+			"instances":[{"name":"web","image":"ami-87654321","count":2,"network":"web","start_ip":"10.0.1.10","launch_template":"lt"}]
+		}`)
+
+		Convey("When mapping it back to a definition", func() {
+			instances := MapDefinitionInstances(g)
+
+			Convey("It should only map the values that differ from the launch template", func() {
+				So(instances[0].LaunchTemplate, ShouldEqual, "lt")
+				So(instances[0].Image, ShouldEqual, "ami-87654321")
+				So(instances[0].Type, ShouldEqual, "")
+				So(instances[0].KeyPair, ShouldEqual, "")
+				So(instances[0].SecurityGroups, ShouldBeNil)
+				So(instances[0].UserData, ShouldEqual, "")
+				So(instances[0].Market, ShouldResemble, definition.InstanceMarket{})
+			})
+		})
+	})
+}
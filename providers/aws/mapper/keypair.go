@@ -0,0 +1,46 @@
+/* This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at http://mozilla.org/MPL/2.0/. */
+
+package mapper
+
+import (
+	"github.com/ernestio/libmapper/providers/aws/components"
+	"github.com/ernestio/libmapper/providers/aws/definition"
+	graph "gopkg.in/r3labs/graph.v2"
+)
+
+// MapKeyPairs : Maps the key pairs from a given input payload.
+func MapKeyPairs(d *definition.Definition) []*components.KeyPair {
+	var kps []*components.KeyPair
+
+	for _, kp := range d.KeyPairs {
+		k := &components.KeyPair{
+			Name:      kp.Name,
+			PublicKey: kp.PublicKey,
+			Tags:      mapTags(kp.Name, d.Name),
+		}
+
+		k.SetDefaultVariables()
+
+		kps = append(kps, k)
+	}
+
+	return kps
+}
+
+// MapDefinitionKeyPairs : Maps components key pairs into a definition defined key pairs
+func MapDefinitionKeyPairs(g *graph.Graph) []definition.KeyPair {
+	var kps []definition.KeyPair
+
+	for _, c := range g.GetComponents().ByType("key_pair") {
+		k := c.(*components.KeyPair)
+
+		kps = append(kps, definition.KeyPair{
+			Name:      k.Name,
+			PublicKey: k.PublicKey,
+		})
+	}
+
+	return kps
+}
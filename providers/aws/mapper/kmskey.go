@@ -0,0 +1,54 @@
+/* This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at http://mozilla.org/MPL/2.0/. */
+
+package mapper
+
+import (
+	"github.com/ernestio/libmapper/providers/aws/components"
+	"github.com/ernestio/libmapper/providers/aws/definition"
+	graph "gopkg.in/r3labs/graph.v2"
+)
+
+// MapKMSKeys : Maps the kms keys from a given input payload.
+func MapKMSKeys(d *definition.Definition) []*components.KMSKey {
+	var keys []*components.KMSKey
+
+	for _, key := range d.KMSKeys {
+		k := &components.KMSKey{
+			Name:           key.Name,
+			Alias:          key.Alias,
+			Description:    key.Description,
+			Policy:         key.Policy,
+			KeyRotation:    key.Rotation,
+			DeletionWindow: key.DeletionWindow,
+			Tags:           mapTags(key.Name, d.Name),
+		}
+
+		k.SetDefaultVariables()
+
+		keys = append(keys, k)
+	}
+
+	return keys
+}
+
+// MapDefinitionKMSKeys : Maps components kms keys into a definition defined kms keys
+func MapDefinitionKMSKeys(g *graph.Graph) []definition.KMSKey {
+	var keys []definition.KMSKey
+
+	for _, c := range g.GetComponents().ByType("kms_key") {
+		k := c.(*components.KMSKey)
+
+		keys = append(keys, definition.KMSKey{
+			Name:           k.Name,
+			Alias:          k.Alias,
+			Description:    k.Description,
+			Policy:         k.Policy,
+			Rotation:       k.KeyRotation,
+			DeletionWindow: k.DeletionWindow,
+		})
+	}
+
+	return keys
+}
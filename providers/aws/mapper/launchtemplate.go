@@ -0,0 +1,65 @@
+/* This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at http://mozilla.org/MPL/2.0/. */
+
+package mapper
+
+import (
+	"github.com/ernestio/libmapper/providers/aws/components"
+	"github.com/ernestio/libmapper/providers/aws/definition"
+	graph "gopkg.in/r3labs/graph.v2"
+)
+
+// MapLaunchTemplates : Maps the launch templates from a given input payload.
+func MapLaunchTemplates(d *definition.Definition) []*components.LaunchTemplate {
+	var templates []*components.LaunchTemplate
+
+	for _, template := range d.LaunchTemplates {
+		lt := &components.LaunchTemplate{
+			Name:           template.Name,
+			Type:           template.Type,
+			Image:          template.Image,
+			KeyPair:        template.KeyPair,
+			SecurityGroups: template.SecurityGroups,
+			UserData:       template.UserData,
+			MarketType:     template.Market.Type,
+			Tags:           mapTags(template.Name, d.Name),
+		}
+
+		if template.Market.Type == components.MARKETSPOT {
+			lt.SpotMaxPrice = template.Market.MaxPrice
+			lt.SpotInterruptionBehaviour = template.Market.InterruptionBehaviour
+		}
+
+		lt.SetDefaultVariables()
+
+		templates = append(templates, lt)
+	}
+
+	return templates
+}
+
+// MapDefinitionLaunchTemplates : Maps components launch templates into a definition defined launch templates
+func MapDefinitionLaunchTemplates(g *graph.Graph) []definition.LaunchTemplate {
+	var templates []definition.LaunchTemplate
+
+	for _, c := range g.GetComponents().ByType("launch_template") {
+		lt := c.(*components.LaunchTemplate)
+
+		templates = append(templates, definition.LaunchTemplate{
+			Name:           lt.Name,
+			Type:           lt.Type,
+			Image:          lt.Image,
+			KeyPair:        lt.KeyPair,
+			SecurityGroups: lt.SecurityGroups,
+			UserData:       lt.UserData,
+			Market: definition.InstanceMarket{
+				Type:                  lt.MarketType,
+				MaxPrice:              lt.SpotMaxPrice,
+				InterruptionBehaviour: lt.SpotInterruptionBehaviour,
+			},
+		})
+	}
+
+	return templates
+}
@@ -15,7 +15,7 @@ import (
 )
 
 // SUPPORTEDCOMPONENTS represents all component types supported by ernest
-var SUPPORTEDCOMPONENTS = []string{"vpc", "network", "instance", "security_group", "nat_gateway", "elb", "ebs", "s3", "route53", "rds_instance", "rds_cluster"}
+var SUPPORTEDCOMPONENTS = []string{"vpc", "network", "instance", "security_group", "nat_gateway", "elb", "ebs", "s3", "route53", "rds_instance", "rds_cluster", "kms_key", "acm_certificate", "cloudwatch_alarm", "elastic_ip", "key_pair", "vpc_endpoint", "ecs_cluster", "ecs_task_definition", "ecs_service", "secret", "snapshot_policy", "launch_template", "placement_group", "vpn_gateway", "customer_gateway", "vpn_connection", "transit_gateway", "transit_gateway_attachment", "elasticsearch_domain", "eks_cluster", "eks_node_group"}
 
 // Mapper : implements the generic mapper structure
 type Mapper struct{}
@@ -62,7 +62,8 @@ func (m Mapper) ConvertDefinition(gd libmapper.Definition) (*graph.Graph, error)
 		}
 	}
 
-	return g, nil
+	// Ensure the graph can be built
+	return g, libmapper.CheckCycles(g)
 }
 
 // ConvertGraph : converts the service graph into an input yaml format
@@ -92,6 +93,26 @@ func (m Mapper) ConvertGraph(g *graph.Graph) (libmapper.Definition, error) {
 	d.EBSVolumes = MapDefinitionEBSVolumes(g)
 	d.NatGateways = MapDefinitionNats(g)
 	d.RDSClusters = MapDefinitionRDSClusters(g)
+	d.KMSKeys = MapDefinitionKMSKeys(g)
+	d.ACMCertificates = MapDefinitionACMCertificates(g)
+	d.ElasticIPs = MapDefinitionElasticIPs(g)
+	d.KeyPairs = MapDefinitionKeyPairs(g)
+	d.VpcEndpoints = MapDefinitionVpcEndpoints(g)
+	d.ECSClusters = MapDefinitionECSClusters(g)
+	d.ECSTaskDefinitions = MapDefinitionECSTaskDefinitions(g)
+	d.ECSServices = MapDefinitionECSServices(g)
+	d.Secrets = MapDefinitionSecrets(g)
+	d.SnapshotPolicies = MapDefinitionSnapshotPolicies(g)
+	d.LaunchTemplates = MapDefinitionLaunchTemplates(g)
+	d.PlacementGroups = MapDefinitionPlacementGroups(g)
+	d.VpnGateways = MapDefinitionVpnGateways(g)
+	d.CustomerGateways = MapDefinitionCustomerGateways(g)
+	d.VpnConnections = MapDefinitionVpnConnections(g)
+	d.TransitGateways = MapDefinitionTransitGateways(g)
+	d.TransitGatewayAttachments = MapDefinitionTransitGatewayAttachments(g)
+	d.ElasticsearchDomains = MapDefinitionElasticsearchDomains(g)
+	d.EKSClusters = MapDefinitionEKSClusters(g)
+	d.EKSNodeGroups = MapDefinitionEKSNodeGroups(g)
 
 	return d, nil
 }
@@ -133,6 +154,48 @@ func (m Mapper) LoadGraph(gg map[string]interface{}) (*graph.Graph, error) {
 			c = &components.NatGateway{}
 		case "rds_cluster":
 			c = &components.RDSCluster{}
+		case "kms_key":
+			c = &components.KMSKey{}
+		case "acm_certificate":
+			c = &components.ACMCertificate{}
+		case "cloudwatch_alarm":
+			c = &components.CloudWatchAlarm{}
+		case "elastic_ip":
+			c = &components.ElasticIP{}
+		case "key_pair":
+			c = &components.KeyPair{}
+		case "vpc_endpoint":
+			c = &components.VpcEndpoint{}
+		case "ecs_cluster":
+			c = &components.ECSCluster{}
+		case "ecs_task_definition":
+			c = &components.ECSTaskDefinition{}
+		case "ecs_service":
+			c = &components.ECSService{}
+		case "secret":
+			c = &components.Secret{}
+		case "snapshot_policy":
+			c = &components.SnapshotPolicy{}
+		case "launch_template":
+			c = &components.LaunchTemplate{}
+		case "placement_group":
+			c = &components.PlacementGroup{}
+		case "vpn_gateway":
+			c = &components.VpnGateway{}
+		case "customer_gateway":
+			c = &components.CustomerGateway{}
+		case "vpn_connection":
+			c = &components.VpnConnection{}
+		case "transit_gateway":
+			c = &components.TransitGateway{}
+		case "transit_gateway_attachment":
+			c = &components.TransitGatewayAttachment{}
+		case "elasticsearch_domain":
+			c = &components.ElasticsearchDomain{}
+		case "eks_cluster":
+			c = &components.EKSCluster{}
+		case "eks_node_group":
+			c = &components.EKSNodeGroup{}
 		}
 
 		config := &mapstructure.DecoderConfig{
@@ -154,7 +217,7 @@ func (m Mapper) LoadGraph(gg map[string]interface{}) (*graph.Graph, error) {
 		g.Components[i] = c
 	}
 
-	return g, nil
+	return g, migrateElasticIPs(g)
 }
 
 // CreateImportGraph : creates a new graph with component queries used to import components from a provider
@@ -192,6 +255,27 @@ func (m Mapper) ProviderCredentials(details map[string]interface{}) graph.Compon
 func mapComponents(d *def.Definition, g *graph.Graph) error {
 	// Map basic component values from definition
 
+	for _, key := range MapKMSKeys(d) {
+		err := g.AddComponent(key)
+		if err != nil {
+			return err
+		}
+	}
+
+	for _, secret := range MapSecrets(d) {
+		err := g.AddComponent(secret)
+		if err != nil {
+			return err
+		}
+	}
+
+	for _, cert := range MapACMCertificates(d) {
+		err := g.AddComponent(cert)
+		if err != nil {
+			return err
+		}
+	}
+
 	for _, vpc := range MapVpcs(d) {
 		err := g.AddComponent(vpc)
 		if err != nil {
@@ -206,6 +290,13 @@ func mapComponents(d *def.Definition, g *graph.Graph) error {
 		}
 	}
 
+	for _, kp := range MapKeyPairs(d) {
+		err := g.AddComponent(kp)
+		if err != nil {
+			return err
+		}
+	}
+
 	for _, instance := range MapInstances(d) {
 		err := g.AddComponent(instance)
 		if err != nil {
@@ -213,6 +304,13 @@ func mapComponents(d *def.Definition, g *graph.Graph) error {
 		}
 	}
 
+	for _, eip := range MapElasticIPs(d) {
+		err := g.AddComponent(eip)
+		if err != nil {
+			return err
+		}
+	}
+
 	for _, securitygroup := range MapSecurityGroups(d) {
 		err := g.AddComponent(securitygroup)
 		if err != nil {
@@ -234,6 +332,83 @@ func mapComponents(d *def.Definition, g *graph.Graph) error {
 		}
 	}
 
+	for _, cluster := range MapEKSClusters(d) {
+		err := g.AddComponent(cluster)
+		if err != nil {
+			return err
+		}
+	}
+
+	for _, group := range MapEKSNodeGroups(d) {
+		err := g.AddComponent(group)
+		if err != nil {
+			return err
+		}
+	}
+
+	for _, domain := range MapElasticsearchDomains(d) {
+		err := g.AddComponent(domain)
+		if err != nil {
+			return err
+		}
+	}
+
+	for _, tgw := range MapTransitGateways(d) {
+		err := g.AddComponent(tgw)
+		if err != nil {
+			return err
+		}
+	}
+
+	for _, attachment := range MapTransitGatewayAttachments(d) {
+		err := g.AddComponent(attachment)
+		if err != nil {
+			return err
+		}
+	}
+
+	for _, vgw := range MapVpnGateways(d) {
+		err := g.AddComponent(vgw)
+		if err != nil {
+			return err
+		}
+	}
+
+	for _, cgw := range MapCustomerGateways(d) {
+		err := g.AddComponent(cgw)
+		if err != nil {
+			return err
+		}
+	}
+
+	for _, vpn := range MapVpnConnections(d) {
+		err := g.AddComponent(vpn)
+		if err != nil {
+			return err
+		}
+	}
+
+	for _, pg := range MapPlacementGroups(d) {
+		err := g.AddComponent(pg)
+		if err != nil {
+			return err
+		}
+	}
+
+	for _, lt := range MapLaunchTemplates(d) {
+		err := g.AddComponent(lt)
+		if err != nil {
+			return err
+		}
+	}
+
+	for _, policy := range MapSnapshotPolicies(d) {
+		err := g.AddComponent(policy)
+		if err != nil {
+			return err
+		}
+	}
+
 	for _, nat := range MapNats(d) {
 		err := g.AddComponent(nat)
 		if err != nil {
@@ -241,6 +416,13 @@ func mapComponents(d *def.Definition, g *graph.Graph) error {
 		}
 	}
 
+	for _, endpoint := range MapVpcEndpoints(d) {
+		err := g.AddComponent(endpoint)
+		if err != nil {
+			return err
+		}
+	}
+
 	for _, rds := range MapRDSClusters(d) {
 		err := g.AddComponent(rds)
 		if err != nil {
@@ -248,6 +430,34 @@ func mapComponents(d *def.Definition, g *graph.Graph) error {
 		}
 	}
 
+	for _, cluster := range MapECSClusters(d) {
+		err := g.AddComponent(cluster)
+		if err != nil {
+			return err
+		}
+	}
+
+	for _, td := range MapECSTaskDefinitions(d) {
+		err := g.AddComponent(td)
+		if err != nil {
+			return err
+		}
+	}
+
+	for _, service := range MapECSServices(d) {
+		err := g.AddComponent(service)
+		if err != nil {
+			return err
+		}
+	}
+
+	for _, alarm := range MapCloudWatchAlarms(d) {
+		err := g.AddComponent(alarm)
+		if err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
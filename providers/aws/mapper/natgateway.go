@@ -19,6 +19,7 @@ func MapNats(d *definition.Definition) []*components.NatGateway {
 			Name:           ng.Name,
 			PublicNetwork:  ng.PublicNetwork,
 			RoutedNetworks: mapNetworkNames(d, ng.Name),
+			ElasticIP:      mapNatElasticIP(d, ng.Name),
 		})
 	}
 
@@ -51,3 +52,13 @@ func mapNetworkNames(d *definition.Definition, name string) []string {
 
 	return nws
 }
+
+func mapNatElasticIP(d *definition.Definition, name string) string {
+	for _, eip := range d.ElasticIPs {
+		if eip.NatGateway == name {
+			return eip.Name
+		}
+	}
+
+	return ""
+}
@@ -0,0 +1,48 @@
+/* This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at http://mozilla.org/MPL/2.0/. */
+
+package mapper
+
+import (
+	"github.com/ernestio/libmapper/providers/aws/components"
+	"github.com/ernestio/libmapper/providers/aws/definition"
+	graph "gopkg.in/r3labs/graph.v2"
+)
+
+// MapPlacementGroups : Maps the placement groups from a given input payload.
+func MapPlacementGroups(d *definition.Definition) []*components.PlacementGroup {
+	var groups []*components.PlacementGroup
+
+	for _, group := range d.PlacementGroups {
+		pg := &components.PlacementGroup{
+			Name:           group.Name,
+			Strategy:       group.Strategy,
+			PartitionCount: group.Partitions,
+			Tags:           mapTags(group.Name, d.Name),
+		}
+
+		pg.SetDefaultVariables()
+
+		groups = append(groups, pg)
+	}
+
+	return groups
+}
+
+// MapDefinitionPlacementGroups : Maps components placement groups into a definition defined placement groups
+func MapDefinitionPlacementGroups(g *graph.Graph) []definition.PlacementGroup {
+	var groups []definition.PlacementGroup
+
+	for _, c := range g.GetComponents().ByType("placement_group") {
+		pg := c.(*components.PlacementGroup)
+
+		groups = append(groups, definition.PlacementGroup{
+			Name:       pg.Name,
+			Strategy:   pg.Strategy,
+			Partitions: pg.PartitionCount,
+		})
+	}
+
+	return groups
+}
@@ -26,14 +26,21 @@ func MapRDSClusters(d *definition.Definition) []*components.RDSCluster {
 			DatabaseName:      cluster.DatabaseName,
 			DatabaseUsername:  cluster.DatabaseUsername,
 			DatabasePassword:  cluster.DatabasePassword,
+			DatabaseSecret:    cluster.DatabaseSecret,
 			BackupRetention:   cluster.Backups.Retention,
 			BackupWindow:      cluster.Backups.Window,
 			MaintenanceWindow: cluster.MaintenanceWindow,
 			ReplicationSource: cluster.ReplicationSource,
 			FinalSnapshot:     cluster.FinalSnapshot,
+			StorageEncrypted:  cluster.Encrypted,
+			EncryptionKey:     cluster.EncryptionKey,
 			Tags:              mapTagsServiceOnly(d.Name),
 		}
 
+		if cluster.EncryptionKeyID != nil {
+			rc.EncryptionKeyID = *cluster.EncryptionKeyID
+		}
+
 		rc.SetDefaultVariables()
 
 		clusters = append(clusters, rc)
@@ -42,7 +49,8 @@ func MapRDSClusters(d *definition.Definition) []*components.RDSCluster {
 	return clusters
 }
 
-// MapDefinitionRDSClusters : Maps the rds clusters for the internal ernest format to the input definition format
+// MapDefinitionRDSClusters : Maps the rds clusters for the internal ernest format to the input definition format.
+// Database passwords are never mapped back onto the definition.
 func MapDefinitionRDSClusters(g *graph.Graph) []definition.RDSCluster {
 	var clusters []definition.RDSCluster
 
@@ -58,10 +66,18 @@ func MapDefinitionRDSClusters(g *graph.Graph) []definition.RDSCluster {
 			Networks:          cluster.Networks,
 			DatabaseName:      cluster.DatabaseName,
 			DatabaseUsername:  cluster.DatabaseUsername,
-			DatabasePassword:  cluster.DatabasePassword,
+			DatabaseSecret:    cluster.DatabaseSecret,
 			MaintenanceWindow: cluster.MaintenanceWindow,
 			ReplicationSource: cluster.ReplicationSource,
 			FinalSnapshot:     cluster.FinalSnapshot,
+			Encrypted:         cluster.StorageEncrypted,
+			EncryptionKey:     cluster.EncryptionKey,
+			Alarms:            MapDefinitionAlarms(g, "rds_cluster", cluster.Name),
+		}
+
+		// keys managed by ernest are referenced by name only
+		if cluster.EncryptionKey == "" && cluster.EncryptionKeyID != "" {
+			c.EncryptionKeyID = &cluster.EncryptionKeyID
 		}
 
 		c.Backups.Retention = cluster.BackupRetention
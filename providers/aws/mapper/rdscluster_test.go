@@ -0,0 +1,63 @@
+/* This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at http://mozilla.org/MPL/2.0/. */
+
+package mapper
+
+import (
+	"testing"
+
+	"github.com/ernestio/libmapper/providers/aws/definition"
+	. "github.com/smartystreets/goconvey/convey"
+	graph "gopkg.in/r3labs/graph.v2"
+)
+
+func TestMapRDSClusters(t *testing.T) {
+	Convey("Given a definition with an rds cluster encrypted by an existing kms key", t, func() {
+		d := definition.New()
+		err := d.LoadJSON([]byte(`{"name":"test","rds_clusters":[
+			{"name":"db","engine":"aurora","encrypted":true,"encryption_key_id":"arn:aws:kms:eu-west-1:123456789012:key/1234"}
+		]}`))
+		So(err, ShouldBeNil)
+
+		Convey("When mapping its rds clusters", func() {
+			clusters := MapRDSClusters(d)
+
+			Convey("It should map the key's id", func() {
+				So(clusters[0].EncryptionKeyID, ShouldEqual, "arn:aws:kms:eu-west-1:123456789012:key/1234")
+			})
+
+			Convey("And when mapping them back to a definition", func() {
+				g := graph.New()
+				g.AddComponent(clusters[0])
+
+				dc := MapDefinitionRDSClusters(g)
+
+				Convey("It should keep the key's id", func() {
+					So(dc[0].EncryptionKeyID, ShouldNotBeNil)
+					So(*dc[0].EncryptionKeyID, ShouldEqual, "arn:aws:kms:eu-west-1:123456789012:key/1234")
+				})
+			})
+		})
+	})
+
+	Convey("Given a definition with an rds cluster encrypted by a kms key it manages", t, func() {
+		d := definition.New()
+		err := d.LoadJSON([]byte(`{"name":"test","rds_clusters":[
+			{"name":"db","engine":"aurora","encrypted":true,"encryption_key":"key"}
+		]}`))
+		So(err, ShouldBeNil)
+
+		Convey("When mapping it there and back", func() {
+			g := graph.New()
+			g.AddComponent(MapRDSClusters(d)[0])
+
+			dc := MapDefinitionRDSClusters(g)
+
+			Convey("It should only reference the key by name", func() {
+				So(dc[0].EncryptionKey, ShouldEqual, "key")
+				So(dc[0].EncryptionKeyID, ShouldBeNil)
+			})
+		})
+	})
+}
@@ -0,0 +1,84 @@
+/* This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at http://mozilla.org/MPL/2.0/. */
+
+package mapper
+
+import (
+	"github.com/ernestio/libmapper/providers/aws/components"
+	"github.com/ernestio/libmapper/providers/aws/definition"
+	graph "gopkg.in/r3labs/graph.v2"
+)
+
+// MapSecrets : Maps the secrets from a given input payload.
+func MapSecrets(d *definition.Definition) []*components.Secret {
+	var secrets []*components.Secret
+
+	for _, secret := range d.Secrets {
+		s := &components.Secret{
+			Name:        secret.Name,
+			Description: secret.Description,
+			KMSKey:      secret.KMSKey,
+			Tags:        mapTags(secret.Name, d.Name),
+		}
+
+		if secret.Password != nil {
+			s.PasswordPolicy = &components.SecretPasswordPolicy{
+				Length:             secret.Password.Length,
+				ExcludeCharacters:  secret.Password.ExcludeCharacters,
+				ExcludePunctuation: secret.Password.ExcludePunctuation,
+				ExcludeNumbers:     secret.Password.ExcludeNumbers,
+				IncludeSpace:       secret.Password.IncludeSpace,
+			}
+		}
+
+		if secret.Rotation != nil {
+			s.Rotation = &components.SecretRotation{
+				LambdaARN: secret.Rotation.Lambda,
+				Days:      secret.Rotation.Days,
+			}
+		}
+
+		s.SetDefaultVariables()
+
+		secrets = append(secrets, s)
+	}
+
+	return secrets
+}
+
+// MapDefinitionSecrets : Maps components secrets into a definition defined secrets
+func MapDefinitionSecrets(g *graph.Graph) []definition.Secret {
+	var secrets []definition.Secret
+
+	for _, c := range g.GetComponents().ByType("secret") {
+		secret := c.(*components.Secret)
+
+		s := definition.Secret{
+			Name:        secret.Name,
+			Description: secret.Description,
+			KMSKey:      secret.KMSKey,
+		}
+
+		if secret.PasswordPolicy != nil {
+			s.Password = &definition.SecretPassword{
+				Length:             secret.PasswordPolicy.Length,
+				ExcludeCharacters:  secret.PasswordPolicy.ExcludeCharacters,
+				ExcludePunctuation: secret.PasswordPolicy.ExcludePunctuation,
+				ExcludeNumbers:     secret.PasswordPolicy.ExcludeNumbers,
+				IncludeSpace:       secret.PasswordPolicy.IncludeSpace,
+			}
+		}
+
+		if secret.Rotation != nil {
+			s.Rotation = &definition.SecretRotation{
+				Lambda: secret.Rotation.LambdaARN,
+				Days:   secret.Rotation.Days,
+			}
+		}
+
+		secrets = append(secrets, s)
+	}
+
+	return secrets
+}
@@ -0,0 +1,74 @@
+/* This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at http://mozilla.org/MPL/2.0/. */
+
+package mapper
+
+import (
+	"github.com/ernestio/libmapper/providers/aws/components"
+	"github.com/ernestio/libmapper/providers/aws/definition"
+	graph "gopkg.in/r3labs/graph.v2"
+)
+
+// MapSnapshotPolicies : Maps the snapshot policies from a given input payload.
+func MapSnapshotPolicies(d *definition.Definition) []*components.SnapshotPolicy {
+	var policies []*components.SnapshotPolicy
+
+	for _, policy := range d.SnapshotPolicies {
+		p := &components.SnapshotPolicy{
+			Name:             policy.Name,
+			Description:      policy.Description,
+			ExecutionRoleARN: policy.ExecutionRole,
+			Enabled:          !policy.Disabled,
+			VolumeGroups:     policy.Volumes,
+			Tags:             mapTags(policy.Name, d.Name),
+		}
+
+		for _, schedule := range policy.Schedules {
+			p.Schedules = append(p.Schedules, components.SnapshotSchedule{
+				Name:        schedule.Name,
+				Interval:    schedule.Interval,
+				Times:       schedule.Times,
+				RetainCount: schedule.Retain,
+				CopyTags:    schedule.CopyTags,
+			})
+		}
+
+		p.SetDefaultVariables()
+
+		policies = append(policies, p)
+	}
+
+	return policies
+}
+
+// MapDefinitionSnapshotPolicies : Maps components snapshot policies into a definition defined snapshot policies
+func MapDefinitionSnapshotPolicies(g *graph.Graph) []definition.SnapshotPolicy {
+	var policies []definition.SnapshotPolicy
+
+	for _, c := range g.GetComponents().ByType("snapshot_policy") {
+		policy := c.(*components.SnapshotPolicy)
+
+		p := definition.SnapshotPolicy{
+			Name:          policy.Name,
+			Description:   policy.Description,
+			ExecutionRole: policy.ExecutionRoleARN,
+			Disabled:      !policy.Enabled,
+			Volumes:       policy.VolumeGroups,
+		}
+
+		for _, schedule := range policy.Schedules {
+			p.Schedules = append(p.Schedules, definition.SnapshotSchedule{
+				Name:     schedule.Name,
+				Interval: schedule.Interval,
+				Times:    schedule.Times,
+				Retain:   schedule.RetainCount,
+				CopyTags: schedule.CopyTags,
+			})
+		}
+
+		policies = append(policies, p)
+	}
+
+	return policies
+}
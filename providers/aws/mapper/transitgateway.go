@@ -0,0 +1,121 @@
+/* This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at http://mozilla.org/MPL/2.0/. */
+
+package mapper
+
+import (
+	"github.com/ernestio/libmapper/providers/aws/components"
+	"github.com/ernestio/libmapper/providers/aws/definition"
+	graph "gopkg.in/r3labs/graph.v2"
+)
+
+// MapTransitGateways : Maps the transit gateways from a given input payload.
+func MapTransitGateways(d *definition.Definition) []*components.TransitGateway {
+	var gateways []*components.TransitGateway
+
+	for _, gateway := range d.TransitGateways {
+		t := &components.TransitGateway{
+			Name:                         gateway.Name,
+			Description:                  gateway.Description,
+			AmazonSideASN:                gateway.AmazonSideASN,
+			DNSSupport:                   mapEnabled(gateway.DNSSupport),
+			AutoAcceptSharedAttachments:  gateway.AutoAcceptSharedAttachments,
+			DefaultRouteTableAssociation: mapEnabled(gateway.DefaultRouteTableAssociation),
+			DefaultRouteTablePropagation: mapEnabled(gateway.DefaultRouteTablePropagation),
+			Tags:                         mapTags(gateway.Name, d.Name),
+		}
+
+		t.SetDefaultVariables()
+
+		gateways = append(gateways, t)
+	}
+
+	return gateways
+}
+
+// MapTransitGatewayAttachments : Maps the transit gateway attachments from a given input payload.
+func MapTransitGatewayAttachments(d *definition.Definition) []*components.TransitGatewayAttachment {
+	var attachments []*components.TransitGatewayAttachment
+
+	for _, attachment := range d.TransitGatewayAttachments {
+		t := &components.TransitGatewayAttachment{
+			Name:                         attachment.Name,
+			TransitGateway:               attachment.TransitGateway,
+			TransitGatewayAWSID:          attachment.TransitGatewayID,
+			Vpc:                          attachment.Vpc,
+			Networks:                     attachment.Networks,
+			DNSSupport:                   mapEnabled(attachment.DNSSupport),
+			DefaultRouteTableAssociation: attachment.RouteTable == "" && mapEnabled(attachment.DefaultRouteTableAssociation),
+			DefaultRouteTablePropagation: mapEnabled(attachment.DefaultRouteTablePropagation),
+			AssociatedRouteTableAWSID:    attachment.RouteTable,
+			PropagatedRouteTableAWSIDs:   attachment.PropagateTo,
+			Tags:                         mapTags(attachment.Name, d.Name),
+		}
+
+		t.SetDefaultVariables()
+
+		attachments = append(attachments, t)
+	}
+
+	return attachments
+}
+
+// MapDefinitionTransitGateways : Maps components transit gateways into a definition defined transit gateways
+func MapDefinitionTransitGateways(g *graph.Graph) []definition.TransitGateway {
+	var gateways []definition.TransitGateway
+
+	for _, c := range g.GetComponents().ByType("transit_gateway") {
+		t := c.(*components.TransitGateway)
+
+		gateways = append(gateways, definition.TransitGateway{
+			Name:                         t.Name,
+			Description:                  t.Description,
+			AmazonSideASN:                t.AmazonSideASN,
+			DNSSupport:                   &t.DNSSupport,
+			AutoAcceptSharedAttachments:  t.AutoAcceptSharedAttachments,
+			DefaultRouteTableAssociation: &t.DefaultRouteTableAssociation,
+			DefaultRouteTablePropagation: &t.DefaultRouteTablePropagation,
+		})
+	}
+
+	return gateways
+}
+
+// MapDefinitionTransitGatewayAttachments : Maps components transit gateway attachments into a definition defined transit gateway attachments
+func MapDefinitionTransitGatewayAttachments(g *graph.Graph) []definition.TransitGatewayAttachment {
+	var attachments []definition.TransitGatewayAttachment
+
+	for _, c := range g.GetComponents().ByType("transit_gateway_attachment") {
+		t := c.(*components.TransitGatewayAttachment)
+
+		a := definition.TransitGatewayAttachment{
+			Name:                         t.Name,
+			TransitGateway:               t.TransitGateway,
+			Vpc:                          t.Vpc,
+			Networks:                     t.Networks,
+			DNSSupport:                   &t.DNSSupport,
+			DefaultRouteTableAssociation: &t.DefaultRouteTableAssociation,
+			DefaultRouteTablePropagation: &t.DefaultRouteTablePropagation,
+			RouteTable:                   t.AssociatedRouteTableAWSID,
+			PropagateTo:                  t.PropagatedRouteTableAWSIDs,
+		}
+
+		if t.TransitGateway == "" {
+			a.TransitGatewayID = t.TransitGatewayAWSID
+		}
+
+		attachments = append(attachments, a)
+	}
+
+	return attachments
+}
+
+// mapEnabled : options that aws enables by default
+func mapEnabled(v *bool) bool {
+	if v == nil {
+		return true
+	}
+
+	return *v
+}
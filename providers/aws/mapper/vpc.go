@@ -5,6 +5,8 @@
 package mapper
 
 import (
+	"strings"
+
 	"github.com/ernestio/libmapper/providers/aws/components"
 	"github.com/ernestio/libmapper/providers/aws/definition"
 	graph "gopkg.in/r3labs/graph.v2"
@@ -16,10 +18,32 @@ func MapVpcs(d *definition.Definition) []*components.Vpc {
 
 	for _, vpc := range d.Vpcs {
 		cv := &components.Vpc{
-			Name:       vpc.Name,
-			VpcAWSID:   vpc.ID,
-			Subnet:     vpc.Subnet,
-			AutoRemove: vpc.AutoRemove,
+			Name:               vpc.Name,
+			VpcAWSID:           vpc.ID,
+			Subnet:             vpc.Subnet,
+			AutoRemove:         vpc.AutoRemove,
+			EnableDNSSupport:   mapDNSSupport(vpc.DNSSupport),
+			EnableDNSHostnames: vpc.DNSHostnames,
+			InstanceTenancy:    vpc.Tenancy,
+			SecondarySubnets:   vpc.SecondarySubnets,
+			IPv6:               vpc.IPv6,
+		}
+
+		if vpc.DHCPOptions != nil {
+			cv.DHCPOptions = &components.VpcDHCPOptions{
+				DomainName:        vpc.DHCPOptions.DomainName,
+				DomainNameServers: vpc.DHCPOptions.NameServers,
+			}
+		}
+
+		if vpc.FlowLogs != nil {
+			cv.FlowLogs = &components.VpcFlowLogs{
+				Destination: strings.ToLower(vpc.FlowLogs.Destination),
+				LogGroup:    vpc.FlowLogs.LogGroup,
+				BucketARN:   vpc.FlowLogs.Bucket,
+				IAMRoleARN:  vpc.FlowLogs.IAMRole,
+				TrafficType: strings.ToUpper(vpc.FlowLogs.TrafficType),
+			}
 		}
 
 		if vpc.ID != "" {
@@ -41,13 +65,44 @@ func MapDefinitionVpcs(g *graph.Graph) []definition.Vpc {
 	for _, c := range g.GetComponents().ByType("vpc") {
 		v := c.(*components.Vpc)
 
-		vpcs = append(vpcs, definition.Vpc{
-			ID:         v.VpcAWSID,
-			Name:       v.Name,
-			Subnet:     v.Subnet,
-			AutoRemove: false,
-		})
+		dnsSupport := v.DNSSupportEnabled()
+
+		dv := definition.Vpc{
+			ID:               v.VpcAWSID,
+			Name:             v.Name,
+			Subnet:           v.Subnet,
+			AutoRemove:       false,
+			DNSSupport:       &dnsSupport,
+			DNSHostnames:     v.EnableDNSHostnames,
+			Tenancy:          v.InstanceTenancy,
+			SecondarySubnets: v.SecondarySubnets,
+			IPv6:             v.IPv6,
+		}
+
+		if v.DHCPOptions != nil {
+			dv.DHCPOptions = &definition.VpcDHCPOptions{
+				DomainName:  v.DHCPOptions.DomainName,
+				NameServers: v.DHCPOptions.DomainNameServers,
+			}
+		}
+
+		if v.FlowLogs != nil {
+			dv.FlowLogs = &definition.VpcFlowLogs{
+				Destination: v.FlowLogs.Destination,
+				LogGroup:    v.FlowLogs.LogGroup,
+				Bucket:      v.FlowLogs.BucketARN,
+				IAMRole:     v.FlowLogs.IAMRoleARN,
+				TrafficType: v.FlowLogs.TrafficType,
+			}
+		}
+
+		vpcs = append(vpcs, dv)
 	}
 
 	return vpcs
 }
+
+func mapDNSSupport(v *bool) *bool {
+	enabled := mapEnabled(v)
+	return &enabled
+}
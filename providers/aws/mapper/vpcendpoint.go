@@ -0,0 +1,79 @@
+/* This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at http://mozilla.org/MPL/2.0/. */
+
+package mapper
+
+import (
+	"strings"
+
+	"github.com/ernestio/libmapper/providers/aws/components"
+	"github.com/ernestio/libmapper/providers/aws/definition"
+	graph "gopkg.in/r3labs/graph.v2"
+)
+
+// MapVpcEndpoints : Maps the vpc endpoints from a given input payload.
+func MapVpcEndpoints(d *definition.Definition) []*components.VpcEndpoint {
+	var endpoints []*components.VpcEndpoint
+
+	for _, endpoint := range d.VpcEndpoints {
+		e := &components.VpcEndpoint{
+			Name:           endpoint.Name,
+			Vpc:            endpoint.Vpc,
+			AWSService:     endpoint.Service,
+			ServiceName:    endpoint.ServiceName,
+			EndpointType:   mapEndpointType(endpoint.Type),
+			Networks:       endpoint.Networks,
+			SecurityGroups: endpoint.SecurityGroups,
+			PrivateDNS:     endpoint.PrivateDNS,
+			Policy:         endpoint.Policy,
+			Tags:           mapTags(endpoint.Name, d.Name),
+		}
+
+		e.SetDefaultVariables()
+
+		endpoints = append(endpoints, e)
+	}
+
+	return endpoints
+}
+
+// MapDefinitionVpcEndpoints : Maps components vpc endpoints into a definition defined vpc endpoints
+func MapDefinitionVpcEndpoints(g *graph.Graph) []definition.VpcEndpoint {
+	var endpoints []definition.VpcEndpoint
+
+	for _, c := range g.GetComponents().ByType("vpc_endpoint") {
+		e := c.(*components.VpcEndpoint)
+
+		de := definition.VpcEndpoint{
+			Name:           e.Name,
+			Vpc:            e.Vpc,
+			Service:        e.AWSService,
+			Type:           strings.ToLower(e.EndpointType),
+			Networks:       e.Networks,
+			SecurityGroups: e.SecurityGroups,
+			PrivateDNS:     e.PrivateDNS,
+			Policy:         e.Policy,
+		}
+
+		// service names in the credentials region are built from the service
+		if e.ServiceName != "com.amazonaws."+components.DATACENTERREGION+"."+e.AWSService {
+			de.ServiceName = e.ServiceName
+		}
+
+		endpoints = append(endpoints, de)
+	}
+
+	return endpoints
+}
+
+func mapEndpointType(t string) string {
+	switch strings.ToLower(t) {
+	case "gateway":
+		return components.ENDPOINTGATEWAY
+	case "interface":
+		return components.ENDPOINTINTERFACE
+	}
+
+	return t
+}
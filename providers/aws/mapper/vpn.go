@@ -0,0 +1,127 @@
+/* This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at http://mozilla.org/MPL/2.0/. */
+
+package mapper
+
+import (
+	"github.com/ernestio/libmapper/providers/aws/components"
+	"github.com/ernestio/libmapper/providers/aws/definition"
+	graph "gopkg.in/r3labs/graph.v2"
+)
+
+// MapVpnGateways : Maps the vpn gateways from a given input payload.
+func MapVpnGateways(d *definition.Definition) []*components.VpnGateway {
+	var gateways []*components.VpnGateway
+
+	for _, gateway := range d.VpnGateways {
+		v := &components.VpnGateway{
+			Name:          gateway.Name,
+			Vpc:           gateway.Vpc,
+			AmazonSideASN: gateway.AmazonSideASN,
+			Tags:          mapTags(gateway.Name, d.Name),
+		}
+
+		v.SetDefaultVariables()
+
+		gateways = append(gateways, v)
+	}
+
+	return gateways
+}
+
+// MapCustomerGateways : Maps the customer gateways from a given input payload.
+func MapCustomerGateways(d *definition.Definition) []*components.CustomerGateway {
+	var gateways []*components.CustomerGateway
+
+	for _, gateway := range d.CustomerGateways {
+		c := &components.CustomerGateway{
+			Name:      gateway.Name,
+			IPAddress: gateway.IPAddress,
+			BGPASN:    gateway.BGPASN,
+			Tags:      mapTags(gateway.Name, d.Name),
+		}
+
+		c.SetDefaultVariables()
+
+		gateways = append(gateways, c)
+	}
+
+	return gateways
+}
+
+// MapVpnConnections : Maps the vpn connections from a given input payload.
+func MapVpnConnections(d *definition.Definition) []*components.VpnConnection {
+	var connections []*components.VpnConnection
+
+	for _, connection := range d.VpnConnections {
+		v := &components.VpnConnection{
+			Name:             connection.Name,
+			VpnGateway:       connection.VpnGateway,
+			CustomerGateway:  connection.CustomerGateway,
+			StaticRoutesOnly: len(connection.StaticRoutes) > 0,
+			StaticRoutes:     connection.StaticRoutes,
+			Networks:         connection.Networks,
+			Tags:             mapTags(connection.Name, d.Name),
+		}
+
+		v.SetDefaultVariables()
+
+		connections = append(connections, v)
+	}
+
+	return connections
+}
+
+// MapDefinitionVpnGateways : Maps components vpn gateways into a definition defined vpn gateways
+func MapDefinitionVpnGateways(g *graph.Graph) []definition.VpnGateway {
+	var gateways []definition.VpnGateway
+
+	for _, c := range g.GetComponents().ByType("vpn_gateway") {
+		v := c.(*components.VpnGateway)
+
+		gateways = append(gateways, definition.VpnGateway{
+			Name:          v.Name,
+			Vpc:           v.Vpc,
+			AmazonSideASN: v.AmazonSideASN,
+		})
+	}
+
+	return gateways
+}
+
+// MapDefinitionCustomerGateways : Maps components customer gateways into a definition defined customer gateways
+func MapDefinitionCustomerGateways(g *graph.Graph) []definition.CustomerGateway {
+	var gateways []definition.CustomerGateway
+
+	for _, c := range g.GetComponents().ByType("customer_gateway") {
+		cg := c.(*components.CustomerGateway)
+
+		gateways = append(gateways, definition.CustomerGateway{
+			Name:      cg.Name,
+			IPAddress: cg.IPAddress,
+			BGPASN:    cg.BGPASN,
+		})
+	}
+
+	return gateways
+}
+
+// MapDefinitionVpnConnections : Maps components vpn connections into a definition defined vpn connections
+func MapDefinitionVpnConnections(g *graph.Graph) []definition.VpnConnection {
+	var connections []definition.VpnConnection
+
+	for _, c := range g.GetComponents().ByType("vpn_connection") {
+		v := c.(*components.VpnConnection)
+
+		connections = append(connections, definition.VpnConnection{
+			Name:            v.Name,
+			VpnGateway:      v.VpnGateway,
+			CustomerGateway: v.CustomerGateway,
+			StaticRoutes:    v.StaticRoutes,
+			Networks:        v.Networks,
+		})
+	}
+
+	return connections
+}
@@ -0,0 +1,286 @@
+/* This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at http://mozilla.org/MPL/2.0/. */
+
+package components
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+
+	graph "gopkg.in/r3labs/graph.v2"
+)
+
+// BACKENDPROTOCOLS : protocols a backend service can use to talk to its backends
+var BACKENDPROTOCOLS = []string{"HTTP", "HTTPS", "HTTP2", "TCP", "SSL", "UDP"}
+
+// HealthCheck : the health check used to determine backend health
+type HealthCheck struct {
+	Protocol           string `json:"protocol"`
+	Port               int64  `json:"port"`
+	RequestPath        string `json:"request_path"`
+	CheckInterval      int64  `json:"check_interval"`
+	Timeout            int64  `json:"timeout"`
+	HealthyThreshold   int64  `json:"healthy_threshold"`
+	UnhealthyThreshold int64  `json:"unhealthy_threshold"`
+}
+
+// Backend : an instance group that serves traffic for a backend service
+type Backend struct {
+	InstanceGroup   string `json:"instance_group"`
+	InstanceGroupID string `json:"instance_group_id"`
+	BalancingMode   string `json:"balancing_mode"`
+}
+
+// BackendService : mapping of a backend service component
+type BackendService struct {
+	ProviderType        string            `json:"_provider"`
+	ComponentType       string            `json:"_component"`
+	ComponentID         string            `json:"_component_id"`
+	State               string            `json:"_state"`
+	Action              string            `json:"_action"`
+	BackendServiceID    string            `json:"backend_service_id"`
+	HealthCheckID       string            `json:"health_check_id"`
+	Name                string            `json:"name"`
+	Region              string            `json:"region"`
+	Protocol            string            `json:"protocol"`
+	PortName            string            `json:"port_name"`
+	LoadBalancingScheme string            `json:"load_balancing_scheme"`
+	SessionAffinity     string            `json:"session_affinity"`
+	Timeout             int64             `json:"timeout"`
+	HealthCheck         HealthCheck       `json:"health_check"`
+	Backends            []Backend         `json:"backends"`
+	Labels              map[string]string `json:"labels"`
+	DatacenterType      string            `json:"datacenter_type,omitempty"`
+	DatacenterName      string            `json:"datacenter_name,omitempty"`
+	DatacenterRegion    string            `json:"datacenter_region"`
+	ProjectID           string            `json:"project_id"`
+	Credentials         string            `json:"credentials"`
+	Service             string            `json:"service"`
+}
+
+// GetID : returns the component's ID
+func (b *BackendService) GetID() string {
+	return b.ComponentID
+}
+
+// GetName returns a components name
+func (b *BackendService) GetName() string {
+	return b.Name
+}
+
+// GetProvider : returns the provider type
+func (b *BackendService) GetProvider() string {
+	return b.ProviderType
+}
+
+// GetProviderID returns a components provider id
+func (b *BackendService) GetProviderID() string {
+	return b.BackendServiceID
+}
+
+// GetType : returns the type of the component
+func (b *BackendService) GetType() string {
+	return b.ComponentType
+}
+
+// GetState : returns the state of the component
+func (b *BackendService) GetState() string {
+	return b.State
+}
+
+// SetState : sets the state of the component
+func (b *BackendService) SetState(s string) {
+	b.State = s
+}
+
+// GetAction : returns the action of the component
+func (b *BackendService) GetAction() string {
+	return b.Action
+}
+
+// SetAction : Sets the action of the component
+func (b *BackendService) SetAction(s string) {
+	b.Action = s
+}
+
+// GetGroup : returns the components group
+func (b *BackendService) GetGroup() string {
+	return ""
+}
+
+// GetTags returns a components labels
+func (b *BackendService) GetTags() map[string]string {
+	return b.Labels
+}
+
+// GetTag returns a components label
+func (b *BackendService) GetTag(tag string) string {
+	return b.Labels[tag]
+}
+
+// Diff : diff's the component against another component of the same type
+func (b *BackendService) Diff(c graph.Component) bool {
+	cb, ok := c.(*BackendService)
+	if ok {
+		if b.Protocol != cb.Protocol || b.PortName != cb.PortName {
+			return true
+		}
+
+		if b.SessionAffinity != cb.SessionAffinity || b.Timeout != cb.Timeout {
+			return true
+		}
+
+		if reflect.DeepEqual(b.HealthCheck, cb.HealthCheck) != true {
+			return true
+		}
+
+		if len(b.Backends) != len(cb.Backends) {
+			return true
+		}
+
+		for x := 0; x < len(b.Backends); x++ {
+			if b.Backends[x].InstanceGroup != cb.Backends[x].InstanceGroup || b.Backends[x].BalancingMode != cb.Backends[x].BalancingMode {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// Update : updates the provider returned values of a component
+func (b *BackendService) Update(c graph.Component) {
+	cb, ok := c.(*BackendService)
+	if ok {
+		b.BackendServiceID = cb.BackendServiceID
+		b.HealthCheckID = cb.HealthCheckID
+	}
+
+	b.SetDefaultVariables()
+}
+
+// Rebuild : rebuilds the component's internal state, such as templated values
+func (b *BackendService) Rebuild(g *graph.Graph) {
+	if b.Region == "" {
+		b.Region = DATACENTERREGION
+	}
+
+	if b.LoadBalancingScheme == "" {
+		b.LoadBalancingScheme = SCHEMEEXTERNAL
+	}
+
+	if b.Protocol == "" {
+		b.Protocol = "TCP"
+	}
+
+	if b.Timeout == 0 {
+		b.Timeout = 30
+	}
+
+	if b.HealthCheck.Protocol == "" {
+		b.HealthCheck.Protocol = "TCP"
+	}
+
+	for x := 0; x < len(b.Backends); x++ {
+		if b.Backends[x].InstanceGroup == "" && b.Backends[x].InstanceGroupID != "" {
+			ig := g.GetComponents().ByProviderID(b.Backends[x].InstanceGroupID)
+			if ig != nil {
+				b.Backends[x].InstanceGroup = ig.GetName()
+			}
+		}
+
+		if b.Backends[x].InstanceGroup != "" && b.Backends[x].InstanceGroupID == "" {
+			b.Backends[x].InstanceGroupID = templInstanceGroupID(b.Backends[x].InstanceGroup)
+		}
+
+		if b.Backends[x].BalancingMode == "" {
+			b.Backends[x].BalancingMode = "CONNECTION"
+		}
+	}
+
+	b.SetDefaultVariables()
+}
+
+// Dependencies : returns a list of component id's upon which the component depends
+func (b *BackendService) Dependencies() []string {
+	var deps []string
+
+	for _, be := range b.Backends {
+		deps = append(deps, TYPEINSTANCEGROUP+TYPEDELIMITER+be.InstanceGroup)
+	}
+
+	return deps
+}
+
+// Validate : validates the components values
+func (b *BackendService) Validate() error {
+	err := validateName(b.Name, "Backend Service")
+	if err != nil {
+		return err
+	}
+
+	if isOneOf(BACKENDPROTOCOLS, b.Protocol) != true {
+		return fmt.Errorf("Backend Service protocol (%s) is not supported", b.Protocol)
+	}
+
+	if b.LoadBalancingScheme != SCHEMEEXTERNAL && b.LoadBalancingScheme != SCHEMEINTERNAL {
+		return errors.New("Backend Service load balancing scheme should be one of EXTERNAL or INTERNAL")
+	}
+
+	if b.Timeout < 1 || b.Timeout > 86400 {
+		return errors.New("Backend Service timeout should be between 1 and 86400 seconds")
+	}
+
+	if len(b.Backends) < 1 {
+		return errors.New("Backend Service should specify at least one backend instance group")
+	}
+
+	for _, be := range b.Backends {
+		if be.InstanceGroup == "" {
+			return errors.New("Backend Service backend instance group should not be null")
+		}
+
+		if be.BalancingMode != "CONNECTION" && be.BalancingMode != "UTILIZATION" && be.BalancingMode != "RATE" {
+			return fmt.Errorf("Backend Service backend (%s) balancing mode should be one of CONNECTION, UTILIZATION or RATE", be.InstanceGroup)
+		}
+	}
+
+	hc := b.HealthCheck
+
+	if isOneOf([]string{"TCP", "SSL", "HTTP", "HTTPS", "HTTP2"}, hc.Protocol) != true {
+		return fmt.Errorf("Backend Service health check protocol (%s) is not supported", hc.Protocol)
+	}
+
+	if hc.Port < 0 || hc.Port > 65535 {
+		return errors.New("Backend Service health check port is out of range [1 - 65535]")
+	}
+
+	if hc.RequestPath != "" && hc.Protocol != "HTTP" && hc.Protocol != "HTTPS" && hc.Protocol != "HTTP2" {
+		return errors.New("Backend Service health check request path should only be set for http health checks")
+	}
+
+	if hc.Timeout > hc.CheckInterval && hc.CheckInterval > 0 {
+		return errors.New("Backend Service health check timeout should not be greater than the check interval")
+	}
+
+	return nil
+}
+
+// IsStateful : returns true if the component needs to be actioned to be removed.
+func (b *BackendService) IsStateful() bool {
+	return true
+}
+
+// SetDefaultVariables : sets up the default template variables for a component
+func (b *BackendService) SetDefaultVariables() {
+	b.ComponentType = TYPEBACKENDSERVICE
+	b.ComponentID = TYPEBACKENDSERVICE + TYPEDELIMITER + b.Name
+	b.ProviderType = PROVIDERTYPE
+	b.DatacenterName = DATACENTERNAME
+	b.DatacenterType = DATACENTERTYPE
+	b.DatacenterRegion = DATACENTERREGION
+	b.ProjectID = PROJECTID
+	b.Credentials = CREDENTIALS
+}
@@ -0,0 +1,182 @@
+/* This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at http://mozilla.org/MPL/2.0/. */
+
+package components
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+
+	graph "gopkg.in/r3labs/graph.v2"
+)
+
+// DISKTYPES : persistent disk types that can be provisioned
+var DISKTYPES = []string{"pd-standard", "pd-balanced", "pd-ssd"}
+
+// Disk : Mapping of a persistent disk component
+type Disk struct {
+	ProviderType     string            `json:"_provider"`
+	ComponentType    string            `json:"_component"`
+	ComponentID      string            `json:"_component_id"`
+	State            string            `json:"_state"`
+	Action           string            `json:"_action"`
+	DiskID           string            `json:"disk_id"`
+	Name             string            `json:"name"`
+	Zone             string            `json:"zone"`
+	Type             string            `json:"type"`
+	Size             *int64            `json:"size"`
+	Image            string            `json:"image,omitempty"`
+	Labels           map[string]string `json:"labels"`
+	DatacenterType   string            `json:"datacenter_type,omitempty"`
+	DatacenterName   string            `json:"datacenter_name,omitempty"`
+	DatacenterRegion string            `json:"datacenter_region"`
+	ProjectID        string            `json:"project_id"`
+	Credentials      string            `json:"credentials"`
+	Service          string            `json:"service"`
+}
+
+// GetID : returns the component's ID
+func (d *Disk) GetID() string {
+	return d.ComponentID
+}
+
+// GetName returns a components name
+func (d *Disk) GetName() string {
+	return d.Name
+}
+
+// GetProvider : returns the provider type
+func (d *Disk) GetProvider() string {
+	return d.ProviderType
+}
+
+// GetProviderID returns a components provider id
+func (d *Disk) GetProviderID() string {
+	return d.DiskID
+}
+
+// GetType : returns the type of the component
+func (d *Disk) GetType() string {
+	return d.ComponentType
+}
+
+// GetState : returns the state of the component
+func (d *Disk) GetState() string {
+	return d.State
+}
+
+// SetState : sets the state of the component
+func (d *Disk) SetState(s string) {
+	d.State = s
+}
+
+// GetAction : returns the action of the component
+func (d *Disk) GetAction() string {
+	return d.Action
+}
+
+// SetAction : Sets the action of the component
+func (d *Disk) SetAction(s string) {
+	d.Action = s
+}
+
+// GetGroup : returns the components group
+func (d *Disk) GetGroup() string {
+	return ""
+}
+
+// GetTags returns a components labels
+func (d *Disk) GetTags() map[string]string {
+	return d.Labels
+}
+
+// GetTag returns a components label
+func (d *Disk) GetTag(tag string) string {
+	return d.Labels[tag]
+}
+
+// Diff : diff's the component against another component of the same type.
+// Disks can only be grown in place
+func (d *Disk) Diff(c graph.Component) bool {
+	cd, ok := c.(*Disk)
+	if ok {
+		if d.Size != nil && cd.Size != nil {
+			if *d.Size != *cd.Size {
+				return true
+			}
+		}
+
+		return !reflect.DeepEqual(d.Labels, cd.Labels)
+	}
+
+	return false
+}
+
+// Update : updates the provider returned values of a component
+func (d *Disk) Update(c graph.Component) {
+	cd, ok := c.(*Disk)
+	if ok {
+		d.DiskID = cd.DiskID
+	}
+
+	d.SetDefaultVariables()
+}
+
+// Rebuild : rebuilds the component's internal state, such as templated values
+func (d *Disk) Rebuild(g *graph.Graph) {
+	if d.Type == "" {
+		d.Type = "pd-standard"
+	}
+
+	d.SetDefaultVariables()
+}
+
+// Dependencies : returns a list of component id's upon which the component depends
+func (d *Disk) Dependencies() []string {
+	return []string{}
+}
+
+// Validate : validates the components values
+func (d *Disk) Validate() error {
+	err := validateName(d.Name, "Disk")
+	if err != nil {
+		return err
+	}
+
+	if d.Zone == "" {
+		return errors.New("Disk zone should not be null")
+	}
+
+	if isOneOf(DISKTYPES, d.Type) != true {
+		return fmt.Errorf("Disk type (%s) should be one of pd-standard, pd-balanced or pd-ssd", d.Type)
+	}
+
+	if d.Size == nil {
+		return errors.New("Disk size should not be null")
+	}
+
+	if *d.Size < 10 || *d.Size > 65536 {
+		return errors.New("Disk size should be between 10 and 65536 GB")
+	}
+
+	return validateLabels(d.Labels, "Disk")
+}
+
+// IsStateful : returns true if the component needs to be actioned to be removed.
+func (d *Disk) IsStateful() bool {
+	return true
+}
+
+// SetDefaultVariables : sets up the default template variables for a component
+func (d *Disk) SetDefaultVariables() {
+	d.ComponentType = TYPEDISK
+	d.ComponentID = TYPEDISK + TYPEDELIMITER + d.Name
+	d.ProviderType = PROVIDERTYPE
+	d.DatacenterName = DATACENTERNAME
+	d.DatacenterType = DATACENTERTYPE
+	d.DatacenterRegion = DATACENTERREGION
+	d.ProjectID = PROJECTID
+	d.Credentials = CREDENTIALS
+}
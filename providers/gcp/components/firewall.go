@@ -0,0 +1,272 @@
+/* This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at http://mozilla.org/MPL/2.0/. */
+
+package components
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"reflect"
+
+	graph "gopkg.in/r3labs/graph.v2"
+)
+
+const (
+	DIRECTIONINGRESS = "INGRESS"
+	DIRECTIONEGRESS  = "EGRESS"
+)
+
+// FIREWALLPROTOCOLS : protocols that can be matched by a firewall rule
+var FIREWALLPROTOCOLS = []string{"tcp", "udp", "icmp", "esp", "ah", "sctp", "ipip", "all"}
+
+// FirewallRule : a protocol and set of ports to allow or deny
+type FirewallRule struct {
+	Protocol string   `json:"protocol"`
+	Ports    []string `json:"ports"`
+}
+
+// Firewall : Mapping of a firewall component
+type Firewall struct {
+	ProviderType      string            `json:"_provider"`
+	ComponentType     string            `json:"_component"`
+	ComponentID       string            `json:"_component_id"`
+	State             string            `json:"_state"`
+	Action            string            `json:"_action"`
+	FirewallID        string            `json:"firewall_id"`
+	Name              string            `json:"name"`
+	Network           string            `json:"network"`
+	NetworkID         string            `json:"network_id"`
+	Direction         string            `json:"direction"`
+	Priority          *int64            `json:"priority"`
+	Allow             []FirewallRule    `json:"allow"`
+	Deny              []FirewallRule    `json:"deny"`
+	SourceRanges      []string          `json:"source_ranges"`
+	DestinationRanges []string          `json:"destination_ranges"`
+	SourceTags        []string          `json:"source_tags"`
+	TargetTags        []string          `json:"target_tags"`
+	Labels            map[string]string `json:"labels"`
+	DatacenterType    string            `json:"datacenter_type,omitempty"`
+	DatacenterName    string            `json:"datacenter_name,omitempty"`
+	DatacenterRegion  string            `json:"datacenter_region"`
+	ProjectID         string            `json:"project_id"`
+	Credentials       string            `json:"credentials"`
+	Service           string            `json:"service"`
+}
+
+// GetID : returns the component's ID
+func (f *Firewall) GetID() string {
+	return f.ComponentID
+}
+
+// GetName returns a components name
+func (f *Firewall) GetName() string {
+	return f.Name
+}
+
+// GetProvider : returns the provider type
+func (f *Firewall) GetProvider() string {
+	return f.ProviderType
+}
+
+// GetProviderID returns a components provider id
+func (f *Firewall) GetProviderID() string {
+	return f.FirewallID
+}
+
+// GetType : returns the type of the component
+func (f *Firewall) GetType() string {
+	return f.ComponentType
+}
+
+// GetState : returns the state of the component
+func (f *Firewall) GetState() string {
+	return f.State
+}
+
+// SetState : sets the state of the component
+func (f *Firewall) SetState(s string) {
+	f.State = s
+}
+
+// GetAction : returns the action of the component
+func (f *Firewall) GetAction() string {
+	return f.Action
+}
+
+// SetAction : Sets the action of the component
+func (f *Firewall) SetAction(s string) {
+	f.Action = s
+}
+
+// GetGroup : returns the components group
+func (f *Firewall) GetGroup() string {
+	return ""
+}
+
+// GetTags returns a components labels
+func (f *Firewall) GetTags() map[string]string {
+	return f.Labels
+}
+
+// GetTag returns a components label
+func (f *Firewall) GetTag(tag string) string {
+	return f.Labels[tag]
+}
+
+// Diff : diff's the component against another component of the same type
+func (f *Firewall) Diff(c graph.Component) bool {
+	cf, ok := c.(*Firewall)
+	if ok {
+		if f.Direction != cf.Direction {
+			return true
+		}
+
+		if f.Priority != nil && cf.Priority != nil {
+			if *f.Priority != *cf.Priority {
+				return true
+			}
+		}
+
+		if reflect.DeepEqual(f.Allow, cf.Allow) != true || reflect.DeepEqual(f.Deny, cf.Deny) != true {
+			return true
+		}
+
+		if reflect.DeepEqual(f.SourceRanges, cf.SourceRanges) != true || reflect.DeepEqual(f.DestinationRanges, cf.DestinationRanges) != true {
+			return true
+		}
+
+		return !reflect.DeepEqual(f.SourceTags, cf.SourceTags) || !reflect.DeepEqual(f.TargetTags, cf.TargetTags)
+	}
+
+	return false
+}
+
+// Update : updates the provider returned values of a component
+func (f *Firewall) Update(c graph.Component) {
+	cf, ok := c.(*Firewall)
+	if ok {
+		f.FirewallID = cf.FirewallID
+	}
+
+	f.SetDefaultVariables()
+}
+
+// Rebuild : rebuilds the component's internal state, such as templated values
+func (f *Firewall) Rebuild(g *graph.Graph) {
+	if f.Network == "" && f.NetworkID != "" {
+		nw := g.GetComponents().ByProviderID(f.NetworkID)
+		if nw != nil {
+			f.Network = nw.GetName()
+		}
+	}
+
+	if f.Network != "" && f.NetworkID == "" {
+		f.NetworkID = templNetworkID(f.Network)
+	}
+
+	if f.Direction == "" {
+		f.Direction = DIRECTIONINGRESS
+	}
+
+	f.SetDefaultVariables()
+}
+
+// Dependencies : returns a list of component id's upon which the component depends
+func (f *Firewall) Dependencies() []string {
+	return []string{TYPENETWORK + TYPEDELIMITER + f.Network}
+}
+
+// Validate : validates the components values
+func (f *Firewall) Validate() error {
+	err := validateName(f.Name, "Firewall")
+	if err != nil {
+		return err
+	}
+
+	if f.Network == "" {
+		return errors.New("Firewall network should not be null")
+	}
+
+	if f.Direction != DIRECTIONINGRESS && f.Direction != DIRECTIONEGRESS {
+		return errors.New("Firewall direction should be one of INGRESS or EGRESS")
+	}
+
+	if f.Priority != nil {
+		if *f.Priority < 0 || *f.Priority > 65535 {
+			return errors.New("Firewall priority should be between 0 and 65535")
+		}
+	}
+
+	if len(f.Allow) > 0 && len(f.Deny) > 0 {
+		return errors.New("Firewall should not specify both allow and deny rules")
+	}
+
+	if len(f.Allow) < 1 && len(f.Deny) < 1 {
+		return errors.New("Firewall should specify at least one allow or deny rule")
+	}
+
+	for _, r := range append(append([]FirewallRule{}, f.Allow...), f.Deny...) {
+		if isOneOf(FIREWALLPROTOCOLS, r.Protocol) != true {
+			return fmt.Errorf("Firewall rule protocol (%s) is not supported", r.Protocol)
+		}
+
+		if len(r.Ports) > 0 && r.Protocol != "tcp" && r.Protocol != "udp" && r.Protocol != "sctp" {
+			return fmt.Errorf("Firewall rule should only specify ports for tcp, udp or sctp, not %s", r.Protocol)
+		}
+
+		for _, p := range r.Ports {
+			err := validatePortRange(p)
+			if err != nil {
+				return errors.New("Firewall rule " + err.Error())
+			}
+		}
+	}
+
+	if f.Direction == DIRECTIONINGRESS {
+		if len(f.DestinationRanges) > 0 {
+			return errors.New("Firewall ingress rules should not specify destination ranges")
+		}
+
+		if len(f.SourceRanges) < 1 && len(f.SourceTags) < 1 {
+			return errors.New("Firewall ingress rules should specify source ranges or source tags")
+		}
+	}
+
+	if f.Direction == DIRECTIONEGRESS {
+		if len(f.SourceRanges) > 0 || len(f.SourceTags) > 0 {
+			return errors.New("Firewall egress rules should not specify source ranges or source tags")
+		}
+
+		if len(f.DestinationRanges) < 1 {
+			return errors.New("Firewall egress rules should specify destination ranges")
+		}
+	}
+
+	for _, r := range append(append([]string{}, f.SourceRanges...), f.DestinationRanges...) {
+		_, _, err := net.ParseCIDR(r)
+		if err != nil {
+			return fmt.Errorf("Firewall range (%s) is not a valid CIDR", r)
+		}
+	}
+
+	return nil
+}
+
+// IsStateful : returns true if the component needs to be actioned to be removed.
+func (f *Firewall) IsStateful() bool {
+	return true
+}
+
+// SetDefaultVariables : sets up the default template variables for a component
+func (f *Firewall) SetDefaultVariables() {
+	f.ComponentType = TYPEFIREWALL
+	f.ComponentID = TYPEFIREWALL + TYPEDELIMITER + f.Name
+	f.ProviderType = PROVIDERTYPE
+	f.DatacenterName = DATACENTERNAME
+	f.DatacenterType = DATACENTERTYPE
+	f.DatacenterRegion = DATACENTERREGION
+	f.ProjectID = PROJECTID
+	f.Credentials = CREDENTIALS
+}
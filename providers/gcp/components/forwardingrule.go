@@ -0,0 +1,263 @@
+/* This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at http://mozilla.org/MPL/2.0/. */
+
+package components
+
+import (
+	"errors"
+	"net"
+	"reflect"
+
+	graph "gopkg.in/r3labs/graph.v2"
+)
+
+const (
+	SCHEMEEXTERNAL = "EXTERNAL"
+	SCHEMEINTERNAL = "INTERNAL"
+)
+
+// ForwardingRule : mapping of a regional forwarding rule component
+type ForwardingRule struct {
+	ProviderType        string            `json:"_provider"`
+	ComponentType       string            `json:"_component"`
+	ComponentID         string            `json:"_component_id"`
+	State               string            `json:"_state"`
+	Action              string            `json:"_action"`
+	ForwardingRuleID    string            `json:"forwarding_rule_id"`
+	Name                string            `json:"name"`
+	Region              string            `json:"region"`
+	IPAddress           string            `json:"ip_address"`
+	IPProtocol          string            `json:"ip_protocol"`
+	PortRange           string            `json:"port_range"`
+	Ports               []string          `json:"ports"`
+	LoadBalancingScheme string            `json:"load_balancing_scheme"`
+	BackendService      string            `json:"backend_service"`
+	BackendServiceID    string            `json:"backend_service_id"`
+	Subnetwork          string            `json:"subnetwork"`
+	SubnetworkID        string            `json:"subnetwork_id"`
+	Labels              map[string]string `json:"labels"`
+	DatacenterType      string            `json:"datacenter_type,omitempty"`
+	DatacenterName      string            `json:"datacenter_name,omitempty"`
+	DatacenterRegion    string            `json:"datacenter_region"`
+	ProjectID           string            `json:"project_id"`
+	Credentials         string            `json:"credentials"`
+	Service             string            `json:"service"`
+}
+
+// GetID : returns the component's ID
+func (fr *ForwardingRule) GetID() string {
+	return fr.ComponentID
+}
+
+// GetName returns a components name
+func (fr *ForwardingRule) GetName() string {
+	return fr.Name
+}
+
+// GetProvider : returns the provider type
+func (fr *ForwardingRule) GetProvider() string {
+	return fr.ProviderType
+}
+
+// GetProviderID returns a components provider id
+func (fr *ForwardingRule) GetProviderID() string {
+	return fr.ForwardingRuleID
+}
+
+// GetType : returns the type of the component
+func (fr *ForwardingRule) GetType() string {
+	return fr.ComponentType
+}
+
+// GetState : returns the state of the component
+func (fr *ForwardingRule) GetState() string {
+	return fr.State
+}
+
+// SetState : sets the state of the component
+func (fr *ForwardingRule) SetState(s string) {
+	fr.State = s
+}
+
+// GetAction : returns the action of the component
+func (fr *ForwardingRule) GetAction() string {
+	return fr.Action
+}
+
+// SetAction : Sets the action of the component
+func (fr *ForwardingRule) SetAction(s string) {
+	fr.Action = s
+}
+
+// GetGroup : returns the components group
+func (fr *ForwardingRule) GetGroup() string {
+	return ""
+}
+
+// GetTags returns a components labels
+func (fr *ForwardingRule) GetTags() map[string]string {
+	return fr.Labels
+}
+
+// GetTag returns a components label
+func (fr *ForwardingRule) GetTag(tag string) string {
+	return fr.Labels[tag]
+}
+
+// Diff : diff's the component against another component of the same type.
+// Forwarding rules can only have their target and labels changed in place
+func (fr *ForwardingRule) Diff(c graph.Component) bool {
+	cfr, ok := c.(*ForwardingRule)
+	if ok {
+		if fr.BackendService != cfr.BackendService {
+			return true
+		}
+
+		return !reflect.DeepEqual(fr.Labels, cfr.Labels)
+	}
+
+	return false
+}
+
+// Update : updates the provider returned values of a component
+func (fr *ForwardingRule) Update(c graph.Component) {
+	cfr, ok := c.(*ForwardingRule)
+	if ok {
+		fr.ForwardingRuleID = cfr.ForwardingRuleID
+		fr.IPAddress = cfr.IPAddress
+	}
+
+	fr.SetDefaultVariables()
+}
+
+// Rebuild : rebuilds the component's internal state, such as templated values
+func (fr *ForwardingRule) Rebuild(g *graph.Graph) {
+	if fr.BackendService == "" && fr.BackendServiceID != "" {
+		bs := g.GetComponents().ByProviderID(fr.BackendServiceID)
+		if bs != nil {
+			fr.BackendService = bs.GetName()
+		}
+	}
+
+	if fr.BackendService != "" && fr.BackendServiceID == "" {
+		fr.BackendServiceID = templBackendServiceID(fr.BackendService)
+	}
+
+	if fr.Subnetwork == "" && fr.SubnetworkID != "" {
+		sn := g.GetComponents().ByProviderID(fr.SubnetworkID)
+		if sn != nil {
+			fr.Subnetwork = sn.GetName()
+		}
+	}
+
+	if fr.Subnetwork != "" && fr.SubnetworkID == "" {
+		fr.SubnetworkID = templSubnetworkID(fr.Subnetwork)
+	}
+
+	if fr.Region == "" {
+		fr.Region = DATACENTERREGION
+	}
+
+	if fr.LoadBalancingScheme == "" {
+		fr.LoadBalancingScheme = SCHEMEEXTERNAL
+	}
+
+	if fr.IPProtocol == "" {
+		fr.IPProtocol = "TCP"
+	}
+
+	fr.SetDefaultVariables()
+}
+
+// Dependencies : returns a list of component id's upon which the component depends
+func (fr *ForwardingRule) Dependencies() []string {
+	deps := []string{TYPEBACKENDSERVICE + TYPEDELIMITER + fr.BackendService}
+
+	if fr.Subnetwork != "" {
+		deps = append(deps, TYPESUBNETWORK+TYPEDELIMITER+fr.Subnetwork)
+	}
+
+	return deps
+}
+
+// Validate : validates the components values
+func (fr *ForwardingRule) Validate() error {
+	err := validateName(fr.Name, "Forwarding Rule")
+	if err != nil {
+		return err
+	}
+
+	if fr.BackendService == "" {
+		return errors.New("Forwarding Rule backend service should not be null")
+	}
+
+	if isOneOf([]string{"TCP", "UDP", "ESP", "AH", "SCTP", "ICMP"}, fr.IPProtocol) != true {
+		return errors.New("Forwarding Rule ip protocol should be one of TCP, UDP, ESP, AH, SCTP or ICMP")
+	}
+
+	if fr.LoadBalancingScheme != SCHEMEEXTERNAL && fr.LoadBalancingScheme != SCHEMEINTERNAL {
+		return errors.New("Forwarding Rule load balancing scheme should be one of EXTERNAL or INTERNAL")
+	}
+
+	if fr.LoadBalancingScheme == SCHEMEINTERNAL {
+		if fr.Subnetwork == "" {
+			return errors.New("Forwarding Rule internal load balancing should specify a subnetwork")
+		}
+
+		if fr.PortRange != "" {
+			return errors.New("Forwarding Rule internal load balancing should specify ports, not a port range")
+		}
+
+		if len(fr.Ports) > 5 {
+			return errors.New("Forwarding Rule should not specify more than 5 ports")
+		}
+	}
+
+	if fr.LoadBalancingScheme == SCHEMEEXTERNAL {
+		if fr.Subnetwork != "" {
+			return errors.New("Forwarding Rule external load balancing should not specify a subnetwork")
+		}
+
+		if len(fr.Ports) > 0 {
+			return errors.New("Forwarding Rule external load balancing should specify a port range, not ports")
+		}
+	}
+
+	if fr.PortRange != "" {
+		err := validatePortRange(fr.PortRange)
+		if err != nil {
+			return errors.New("Forwarding Rule " + err.Error())
+		}
+	}
+
+	for _, p := range fr.Ports {
+		err := validatePortRange(p)
+		if err != nil {
+			return errors.New("Forwarding Rule " + err.Error())
+		}
+	}
+
+	if fr.IPAddress != "" && net.ParseIP(fr.IPAddress) == nil {
+		return errors.New("Forwarding Rule ip address is not valid")
+	}
+
+	return validateLabels(fr.Labels, "Forwarding Rule")
+}
+
+// IsStateful : returns true if the component needs to be actioned to be removed.
+func (fr *ForwardingRule) IsStateful() bool {
+	return true
+}
+
+// SetDefaultVariables : sets up the default template variables for a component
+func (fr *ForwardingRule) SetDefaultVariables() {
+	fr.ComponentType = TYPEFORWARDINGRULE
+	fr.ComponentID = TYPEFORWARDINGRULE + TYPEDELIMITER + fr.Name
+	fr.ProviderType = PROVIDERTYPE
+	fr.DatacenterName = DATACENTERNAME
+	fr.DatacenterType = DATACENTERTYPE
+	fr.DatacenterRegion = DATACENTERREGION
+	fr.ProjectID = PROJECTID
+	fr.Credentials = CREDENTIALS
+}
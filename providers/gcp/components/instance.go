@@ -0,0 +1,282 @@
+/* This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at http://mozilla.org/MPL/2.0/. */
+
+package components
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"reflect"
+
+	graph "gopkg.in/r3labs/graph.v2"
+)
+
+// InstanceBootDisk : the boot disk created alongside an instance
+type InstanceBootDisk struct {
+	Type string `json:"type"`
+	Size *int64 `json:"size"`
+}
+
+// InstanceDisk : an attached persistent disk
+type InstanceDisk struct {
+	Disk       string `json:"disk"`
+	DiskID     string `json:"disk_id"`
+	DeviceName string `json:"device_name"`
+	ReadOnly   bool   `json:"read_only"`
+}
+
+// Instance : mapping of a compute instance component
+type Instance struct {
+	ProviderType     string            `json:"_provider"`
+	ComponentType    string            `json:"_component"`
+	ComponentID      string            `json:"_component_id"`
+	State            string            `json:"_state"`
+	Action           string            `json:"_action"`
+	InstanceID       string            `json:"instance_id"`
+	Name             string            `json:"name"`
+	MachineType      string            `json:"machine_type"`
+	Zone             string            `json:"zone"`
+	Image            string            `json:"image"`
+	Subnetwork       string            `json:"subnetwork"`
+	SubnetworkID     string            `json:"subnetwork_id"`
+	IP               string            `json:"ip"`
+	PublicIP         string            `json:"public_ip"`
+	AssignPublicIP   bool              `json:"assign_public_ip"`
+	NetworkTags      []string          `json:"network_tags"`
+	BootDisk         InstanceBootDisk  `json:"boot_disk"`
+	Disks            []InstanceDisk    `json:"disks"`
+	Metadata         map[string]string `json:"metadata"`
+	Labels           map[string]string `json:"labels"`
+	DatacenterType   string            `json:"datacenter_type,omitempty"`
+	DatacenterName   string            `json:"datacenter_name,omitempty"`
+	DatacenterRegion string            `json:"datacenter_region"`
+	ProjectID        string            `json:"project_id"`
+	Credentials      string            `json:"credentials"`
+	Service          string            `json:"service"`
+}
+
+// GetID : returns the component's ID
+func (i *Instance) GetID() string {
+	return i.ComponentID
+}
+
+// GetName returns a components name
+func (i *Instance) GetName() string {
+	return i.Name
+}
+
+// GetProvider : returns the provider type
+func (i *Instance) GetProvider() string {
+	return i.ProviderType
+}
+
+// GetProviderID returns a components provider id
+func (i *Instance) GetProviderID() string {
+	return i.InstanceID
+}
+
+// GetType : returns the type of the component
+func (i *Instance) GetType() string {
+	return i.ComponentType
+}
+
+// GetState : returns the state of the component
+func (i *Instance) GetState() string {
+	return i.State
+}
+
+// SetState : sets the state of the component
+func (i *Instance) SetState(s string) {
+	i.State = s
+}
+
+// GetAction : returns the action of the component
+func (i *Instance) GetAction() string {
+	return i.Action
+}
+
+// SetAction : Sets the action of the component
+func (i *Instance) SetAction(s string) {
+	i.Action = s
+}
+
+// GetGroup : returns the components group
+func (i *Instance) GetGroup() string {
+	return i.Labels[LABELINSTANCEGROUP]
+}
+
+// GetTags returns a components labels
+func (i *Instance) GetTags() map[string]string {
+	return i.Labels
+}
+
+// GetTag returns a components label
+func (i *Instance) GetTag(tag string) string {
+	return i.Labels[tag]
+}
+
+// Diff : diff's the component against another component of the same type
+func (i *Instance) Diff(c graph.Component) bool {
+	ci, ok := c.(*Instance)
+	if ok {
+		if i.MachineType != ci.MachineType {
+			return true
+		}
+
+		if i.AssignPublicIP != ci.AssignPublicIP {
+			return true
+		}
+
+		if reflect.DeepEqual(i.NetworkTags, ci.NetworkTags) != true {
+			return true
+		}
+
+		if reflect.DeepEqual(i.Metadata, ci.Metadata) != true {
+			return true
+		}
+
+		if len(i.Disks) != len(ci.Disks) {
+			return true
+		}
+
+		for x := 0; x < len(i.Disks); x++ {
+			if i.Disks[x].Disk != ci.Disks[x].Disk || i.Disks[x].DeviceName != ci.Disks[x].DeviceName || i.Disks[x].ReadOnly != ci.Disks[x].ReadOnly {
+				return true
+			}
+		}
+
+		return !reflect.DeepEqual(i.Labels, ci.Labels)
+	}
+
+	return false
+}
+
+// Update : updates the provider returned values of a component
+func (i *Instance) Update(c graph.Component) {
+	ci, ok := c.(*Instance)
+	if ok {
+		i.InstanceID = ci.InstanceID
+		i.PublicIP = ci.PublicIP
+	}
+
+	i.SetDefaultVariables()
+}
+
+// Rebuild : rebuilds the component's internal state, such as templated values
+func (i *Instance) Rebuild(g *graph.Graph) {
+	if i.Subnetwork == "" && i.SubnetworkID != "" {
+		sn := g.GetComponents().ByProviderID(i.SubnetworkID)
+		if sn != nil {
+			i.Subnetwork = sn.GetName()
+		}
+	}
+
+	if i.Subnetwork != "" && i.SubnetworkID == "" {
+		i.SubnetworkID = templSubnetworkID(i.Subnetwork)
+	}
+
+	for x := 0; x < len(i.Disks); x++ {
+		if i.Disks[x].Disk == "" && i.Disks[x].DiskID != "" {
+			d := g.GetComponents().ByProviderID(i.Disks[x].DiskID)
+			if d != nil {
+				i.Disks[x].Disk = d.GetName()
+			}
+		}
+
+		if i.Disks[x].Disk != "" && i.Disks[x].DiskID == "" {
+			i.Disks[x].DiskID = templDiskID(i.Disks[x].Disk)
+		}
+	}
+
+	if i.BootDisk.Type == "" {
+		i.BootDisk.Type = "pd-standard"
+	}
+
+	i.SetDefaultVariables()
+}
+
+// Dependencies : returns a list of component id's upon which the component depends
+func (i *Instance) Dependencies() []string {
+	deps := []string{TYPESUBNETWORK + TYPEDELIMITER + i.Subnetwork}
+
+	for _, d := range i.Disks {
+		deps = append(deps, TYPEDISK+TYPEDELIMITER+d.Disk)
+	}
+
+	return deps
+}
+
+// Validate : validates the components values
+func (i *Instance) Validate() error {
+	err := validateName(i.Name, "Instance")
+	if err != nil {
+		return err
+	}
+
+	if i.MachineType == "" {
+		return errors.New("Instance machine type should not be null")
+	}
+
+	if i.Zone == "" {
+		return errors.New("Instance zone should not be null")
+	}
+
+	if i.Image == "" {
+		return errors.New("Instance image should not be null")
+	}
+
+	if i.Subnetwork == "" {
+		return errors.New("Instance subnetwork should not be null")
+	}
+
+	if i.IP != "" && net.ParseIP(i.IP).To4() == nil {
+		return errors.New("Instance ip should be a valid ipv4 address")
+	}
+
+	if isOneOf(DISKTYPES, i.BootDisk.Type) != true {
+		return fmt.Errorf("Instance boot disk type (%s) should be one of pd-standard, pd-balanced or pd-ssd", i.BootDisk.Type)
+	}
+
+	if i.BootDisk.Size != nil {
+		if *i.BootDisk.Size < 10 || *i.BootDisk.Size > 65536 {
+			return errors.New("Instance boot disk size should be between 10 and 65536 GB")
+		}
+	}
+
+	var devices []string
+
+	for _, d := range i.Disks {
+		if d.Disk == "" {
+			return errors.New("Instance attached disk should not be null")
+		}
+
+		if d.DeviceName != "" {
+			if isOneOf(devices, d.DeviceName) {
+				return fmt.Errorf("Instance attached disk device name (%s) is used more than once", d.DeviceName)
+			}
+
+			devices = append(devices, d.DeviceName)
+		}
+	}
+
+	return validateLabels(i.Labels, "Instance")
+}
+
+// IsStateful : returns true if the component needs to be actioned to be removed.
+func (i *Instance) IsStateful() bool {
+	return true
+}
+
+// SetDefaultVariables : sets up the default template variables for a component
+func (i *Instance) SetDefaultVariables() {
+	i.ComponentType = TYPEINSTANCE
+	i.ComponentID = TYPEINSTANCE + TYPEDELIMITER + i.Name
+	i.ProviderType = PROVIDERTYPE
+	i.DatacenterName = DATACENTERNAME
+	i.DatacenterType = DATACENTERTYPE
+	i.DatacenterRegion = DATACENTERREGION
+	i.ProjectID = PROJECTID
+	i.Credentials = CREDENTIALS
+}
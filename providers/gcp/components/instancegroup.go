@@ -0,0 +1,223 @@
+/* This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at http://mozilla.org/MPL/2.0/. */
+
+package components
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+
+	graph "gopkg.in/r3labs/graph.v2"
+)
+
+// NamedPort : a named port exposed by an instance group, referenced by backend services
+type NamedPort struct {
+	Name string `json:"name"`
+	Port int64  `json:"port"`
+}
+
+// InstanceGroup : mapping of an unmanaged instance group component
+type InstanceGroup struct {
+	ProviderType     string            `json:"_provider"`
+	ComponentType    string            `json:"_component"`
+	ComponentID      string            `json:"_component_id"`
+	State            string            `json:"_state"`
+	Action           string            `json:"_action"`
+	InstanceGroupID  string            `json:"instance_group_id"`
+	Name             string            `json:"name"`
+	Zone             string            `json:"zone"`
+	Subnetwork       string            `json:"subnetwork"`
+	SubnetworkID     string            `json:"subnetwork_id"`
+	Instances        []string          `json:"instances"`
+	InstanceIDs      []string          `json:"instance_ids"`
+	NamedPorts       []NamedPort       `json:"named_ports"`
+	Labels           map[string]string `json:"labels"`
+	DatacenterType   string            `json:"datacenter_type,omitempty"`
+	DatacenterName   string            `json:"datacenter_name,omitempty"`
+	DatacenterRegion string            `json:"datacenter_region"`
+	ProjectID        string            `json:"project_id"`
+	Credentials      string            `json:"credentials"`
+	Service          string            `json:"service"`
+}
+
+// GetID : returns the component's ID
+func (ig *InstanceGroup) GetID() string {
+	return ig.ComponentID
+}
+
+// GetName returns a components name
+func (ig *InstanceGroup) GetName() string {
+	return ig.Name
+}
+
+// GetProvider : returns the provider type
+func (ig *InstanceGroup) GetProvider() string {
+	return ig.ProviderType
+}
+
+// GetProviderID returns a components provider id
+func (ig *InstanceGroup) GetProviderID() string {
+	return ig.InstanceGroupID
+}
+
+// GetType : returns the type of the component
+func (ig *InstanceGroup) GetType() string {
+	return ig.ComponentType
+}
+
+// GetState : returns the state of the component
+func (ig *InstanceGroup) GetState() string {
+	return ig.State
+}
+
+// SetState : sets the state of the component
+func (ig *InstanceGroup) SetState(s string) {
+	ig.State = s
+}
+
+// GetAction : returns the action of the component
+func (ig *InstanceGroup) GetAction() string {
+	return ig.Action
+}
+
+// SetAction : Sets the action of the component
+func (ig *InstanceGroup) SetAction(s string) {
+	ig.Action = s
+}
+
+// GetGroup : returns the components group
+func (ig *InstanceGroup) GetGroup() string {
+	return ""
+}
+
+// GetTags returns a components labels
+func (ig *InstanceGroup) GetTags() map[string]string {
+	return ig.Labels
+}
+
+// GetTag returns a components label
+func (ig *InstanceGroup) GetTag(tag string) string {
+	return ig.Labels[tag]
+}
+
+// Diff : diff's the component against another component of the same type
+func (ig *InstanceGroup) Diff(c graph.Component) bool {
+	cig, ok := c.(*InstanceGroup)
+	if ok {
+		if reflect.DeepEqual(ig.Instances, cig.Instances) != true {
+			return true
+		}
+
+		return !reflect.DeepEqual(ig.NamedPorts, cig.NamedPorts)
+	}
+
+	return false
+}
+
+// Update : updates the provider returned values of a component
+func (ig *InstanceGroup) Update(c graph.Component) {
+	cig, ok := c.(*InstanceGroup)
+	if ok {
+		ig.InstanceGroupID = cig.InstanceGroupID
+	}
+
+	ig.SetDefaultVariables()
+}
+
+// Rebuild : rebuilds the component's internal state, such as templated values
+func (ig *InstanceGroup) Rebuild(g *graph.Graph) {
+	if ig.Subnetwork == "" && ig.SubnetworkID != "" {
+		sn := g.GetComponents().ByProviderID(ig.SubnetworkID)
+		if sn != nil {
+			ig.Subnetwork = sn.GetName()
+		}
+	}
+
+	if ig.Subnetwork != "" && ig.SubnetworkID == "" {
+		ig.SubnetworkID = templSubnetworkID(ig.Subnetwork)
+	}
+
+	if len(ig.Instances) > len(ig.InstanceIDs) {
+		ig.InstanceIDs = nil
+		for _, in := range ig.Instances {
+			ig.InstanceIDs = append(ig.InstanceIDs, templInstanceID(in))
+		}
+	}
+
+	if len(ig.InstanceIDs) > len(ig.Instances) {
+		for _, inid := range ig.InstanceIDs {
+			in := g.GetComponents().ByProviderID(inid)
+			if in != nil {
+				ig.Instances = append(ig.Instances, in.GetName())
+			}
+		}
+	}
+
+	ig.SetDefaultVariables()
+}
+
+// Dependencies : returns a list of component id's upon which the component depends
+func (ig *InstanceGroup) Dependencies() []string {
+	var deps []string
+
+	if ig.Subnetwork != "" {
+		deps = append(deps, TYPESUBNETWORK+TYPEDELIMITER+ig.Subnetwork)
+	}
+
+	for _, in := range ig.Instances {
+		deps = append(deps, TYPEINSTANCE+TYPEDELIMITER+in)
+	}
+
+	return deps
+}
+
+// Validate : validates the components values
+func (ig *InstanceGroup) Validate() error {
+	err := validateName(ig.Name, "Instance Group")
+	if err != nil {
+		return err
+	}
+
+	if ig.Zone == "" {
+		return errors.New("Instance Group zone should not be null")
+	}
+
+	var names []string
+
+	for _, np := range ig.NamedPorts {
+		if np.Name == "" {
+			return errors.New("Instance Group named port name should not be null")
+		}
+
+		if isOneOf(names, np.Name) {
+			return fmt.Errorf("Instance Group named port (%s) is specified more than once", np.Name)
+		}
+
+		names = append(names, np.Name)
+
+		if np.Port < 1 || np.Port > 65535 {
+			return fmt.Errorf("Instance Group named port (%s) is out of range [1 - 65535]", np.Name)
+		}
+	}
+
+	return validateLabels(ig.Labels, "Instance Group")
+}
+
+// IsStateful : returns true if the component needs to be actioned to be removed.
+func (ig *InstanceGroup) IsStateful() bool {
+	return true
+}
+
+// SetDefaultVariables : sets up the default template variables for a component
+func (ig *InstanceGroup) SetDefaultVariables() {
+	ig.ComponentType = TYPEINSTANCEGROUP
+	ig.ComponentID = TYPEINSTANCEGROUP + TYPEDELIMITER + ig.Name
+	ig.ProviderType = PROVIDERTYPE
+	ig.DatacenterName = DATACENTERNAME
+	ig.DatacenterType = DATACENTERTYPE
+	ig.DatacenterRegion = DATACENTERREGION
+	ig.ProjectID = PROJECTID
+	ig.Credentials = CREDENTIALS
+}
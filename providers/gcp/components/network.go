@@ -0,0 +1,165 @@
+/* This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at http://mozilla.org/MPL/2.0/. */
+
+package components
+
+import (
+	"errors"
+	"reflect"
+
+	graph "gopkg.in/r3labs/graph.v2"
+)
+
+const (
+	ROUTINGREGIONAL = "REGIONAL"
+	ROUTINGGLOBAL   = "GLOBAL"
+)
+
+// Network : Mapping of a vpc network component
+type Network struct {
+	ProviderType     string            `json:"_provider"`
+	ComponentType    string            `json:"_component"`
+	ComponentID      string            `json:"_component_id"`
+	State            string            `json:"_state"`
+	Action           string            `json:"_action"`
+	NetworkID        string            `json:"network_id"`
+	Name             string            `json:"name"`
+	RoutingMode      string            `json:"routing_mode"`
+	Labels           map[string]string `json:"labels"`
+	DatacenterType   string            `json:"datacenter_type,omitempty"`
+	DatacenterName   string            `json:"datacenter_name,omitempty"`
+	DatacenterRegion string            `json:"datacenter_region"`
+	ProjectID        string            `json:"project_id"`
+	Credentials      string            `json:"credentials"`
+	Service          string            `json:"service"`
+}
+
+// GetID : returns the component's ID
+func (n *Network) GetID() string {
+	return n.ComponentID
+}
+
+// GetName returns a components name
+func (n *Network) GetName() string {
+	return n.Name
+}
+
+// GetProvider : returns the provider type
+func (n *Network) GetProvider() string {
+	return n.ProviderType
+}
+
+// GetProviderID returns a components provider id
+func (n *Network) GetProviderID() string {
+	return n.NetworkID
+}
+
+// GetType : returns the type of the component
+func (n *Network) GetType() string {
+	return n.ComponentType
+}
+
+// GetState : returns the state of the component
+func (n *Network) GetState() string {
+	return n.State
+}
+
+// SetState : sets the state of the component
+func (n *Network) SetState(s string) {
+	n.State = s
+}
+
+// GetAction : returns the action of the component
+func (n *Network) GetAction() string {
+	return n.Action
+}
+
+// SetAction : Sets the action of the component
+func (n *Network) SetAction(s string) {
+	n.Action = s
+}
+
+// GetGroup : returns the components group
+func (n *Network) GetGroup() string {
+	return ""
+}
+
+// GetTags returns a components labels
+func (n *Network) GetTags() map[string]string {
+	return n.Labels
+}
+
+// GetTag returns a components label
+func (n *Network) GetTag(tag string) string {
+	return n.Labels[tag]
+}
+
+// Diff : diff's the component against another component of the same type
+func (n *Network) Diff(c graph.Component) bool {
+	cn, ok := c.(*Network)
+	if ok {
+		if n.RoutingMode != cn.RoutingMode {
+			return true
+		}
+
+		return !reflect.DeepEqual(n.Labels, cn.Labels)
+	}
+
+	return false
+}
+
+// Update : updates the provider returned values of a component
+func (n *Network) Update(c graph.Component) {
+	cn, ok := c.(*Network)
+	if ok {
+		n.NetworkID = cn.NetworkID
+	}
+
+	n.SetDefaultVariables()
+}
+
+// Rebuild : rebuilds the component's internal state, such as templated values
+func (n *Network) Rebuild(g *graph.Graph) {
+	if n.RoutingMode == "" {
+		n.RoutingMode = ROUTINGREGIONAL
+	}
+
+	n.SetDefaultVariables()
+}
+
+// Dependencies : returns a list of component id's upon which the component depends
+func (n *Network) Dependencies() []string {
+	return []string{}
+}
+
+// Validate : validates the components values
+func (n *Network) Validate() error {
+	err := validateName(n.Name, "Network")
+	if err != nil {
+		return err
+	}
+
+	if n.RoutingMode != ROUTINGREGIONAL && n.RoutingMode != ROUTINGGLOBAL {
+		return errors.New("Network routing mode should be one of REGIONAL or GLOBAL")
+	}
+
+	return validateLabels(n.Labels, "Network")
+}
+
+// IsStateful : returns true if the component needs to be actioned to be removed.
+func (n *Network) IsStateful() bool {
+	return true
+}
+
+// SetDefaultVariables : sets up the default template variables for a component
+func (n *Network) SetDefaultVariables() {
+	n.ComponentType = TYPENETWORK
+	n.ComponentID = TYPENETWORK + TYPEDELIMITER + n.Name
+	n.ProviderType = PROVIDERTYPE
+	n.DatacenterName = DATACENTERNAME
+	n.DatacenterType = DATACENTERTYPE
+	n.DatacenterRegion = DATACENTERREGION
+	n.ProjectID = PROJECTID
+	n.Credentials = CREDENTIALS
+}
@@ -0,0 +1,323 @@
+/* This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at http://mozilla.org/MPL/2.0/. */
+
+package components
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"reflect"
+	"strings"
+
+	graph "gopkg.in/r3labs/graph.v2"
+)
+
+// SQLAuthorizedNetwork : an external network allowed to connect to the instance
+type SQLAuthorizedNetwork struct {
+	Name  string `json:"name"`
+	Value string `json:"value"`
+}
+
+// SQLUser : a database user
+type SQLUser struct {
+	Name     string `json:"name"`
+	Password string `json:"password"`
+}
+
+// SQLInstance : mapping of a cloud sql instance component
+type SQLInstance struct {
+	ProviderType       string                 `json:"_provider"`
+	ComponentType      string                 `json:"_component"`
+	ComponentID        string                 `json:"_component_id"`
+	State              string                 `json:"_state"`
+	Action             string                 `json:"_action"`
+	SQLInstanceID      string                 `json:"sql_instance_id"`
+	ConnectionName     string                 `json:"connection_name"`
+	IPAddress          string                 `json:"ip_address"`
+	Name               string                 `json:"name"`
+	DatabaseVersion    string                 `json:"database_version"`
+	Tier               string                 `json:"tier"`
+	Region             string                 `json:"region"`
+	DiskSize           *int64                 `json:"disk_size"`
+	DiskType           string                 `json:"disk_type"`
+	DiskAutoresize     bool                   `json:"disk_autoresize"`
+	AvailabilityType   string                 `json:"availability_type"`
+	Network            string                 `json:"network"`
+	NetworkID          string                 `json:"network_id"`
+	PublicIP           bool                   `json:"ipv4_enabled"`
+	AuthorizedNetworks []SQLAuthorizedNetwork `json:"authorized_networks"`
+	BackupEnabled      bool                   `json:"backup_enabled"`
+	BackupStartTime    string                 `json:"backup_start_time"`
+	MaintenanceDay     *int64                 `json:"maintenance_day"`
+	MaintenanceHour    *int64                 `json:"maintenance_hour"`
+	Databases          []string               `json:"databases"`
+	Users              []SQLUser              `json:"users"`
+	DeletionProtection bool                   `json:"deletion_protection"`
+	Labels             map[string]string      `json:"labels"`
+	DatacenterType     string                 `json:"datacenter_type,omitempty"`
+	DatacenterName     string                 `json:"datacenter_name,omitempty"`
+	DatacenterRegion   string                 `json:"datacenter_region"`
+	ProjectID          string                 `json:"project_id"`
+	Credentials        string                 `json:"credentials"`
+	Service            string                 `json:"service"`
+}
+
+// GetID : returns the component's ID
+func (si *SQLInstance) GetID() string {
+	return si.ComponentID
+}
+
+// GetName returns a components name
+func (si *SQLInstance) GetName() string {
+	return si.Name
+}
+
+// GetProvider : returns the provider type
+func (si *SQLInstance) GetProvider() string {
+	return si.ProviderType
+}
+
+// GetProviderID returns a components provider id
+func (si *SQLInstance) GetProviderID() string {
+	return si.SQLInstanceID
+}
+
+// GetType : returns the type of the component
+func (si *SQLInstance) GetType() string {
+	return si.ComponentType
+}
+
+// GetState : returns the state of the component
+func (si *SQLInstance) GetState() string {
+	return si.State
+}
+
+// SetState : sets the state of the component
+func (si *SQLInstance) SetState(s string) {
+	si.State = s
+}
+
+// GetAction : returns the action of the component
+func (si *SQLInstance) GetAction() string {
+	return si.Action
+}
+
+// SetAction : Sets the action of the component
+func (si *SQLInstance) SetAction(s string) {
+	si.Action = s
+}
+
+// GetGroup : returns the components group
+func (si *SQLInstance) GetGroup() string {
+	return ""
+}
+
+// GetTags returns a components labels
+func (si *SQLInstance) GetTags() map[string]string {
+	return si.Labels
+}
+
+// GetTag returns a components label
+func (si *SQLInstance) GetTag(tag string) string {
+	return si.Labels[tag]
+}
+
+// Diff : diff's the component against another component of the same type
+func (si *SQLInstance) Diff(c graph.Component) bool {
+	csi, ok := c.(*SQLInstance)
+	if ok {
+		if si.Tier != csi.Tier || si.AvailabilityType != csi.AvailabilityType {
+			return true
+		}
+
+		if si.DiskSize != nil && csi.DiskSize != nil {
+			if *si.DiskSize != *csi.DiskSize {
+				return true
+			}
+		}
+
+		if si.DiskAutoresize != csi.DiskAutoresize || si.PublicIP != csi.PublicIP {
+			return true
+		}
+
+		if si.BackupEnabled != csi.BackupEnabled || si.BackupStartTime != csi.BackupStartTime {
+			return true
+		}
+
+		if reflect.DeepEqual(si.MaintenanceDay, csi.MaintenanceDay) != true || reflect.DeepEqual(si.MaintenanceHour, csi.MaintenanceHour) != true {
+			return true
+		}
+
+		if reflect.DeepEqual(si.AuthorizedNetworks, csi.AuthorizedNetworks) != true {
+			return true
+		}
+
+		if reflect.DeepEqual(si.Databases, csi.Databases) != true || reflect.DeepEqual(si.Users, csi.Users) != true {
+			return true
+		}
+
+		if si.DeletionProtection != csi.DeletionProtection {
+			return true
+		}
+
+		return !reflect.DeepEqual(si.Labels, csi.Labels)
+	}
+
+	return false
+}
+
+// Update : updates the provider returned values of a component
+func (si *SQLInstance) Update(c graph.Component) {
+	csi, ok := c.(*SQLInstance)
+	if ok {
+		si.SQLInstanceID = csi.SQLInstanceID
+		si.ConnectionName = csi.ConnectionName
+		si.IPAddress = csi.IPAddress
+	}
+
+	si.SetDefaultVariables()
+}
+
+// Rebuild : rebuilds the component's internal state, such as templated values
+func (si *SQLInstance) Rebuild(g *graph.Graph) {
+	if si.Network == "" && si.NetworkID != "" {
+		nw := g.GetComponents().ByProviderID(si.NetworkID)
+		if nw != nil {
+			si.Network = nw.GetName()
+		}
+	}
+
+	if si.Network != "" && si.NetworkID == "" {
+		si.NetworkID = templNetworkID(si.Network)
+	}
+
+	if si.Region == "" {
+		si.Region = DATACENTERREGION
+	}
+
+	if si.DiskType == "" {
+		si.DiskType = "PD_SSD"
+	}
+
+	if si.AvailabilityType == "" {
+		si.AvailabilityType = "ZONAL"
+	}
+
+	si.SetDefaultVariables()
+}
+
+// Dependencies : returns a list of component id's upon which the component depends
+func (si *SQLInstance) Dependencies() []string {
+	var deps []string
+
+	if si.Network != "" {
+		deps = append(deps, TYPENETWORK+TYPEDELIMITER+si.Network)
+	}
+
+	return deps
+}
+
+// Validate : validates the components values
+func (si *SQLInstance) Validate() error {
+	err := validateName(si.Name, "SQL Instance")
+	if err != nil {
+		return err
+	}
+
+	if strings.HasPrefix(si.DatabaseVersion, "MYSQL_") != true && strings.HasPrefix(si.DatabaseVersion, "POSTGRES_") != true && strings.HasPrefix(si.DatabaseVersion, "SQLSERVER_") != true {
+		return errors.New("SQL Instance database version should be a valid mysql, postgres or sqlserver version, i.e. 'POSTGRES_13'")
+	}
+
+	if si.Tier == "" {
+		return errors.New("SQL Instance tier should not be null")
+	}
+
+	if si.DiskType != "PD_SSD" && si.DiskType != "PD_HDD" {
+		return errors.New("SQL Instance disk type should be one of PD_SSD or PD_HDD")
+	}
+
+	if si.DiskSize != nil {
+		if *si.DiskSize < 10 || *si.DiskSize > 65536 {
+			return errors.New("SQL Instance disk size should be between 10 and 65536 GB")
+		}
+	}
+
+	if si.AvailabilityType != "ZONAL" && si.AvailabilityType != "REGIONAL" {
+		return errors.New("SQL Instance availability type should be one of ZONAL or REGIONAL")
+	}
+
+	if si.AvailabilityType == "REGIONAL" && si.BackupEnabled != true {
+		return errors.New("SQL Instance with regional availability should have backups enabled")
+	}
+
+	if si.PublicIP != true && si.Network == "" {
+		return errors.New("SQL Instance should either enable a public ip or specify a private network")
+	}
+
+	if si.PublicIP != true && len(si.AuthorizedNetworks) > 0 {
+		return errors.New("SQL Instance should only specify authorized networks when a public ip is enabled")
+	}
+
+	for _, an := range si.AuthorizedNetworks {
+		_, _, err := net.ParseCIDR(an.Value)
+		if err != nil {
+			return fmt.Errorf("SQL Instance authorized network (%s) is not a valid CIDR", an.Value)
+		}
+	}
+
+	if si.BackupStartTime != "" {
+		parts := strings.Split(si.BackupStartTime, ":")
+		if len(parts) != 2 || len(parts[0]) != 2 || len(parts[1]) != 2 || parts[0] > "23" || parts[1] > "59" {
+			return errors.New("SQL Instance backup start time should be in the format 'HH:MM'")
+		}
+	}
+
+	if si.MaintenanceDay != nil {
+		if *si.MaintenanceDay < 1 || *si.MaintenanceDay > 7 {
+			return errors.New("SQL Instance maintenance day should be between 1 (monday) and 7 (sunday)")
+		}
+	}
+
+	if si.MaintenanceHour != nil {
+		if *si.MaintenanceHour < 0 || *si.MaintenanceHour > 23 {
+			return errors.New("SQL Instance maintenance hour should be between 0 and 23")
+		}
+	}
+
+	for _, db := range si.Databases {
+		if db == "" || len(db) > 64 {
+			return errors.New("SQL Instance database names should be between 1 and 64 characters")
+		}
+	}
+
+	for _, u := range si.Users {
+		if u.Name == "" {
+			return errors.New("SQL Instance user name should not be null")
+		}
+
+		if u.Password == "" {
+			return fmt.Errorf("SQL Instance user (%s) password should not be null", u.Name)
+		}
+	}
+
+	return validateLabels(si.Labels, "SQL Instance")
+}
+
+// IsStateful : returns true if the component needs to be actioned to be removed.
+func (si *SQLInstance) IsStateful() bool {
+	return true
+}
+
+// SetDefaultVariables : sets up the default template variables for a component
+func (si *SQLInstance) SetDefaultVariables() {
+	si.ComponentType = TYPESQLINSTANCE
+	si.ComponentID = TYPESQLINSTANCE + TYPEDELIMITER + si.Name
+	si.ProviderType = PROVIDERTYPE
+	si.DatacenterName = DATACENTERNAME
+	si.DatacenterType = DATACENTERTYPE
+	si.DatacenterRegion = DATACENTERREGION
+	si.ProjectID = PROJECTID
+	si.Credentials = CREDENTIALS
+}
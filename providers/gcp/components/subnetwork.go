@@ -0,0 +1,223 @@
+/* This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at http://mozilla.org/MPL/2.0/. */
+
+package components
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"reflect"
+
+	graph "gopkg.in/r3labs/graph.v2"
+)
+
+// SecondaryRange : a named secondary ip range on a subnetwork
+type SecondaryRange struct {
+	Name        string `json:"name"`
+	IPCIDRRange string `json:"ip_cidr_range"`
+}
+
+// Subnetwork : Mapping of a subnetwork component
+type Subnetwork struct {
+	ProviderType        string            `json:"_provider"`
+	ComponentType       string            `json:"_component"`
+	ComponentID         string            `json:"_component_id"`
+	State               string            `json:"_state"`
+	Action              string            `json:"_action"`
+	SubnetworkID        string            `json:"subnetwork_id"`
+	Name                string            `json:"name"`
+	Network             string            `json:"network"`
+	NetworkID           string            `json:"network_id"`
+	Region              string            `json:"region"`
+	IPCIDRRange         string            `json:"ip_cidr_range"`
+	PrivateGoogleAccess bool              `json:"private_ip_google_access"`
+	SecondaryRanges     []SecondaryRange  `json:"secondary_ip_ranges"`
+	Labels              map[string]string `json:"labels"`
+	DatacenterType      string            `json:"datacenter_type,omitempty"`
+	DatacenterName      string            `json:"datacenter_name,omitempty"`
+	DatacenterRegion    string            `json:"datacenter_region"`
+	ProjectID           string            `json:"project_id"`
+	Credentials         string            `json:"credentials"`
+	Service             string            `json:"service"`
+}
+
+// GetID : returns the component's ID
+func (sn *Subnetwork) GetID() string {
+	return sn.ComponentID
+}
+
+// GetName returns a components name
+func (sn *Subnetwork) GetName() string {
+	return sn.Name
+}
+
+// GetProvider : returns the provider type
+func (sn *Subnetwork) GetProvider() string {
+	return sn.ProviderType
+}
+
+// GetProviderID returns a components provider id
+func (sn *Subnetwork) GetProviderID() string {
+	return sn.SubnetworkID
+}
+
+// GetType : returns the type of the component
+func (sn *Subnetwork) GetType() string {
+	return sn.ComponentType
+}
+
+// GetState : returns the state of the component
+func (sn *Subnetwork) GetState() string {
+	return sn.State
+}
+
+// SetState : sets the state of the component
+func (sn *Subnetwork) SetState(s string) {
+	sn.State = s
+}
+
+// GetAction : returns the action of the component
+func (sn *Subnetwork) GetAction() string {
+	return sn.Action
+}
+
+// SetAction : Sets the action of the component
+func (sn *Subnetwork) SetAction(s string) {
+	sn.Action = s
+}
+
+// GetGroup : returns the components group
+func (sn *Subnetwork) GetGroup() string {
+	return ""
+}
+
+// GetTags returns a components labels
+func (sn *Subnetwork) GetTags() map[string]string {
+	return sn.Labels
+}
+
+// GetTag returns a components label
+func (sn *Subnetwork) GetTag(tag string) string {
+	return sn.Labels[tag]
+}
+
+// Diff : diff's the component against another component of the same type.
+// Only the private google access setting and secondary ranges can be changed
+// in place, any other change requires the subnetwork to be recreated
+func (sn *Subnetwork) Diff(c graph.Component) bool {
+	cs, ok := c.(*Subnetwork)
+	if ok {
+		if sn.PrivateGoogleAccess != cs.PrivateGoogleAccess {
+			return true
+		}
+
+		if reflect.DeepEqual(sn.SecondaryRanges, cs.SecondaryRanges) != true {
+			return true
+		}
+
+		return !reflect.DeepEqual(sn.Labels, cs.Labels)
+	}
+
+	return false
+}
+
+// Update : updates the provider returned values of a component
+func (sn *Subnetwork) Update(c graph.Component) {
+	cs, ok := c.(*Subnetwork)
+	if ok {
+		sn.SubnetworkID = cs.SubnetworkID
+	}
+
+	sn.SetDefaultVariables()
+}
+
+// Rebuild : rebuilds the component's internal state, such as templated values
+func (sn *Subnetwork) Rebuild(g *graph.Graph) {
+	if sn.Network == "" && sn.NetworkID != "" {
+		nw := g.GetComponents().ByProviderID(sn.NetworkID)
+		if nw != nil {
+			sn.Network = nw.GetName()
+		}
+	}
+
+	if sn.Network != "" && sn.NetworkID == "" {
+		sn.NetworkID = templNetworkID(sn.Network)
+	}
+
+	if sn.Region == "" {
+		sn.Region = DATACENTERREGION
+	}
+
+	sn.SetDefaultVariables()
+}
+
+// Dependencies : returns a list of component id's upon which the component depends
+func (sn *Subnetwork) Dependencies() []string {
+	return []string{TYPENETWORK + TYPEDELIMITER + sn.Network}
+}
+
+// Validate : validates the components values
+func (sn *Subnetwork) Validate() error {
+	err := validateName(sn.Name, "Subnetwork")
+	if err != nil {
+		return err
+	}
+
+	if sn.Network == "" {
+		return errors.New("Subnetwork network should not be null")
+	}
+
+	_, cidr, err := net.ParseCIDR(sn.IPCIDRRange)
+	if err != nil || cidr.IP.To4() == nil {
+		return errors.New("Subnetwork ip cidr range is not a valid ipv4 CIDR")
+	}
+
+	if ones, _ := cidr.Mask.Size(); ones > 29 {
+		return errors.New("Subnetwork ip cidr range should be at least a /29")
+	}
+
+	var names []string
+
+	for _, sr := range sn.SecondaryRanges {
+		err := validateName(sr.Name, "Subnetwork secondary range")
+		if err != nil {
+			return err
+		}
+
+		if isOneOf(names, sr.Name) {
+			return fmt.Errorf("Subnetwork secondary range (%s) is specified more than once", sr.Name)
+		}
+
+		names = append(names, sr.Name)
+
+		_, scidr, err := net.ParseCIDR(sr.IPCIDRRange)
+		if err != nil || scidr.IP.To4() == nil {
+			return fmt.Errorf("Subnetwork secondary range (%s) is not a valid ipv4 CIDR", sr.Name)
+		}
+
+		if scidr.Contains(cidr.IP) || cidr.Contains(scidr.IP) {
+			return fmt.Errorf("Subnetwork secondary range (%s) should not overlap the primary range", sr.Name)
+		}
+	}
+
+	return validateLabels(sn.Labels, "Subnetwork")
+}
+
+// IsStateful : returns true if the component needs to be actioned to be removed.
+func (sn *Subnetwork) IsStateful() bool {
+	return true
+}
+
+// SetDefaultVariables : sets up the default template variables for a component
+func (sn *Subnetwork) SetDefaultVariables() {
+	sn.ComponentType = TYPESUBNETWORK
+	sn.ComponentID = TYPESUBNETWORK + TYPEDELIMITER + sn.Name
+	sn.ProviderType = PROVIDERTYPE
+	sn.DatacenterName = DATACENTERNAME
+	sn.DatacenterType = DATACENTERTYPE
+	sn.DatacenterRegion = DATACENTERREGION
+	sn.ProjectID = PROJECTID
+	sn.Credentials = CREDENTIALS
+}
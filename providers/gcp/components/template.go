@@ -0,0 +1,55 @@
+/* This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at http://mozilla.org/MPL/2.0/. */
+
+package components
+
+const (
+	TYPEDELIMITER      = "::"
+	TYPENETWORK        = "network"
+	TYPESUBNETWORK     = "subnetwork"
+	TYPEFIREWALL       = "firewall"
+	TYPEDISK           = "disk"
+	TYPEINSTANCE       = "instance"
+	TYPEINSTANCEGROUP  = "instance_group"
+	TYPEBACKENDSERVICE = "backend_service"
+	TYPEFORWARDINGRULE = "forwarding_rule"
+	TYPESQLINSTANCE    = "sql_instance"
+
+	// labels only allow lowercase letters, numbers, underscores and dashes,
+	// so the ernest tags used by other providers are dash separated
+	LABELSERVICE       = "ernest-service"
+	LABELINSTANCEGROUP = "ernest-instance-group"
+	LABELDISK          = "ernest-disk"
+
+	PROVIDERTYPE     = `$(components.#[_component_id="credentials::gcp"]._provider)`
+	DATACENTERNAME   = `$(components.#[_component_id="credentials::gcp"].name)`
+	DATACENTERTYPE   = `$(components.#[_component_id="credentials::gcp"]._provider)`
+	DATACENTERREGION = `$(components.#[_component_id="credentials::gcp"].region)`
+	PROJECTID        = `$(components.#[_component_id="credentials::gcp"].project_id)`
+	CREDENTIALS      = `$(components.#[_component_id="credentials::gcp"].credentials)`
+)
+
+func templNetworkID(nw string) string {
+	return `$(components.#[_component_id="` + "network::" + nw + `"].network_id)`
+}
+
+func templSubnetworkID(sn string) string {
+	return `$(components.#[_component_id="` + "subnetwork::" + sn + `"].subnetwork_id)`
+}
+
+func templDiskID(disk string) string {
+	return `$(components.#[_component_id="` + "disk::" + disk + `"].disk_id)`
+}
+
+func templInstanceID(in string) string {
+	return `$(components.#[_component_id="` + "instance::" + in + `"].instance_id)`
+}
+
+func templInstanceGroupID(ig string) string {
+	return `$(components.#[_component_id="` + "instance_group::" + ig + `"].instance_group_id)`
+}
+
+func templBackendServiceID(bs string) string {
+	return `$(components.#[_component_id="` + "backend_service::" + bs + `"].backend_service_id)`
+}
@@ -0,0 +1,90 @@
+/* This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at http://mozilla.org/MPL/2.0/. */
+
+package components
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// validateName : gcp resource names must match [a-z]([-a-z0-9]*[a-z0-9])?
+func validateName(name, ctype string) error {
+	if name == "" {
+		return fmt.Errorf("%s name should not be null", ctype)
+	}
+
+	if len(name) > 63 {
+		return fmt.Errorf("%s name should not exceed 63 characters", ctype)
+	}
+
+	if unicode.IsLower(rune(name[0])) != true || strings.HasSuffix(name, "-") {
+		return fmt.Errorf("%s name should start with a lowercase letter and not end with a hyphen", ctype)
+	}
+
+	for _, c := range name {
+		if (c < 'a' || c > 'z') && unicode.IsDigit(c) != true && c != '-' {
+			return fmt.Errorf("%s name can only contain lowercase letters, numbers and hyphens", ctype)
+		}
+	}
+
+	return nil
+}
+
+// validateLabels : label keys and values are limited to 63 lowercase characters
+func validateLabels(labels map[string]string, ctype string) error {
+	if len(labels) > 64 {
+		return fmt.Errorf("%s should not specify more than 64 labels", ctype)
+	}
+
+	for k, v := range labels {
+		if k == "" || len(k) > 63 || len(v) > 63 {
+			return fmt.Errorf("%s label (%s) keys and values should not exceed 63 characters", ctype, k)
+		}
+
+		if strings.ToLower(k) != k || strings.ToLower(v) != v {
+			return fmt.Errorf("%s label (%s) keys and values should be lowercase", ctype, k)
+		}
+	}
+
+	return nil
+}
+
+// validatePortRange : checks a port or a range of ports, i.e. '8000-8080'
+func validatePortRange(ports string) error {
+	parts := strings.Split(ports, "-")
+	if len(parts) > 2 {
+		return errors.New("Port range should take the form of 'port' or 'from-to'")
+	}
+
+	for _, p := range parts {
+		x, err := strconv.Atoi(p)
+		if err != nil || x < 1 || x > 65535 {
+			return fmt.Errorf("Port (%s) is out of range [1 - 65535]", ports)
+		}
+	}
+
+	return nil
+}
+
+func appendUnique(s []string, v string) []string {
+	for _, x := range s {
+		if x == v {
+			return s
+		}
+	}
+	return append(s, v)
+}
+
+func isOneOf(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
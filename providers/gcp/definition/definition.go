@@ -0,0 +1,48 @@
+/* This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at http://mozilla.org/MPL/2.0/. */
+
+package definition
+
+import (
+	"encoding/json"
+
+	"github.com/mitchellh/mapstructure"
+)
+
+// Definition ...
+type Definition struct {
+	Name          string         `json:"name"`
+	Datacenter    string         `json:"datacenter"`
+	Networks      []Network      `json:"networks,omitempty"`
+	Firewalls     []Firewall     `json:"firewalls,omitempty"`
+	Instances     []Instance     `json:"instances,omitempty"`
+	LoadBalancers []LoadBalancer `json:"load_balancers,omitempty"`
+	SQLInstances  []SQLInstance  `json:"sql_instances,omitempty"`
+}
+
+// New returns a new Definition
+func New() *Definition {
+	return &Definition{}
+}
+
+// LoadJSON unmarshals raw json data onto the defintion
+func (d *Definition) LoadJSON(data []byte) error {
+	return json.Unmarshal(data, d)
+}
+
+// LoadMap converts a generic definition from a map[string]interface into a gcp definition
+func (d *Definition) LoadMap(i map[string]interface{}) error {
+	config := &mapstructure.DecoderConfig{
+		Metadata: nil,
+		Result:   d,
+		TagName:  "json",
+	}
+
+	decoder, err := mapstructure.NewDecoder(config)
+	if err != nil {
+		return err
+	}
+
+	return decoder.Decode(i)
+}
@@ -0,0 +1,25 @@
+/* This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at http://mozilla.org/MPL/2.0/. */
+
+package definition
+
+// FirewallRule ...
+type FirewallRule struct {
+	Protocol string   `json:"protocol"`
+	Ports    []string `json:"ports"`
+}
+
+// Firewall ...
+type Firewall struct {
+	Name              string         `json:"name"`
+	Network           string         `json:"network"`
+	Direction         string         `json:"direction"`
+	Priority          *int64         `json:"priority"`
+	Allow             []FirewallRule `json:"allow"`
+	Deny              []FirewallRule `json:"deny"`
+	SourceRanges      []string       `json:"source_ranges"`
+	DestinationRanges []string       `json:"destination_ranges"`
+	SourceTags        []string       `json:"source_tags"`
+	TargetTags        []string       `json:"target_tags"`
+}
@@ -0,0 +1,35 @@
+/* This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at http://mozilla.org/MPL/2.0/. */
+
+package definition
+
+// InstanceBootDisk ...
+type InstanceBootDisk struct {
+	Type string `json:"type"`
+	Size *int64 `json:"size"`
+}
+
+// InstanceDisk ...
+type InstanceDisk struct {
+	Name       string `json:"name"`
+	Type       string `json:"type"`
+	Size       *int64 `json:"size"`
+	DeviceName string `json:"device_name"`
+}
+
+// Instance ...
+type Instance struct {
+	Name        string            `json:"name"`
+	MachineType string            `json:"machine_type"`
+	Image       string            `json:"image"`
+	Zone        string            `json:"zone"`
+	Count       int               `json:"count"`
+	Subnetwork  string            `json:"subnetwork"`
+	StartIP     string            `json:"start_ip"`
+	PublicIP    bool              `json:"public_ip"`
+	NetworkTags []string          `json:"network_tags"`
+	Metadata    map[string]string `json:"metadata"`
+	BootDisk    InstanceBootDisk  `json:"boot_disk"`
+	Disks       []InstanceDisk    `json:"disks"`
+}
@@ -0,0 +1,30 @@
+/* This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at http://mozilla.org/MPL/2.0/. */
+
+package definition
+
+// HealthCheck ...
+type HealthCheck struct {
+	Protocol           string `json:"protocol"`
+	Port               int64  `json:"port"`
+	RequestPath        string `json:"request_path"`
+	Interval           int64  `json:"interval"`
+	Timeout            int64  `json:"timeout"`
+	HealthyThreshold   int64  `json:"healthy_threshold"`
+	UnhealthyThreshold int64  `json:"unhealthy_threshold"`
+}
+
+// LoadBalancer ...
+type LoadBalancer struct {
+	Name            string      `json:"name"`
+	Scheme          string      `json:"scheme"`
+	Protocol        string      `json:"protocol"`
+	Subnetwork      string      `json:"subnetwork"`
+	PortRange       string      `json:"port_range"`
+	Ports           []string    `json:"ports"`
+	BackendPort     int64       `json:"backend_port"`
+	SessionAffinity string      `json:"session_affinity"`
+	Instances       []string    `json:"instances"`
+	HealthCheck     HealthCheck `json:"health_check"`
+}
@@ -0,0 +1,26 @@
+/* This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at http://mozilla.org/MPL/2.0/. */
+
+package definition
+
+// SecondaryRange ...
+type SecondaryRange struct {
+	Name  string `json:"name"`
+	Range string `json:"range"`
+}
+
+// Subnetwork ...
+type Subnetwork struct {
+	Name                string           `json:"name"`
+	Range               string           `json:"range"`
+	PrivateGoogleAccess bool             `json:"private_google_access"`
+	SecondaryRanges     []SecondaryRange `json:"secondary_ranges"`
+}
+
+// Network ...
+type Network struct {
+	Name        string       `json:"name"`
+	RoutingMode string       `json:"routing_mode"`
+	Subnetworks []Subnetwork `json:"subnetworks"`
+}
@@ -0,0 +1,48 @@
+/* This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at http://mozilla.org/MPL/2.0/. */
+
+package definition
+
+// SQLBackup ...
+type SQLBackup struct {
+	Enabled   bool   `json:"enabled"`
+	StartTime string `json:"start_time"`
+}
+
+// SQLMaintenance ...
+type SQLMaintenance struct {
+	Day  *int64 `json:"day"`
+	Hour *int64 `json:"hour"`
+}
+
+// SQLAuthorizedNetwork ...
+type SQLAuthorizedNetwork struct {
+	Name  string `json:"name"`
+	Range string `json:"range"`
+}
+
+// SQLUser ...
+type SQLUser struct {
+	Name     string `json:"name"`
+	Password string `json:"password"`
+}
+
+// SQLInstance ...
+type SQLInstance struct {
+	Name               string                 `json:"name"`
+	DatabaseVersion    string                 `json:"database_version"`
+	Tier               string                 `json:"tier"`
+	DiskSize           *int64                 `json:"disk_size"`
+	DiskType           string                 `json:"disk_type"`
+	DiskAutoresize     bool                   `json:"disk_autoresize"`
+	HighlyAvailable    bool                   `json:"highly_available"`
+	Network            string                 `json:"network"`
+	PublicIP           bool                   `json:"public_ip"`
+	AuthorizedNetworks []SQLAuthorizedNetwork `json:"authorized_networks"`
+	Backups            SQLBackup              `json:"backups"`
+	Maintenance        SQLMaintenance         `json:"maintenance"`
+	Databases          []string               `json:"databases"`
+	Users              []SQLUser              `json:"users"`
+	DeletionProtection bool                   `json:"deletion_protection"`
+}
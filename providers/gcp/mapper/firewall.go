@@ -0,0 +1,88 @@
+/* This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at http://mozilla.org/MPL/2.0/. */
+
+package mapper
+
+import (
+	"github.com/ernestio/libmapper/providers/gcp/components"
+	"github.com/ernestio/libmapper/providers/gcp/definition"
+	graph "gopkg.in/r3labs/graph.v2"
+)
+
+// MapFirewalls : Maps the firewall rules from a given input payload.
+func MapFirewalls(d *definition.Definition) []*components.Firewall {
+	var firewalls []*components.Firewall
+
+	for _, firewall := range d.Firewalls {
+		f := &components.Firewall{
+			Name:              firewall.Name,
+			Network:           firewall.Network,
+			Direction:         firewall.Direction,
+			Priority:          firewall.Priority,
+			SourceRanges:      firewall.SourceRanges,
+			DestinationRanges: firewall.DestinationRanges,
+			SourceTags:        firewall.SourceTags,
+			TargetTags:        firewall.TargetTags,
+			Labels:            mapLabels(d.Name),
+		}
+
+		for _, r := range firewall.Allow {
+			f.Allow = append(f.Allow, components.FirewallRule{
+				Protocol: r.Protocol,
+				Ports:    r.Ports,
+			})
+		}
+
+		for _, r := range firewall.Deny {
+			f.Deny = append(f.Deny, components.FirewallRule{
+				Protocol: r.Protocol,
+				Ports:    r.Ports,
+			})
+		}
+
+		f.SetDefaultVariables()
+
+		firewalls = append(firewalls, f)
+	}
+
+	return firewalls
+}
+
+// MapDefinitionFirewalls : Maps components firewalls into a definition defined firewalls
+func MapDefinitionFirewalls(g *graph.Graph) []definition.Firewall {
+	var firewalls []definition.Firewall
+
+	for _, c := range g.GetComponents().ByType("firewall") {
+		f := c.(*components.Firewall)
+
+		firewall := definition.Firewall{
+			Name:              f.Name,
+			Network:           f.Network,
+			Direction:         f.Direction,
+			Priority:          f.Priority,
+			SourceRanges:      f.SourceRanges,
+			DestinationRanges: f.DestinationRanges,
+			SourceTags:        f.SourceTags,
+			TargetTags:        f.TargetTags,
+		}
+
+		for _, r := range f.Allow {
+			firewall.Allow = append(firewall.Allow, definition.FirewallRule{
+				Protocol: r.Protocol,
+				Ports:    r.Ports,
+			})
+		}
+
+		for _, r := range f.Deny {
+			firewall.Deny = append(firewall.Deny, definition.FirewallRule{
+				Protocol: r.Protocol,
+				Ports:    r.Ports,
+			})
+		}
+
+		firewalls = append(firewalls, firewall)
+	}
+
+	return firewalls
+}
@@ -0,0 +1,199 @@
+/* This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at http://mozilla.org/MPL/2.0/. */
+
+package mapper
+
+import (
+	"net"
+	"strconv"
+
+	"github.com/ernestio/libmapper/providers/gcp/components"
+	"github.com/ernestio/libmapper/providers/gcp/definition"
+	graph "gopkg.in/r3labs/graph.v2"
+)
+
+// MapInstances : Maps the compute instances from a given input payload.
+func MapInstances(d *definition.Definition) []*components.Instance {
+	var is []*components.Instance
+
+	for _, instance := range d.Instances {
+		var ip net.IP
+
+		if instance.StartIP != "" {
+			ip = make(net.IP, net.IPv4len)
+			copy(ip, net.ParseIP(instance.StartIP).To4())
+		}
+
+		for i := 0; i < instance.Count; i++ {
+			name := instance.Name + "-" + strconv.Itoa(i+1)
+
+			ci := &components.Instance{
+				Name:           name,
+				MachineType:    instance.MachineType,
+				Zone:           instance.Zone,
+				Image:          instance.Image,
+				Subnetwork:     instance.Subnetwork,
+				AssignPublicIP: instance.PublicIP,
+				NetworkTags:    instance.NetworkTags,
+				Metadata:       instance.Metadata,
+				BootDisk: components.InstanceBootDisk{
+					Type: instance.BootDisk.Type,
+					Size: instance.BootDisk.Size,
+				},
+				Labels: mapInstanceLabels(d.Name, instance.Name),
+			}
+
+			if ip != nil {
+				ci.IP = ip.String()
+
+				// Increment IP address
+				ip[3]++
+			}
+
+			for _, disk := range instance.Disks {
+				ci.Disks = append(ci.Disks, components.InstanceDisk{
+					Disk:       name + "-" + disk.Name,
+					DeviceName: disk.DeviceName,
+				})
+			}
+
+			ci.SetDefaultVariables()
+
+			is = append(is, ci)
+		}
+	}
+
+	return is
+}
+
+// MapDisks : Maps the persistent disks attached to each instance from a given input payload.
+func MapDisks(d *definition.Definition) []*components.Disk {
+	var disks []*components.Disk
+
+	for _, instance := range d.Instances {
+		for i := 0; i < instance.Count; i++ {
+			for _, disk := range instance.Disks {
+				cd := &components.Disk{
+					Name:   instance.Name + "-" + strconv.Itoa(i+1) + "-" + disk.Name,
+					Zone:   instance.Zone,
+					Type:   disk.Type,
+					Size:   disk.Size,
+					Labels: mapDiskLabels(d.Name, disk.Name),
+				}
+
+				cd.SetDefaultVariables()
+
+				disks = append(disks, cd)
+			}
+		}
+	}
+
+	return disks
+}
+
+// MapInstanceGroups : Maps an unmanaged instance group for each group of instances,
+// exposing a named port for every load balancer that serves it
+func MapInstanceGroups(d *definition.Definition) []*components.InstanceGroup {
+	var igs []*components.InstanceGroup
+
+	for _, instance := range d.Instances {
+		ig := &components.InstanceGroup{
+			Name:       instance.Name,
+			Zone:       instance.Zone,
+			Subnetwork: instance.Subnetwork,
+			Labels:     mapLabels(d.Name),
+		}
+
+		for i := 0; i < instance.Count; i++ {
+			ig.Instances = append(ig.Instances, instance.Name+"-"+strconv.Itoa(i+1))
+		}
+
+		for _, lb := range d.LoadBalancers {
+			for _, in := range lb.Instances {
+				if in == instance.Name && lb.BackendPort > 0 {
+					ig.NamedPorts = append(ig.NamedPorts, components.NamedPort{
+						Name: lb.Name,
+						Port: lb.BackendPort,
+					})
+				}
+			}
+		}
+
+		ig.SetDefaultVariables()
+
+		igs = append(igs, ig)
+	}
+
+	return igs
+}
+
+// MapDefinitionInstances : Maps output instances into a definition defined instances
+func MapDefinitionInstances(g *graph.Graph) []definition.Instance {
+	var instances []definition.Instance
+
+	ci := g.GetComponents().ByType("instance")
+
+	for _, ig := range ci.TagValues(components.LABELINSTANCEGROUP) {
+		is := ci.ByGroup(components.LABELINSTANCEGROUP, ig)
+
+		if len(is) < 1 {
+			continue
+		}
+
+		firstInstance := is[0].(*components.Instance)
+
+		instance := definition.Instance{
+			Name:        ig,
+			MachineType: firstInstance.MachineType,
+			Image:       firstInstance.Image,
+			Zone:        firstInstance.Zone,
+			Count:       len(is),
+			Subnetwork:  firstInstance.Subnetwork,
+			StartIP:     firstInstance.IP,
+			PublicIP:    firstInstance.AssignPublicIP,
+			NetworkTags: firstInstance.NetworkTags,
+			Metadata:    firstInstance.Metadata,
+			BootDisk: definition.InstanceBootDisk{
+				Type: firstInstance.BootDisk.Type,
+				Size: firstInstance.BootDisk.Size,
+			},
+		}
+
+		for _, disk := range firstInstance.Disks {
+			dc := g.GetComponents().ByProviderID(disk.DiskID)
+			if dc == nil {
+				continue
+			}
+
+			cd := dc.(*components.Disk)
+
+			instance.Disks = append(instance.Disks, definition.InstanceDisk{
+				Name:       cd.GetTag(components.LABELDISK),
+				Type:       cd.Type,
+				Size:       cd.Size,
+				DeviceName: disk.DeviceName,
+			})
+		}
+
+		instances = append(instances, instance)
+	}
+
+	return instances
+}
+
+func mapInstanceLabels(service, instanceGroup string) map[string]string {
+	labels := mapLabels(service)
+
+	labels[components.LABELINSTANCEGROUP] = instanceGroup
+
+	return labels
+}
+
+func mapDiskLabels(service, disk string) map[string]string {
+	labels := mapLabels(service)
+
+	labels[components.LABELDISK] = disk
+
+	return labels
+}
@@ -0,0 +1,143 @@
+/* This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at http://mozilla.org/MPL/2.0/. */
+
+package mapper
+
+import (
+	"github.com/ernestio/libmapper/providers/gcp/components"
+	"github.com/ernestio/libmapper/providers/gcp/definition"
+	graph "gopkg.in/r3labs/graph.v2"
+)
+
+// MapBackendServices : Maps a backend service for each load balancer from a given input payload.
+func MapBackendServices(d *definition.Definition) []*components.BackendService {
+	var bss []*components.BackendService
+
+	for _, lb := range d.LoadBalancers {
+		bs := &components.BackendService{
+			Name:                lb.Name,
+			Protocol:            lb.Protocol,
+			LoadBalancingScheme: lb.Scheme,
+			SessionAffinity:     lb.SessionAffinity,
+			HealthCheck: components.HealthCheck{
+				Protocol:           lb.HealthCheck.Protocol,
+				Port:               lb.HealthCheck.Port,
+				RequestPath:        lb.HealthCheck.RequestPath,
+				CheckInterval:      lb.HealthCheck.Interval,
+				Timeout:            lb.HealthCheck.Timeout,
+				HealthyThreshold:   lb.HealthCheck.HealthyThreshold,
+				UnhealthyThreshold: lb.HealthCheck.UnhealthyThreshold,
+			},
+			Labels: mapLabels(d.Name),
+		}
+
+		if lb.BackendPort > 0 {
+			bs.PortName = lb.Name
+		}
+
+		for _, in := range lb.Instances {
+			bs.Backends = append(bs.Backends, components.Backend{
+				InstanceGroup: in,
+			})
+		}
+
+		bs.SetDefaultVariables()
+
+		bss = append(bss, bs)
+	}
+
+	return bss
+}
+
+// MapForwardingRules : Maps a forwarding rule for each load balancer from a given input payload.
+func MapForwardingRules(d *definition.Definition) []*components.ForwardingRule {
+	var frs []*components.ForwardingRule
+
+	for _, lb := range d.LoadBalancers {
+		fr := &components.ForwardingRule{
+			Name:                lb.Name,
+			PortRange:           lb.PortRange,
+			Ports:               lb.Ports,
+			LoadBalancingScheme: lb.Scheme,
+			BackendService:      lb.Name,
+			Subnetwork:          lb.Subnetwork,
+			Labels:              mapLabels(d.Name),
+		}
+
+		if lb.Protocol == "UDP" {
+			fr.IPProtocol = "UDP"
+		}
+
+		fr.SetDefaultVariables()
+
+		frs = append(frs, fr)
+	}
+
+	return frs
+}
+
+// MapDefinitionLoadBalancers : Maps forwarding rules and their backend services into a definition defined load balancers
+func MapDefinitionLoadBalancers(g *graph.Graph) []definition.LoadBalancer {
+	var lbs []definition.LoadBalancer
+
+	for _, c := range g.GetComponents().ByType("forwarding_rule") {
+		fr := c.(*components.ForwardingRule)
+
+		lb := definition.LoadBalancer{
+			Name:       fr.Name,
+			Scheme:     fr.LoadBalancingScheme,
+			Subnetwork: fr.Subnetwork,
+			PortRange:  fr.PortRange,
+			Ports:      fr.Ports,
+		}
+
+		for _, bc := range g.GetComponents().ByType("backend_service") {
+			bs := bc.(*components.BackendService)
+
+			if bs.Name != fr.BackendService {
+				continue
+			}
+
+			lb.Protocol = bs.Protocol
+			lb.SessionAffinity = bs.SessionAffinity
+			lb.HealthCheck = definition.HealthCheck{
+				Protocol:           bs.HealthCheck.Protocol,
+				Port:               bs.HealthCheck.Port,
+				RequestPath:        bs.HealthCheck.RequestPath,
+				Interval:           bs.HealthCheck.CheckInterval,
+				Timeout:            bs.HealthCheck.Timeout,
+				HealthyThreshold:   bs.HealthCheck.HealthyThreshold,
+				UnhealthyThreshold: bs.HealthCheck.UnhealthyThreshold,
+			}
+
+			for _, be := range bs.Backends {
+				lb.Instances = append(lb.Instances, be.InstanceGroup)
+				lb.BackendPort = mapNamedPort(g, be.InstanceGroup, bs.PortName)
+			}
+		}
+
+		lbs = append(lbs, lb)
+	}
+
+	return lbs
+}
+
+// mapNamedPort : returns the port an instance group exposes under a given name
+func mapNamedPort(g *graph.Graph, ig, name string) int64 {
+	for _, c := range g.GetComponents().ByType("instance_group") {
+		cig := c.(*components.InstanceGroup)
+
+		if cig.Name != ig {
+			continue
+		}
+
+		for _, np := range cig.NamedPorts {
+			if np.Name == name {
+				return np.Port
+			}
+		}
+	}
+
+	return 0
+}
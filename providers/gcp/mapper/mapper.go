@@ -0,0 +1,292 @@
+/* This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at http://mozilla.org/MPL/2.0/. */
+
+package mapper
+
+import (
+	"encoding/json"
+	"errors"
+	"strings"
+
+	"github.com/ernestio/libmapper"
+	"github.com/ernestio/libmapper/providers/gcp/components"
+	def "github.com/ernestio/libmapper/providers/gcp/definition"
+	"github.com/mitchellh/mapstructure"
+	graph "gopkg.in/r3labs/graph.v2"
+)
+
+// SUPPORTEDCOMPONENTS represents all component types supported by ernest
+var SUPPORTEDCOMPONENTS = []string{"network", "subnetwork", "firewall", "disk", "instance", "instance_group", "backend_service", "forwarding_rule", "sql_instance"}
+
+// Mapper : implements the generic mapper structure
+type Mapper struct{}
+
+// New : returns a new gcp mapper
+func New() libmapper.Mapper {
+	return &Mapper{}
+}
+
+// ConvertDefinition : converts the input yaml definition to a graph format
+func (m Mapper) ConvertDefinition(gd libmapper.Definition) (*graph.Graph, error) {
+	g := graph.New()
+
+	d, ok := gd.(*def.Definition)
+	if ok != true {
+		return g, errors.New("Could not convert generic definition into gcp format")
+	}
+
+	// Map basic component values from definition
+	err := mapComponents(d, g)
+	if err != nil {
+		return g, err
+	}
+
+	for _, c := range g.Components {
+		// Build internal & template values
+		for _, dep := range c.Dependencies() {
+			if g.HasComponent(dep) != true {
+				return g, errors.New("Could not resolve component dependency: " + dep)
+			}
+		}
+
+		c.Rebuild(g)
+
+		// Validate Components
+		err := c.Validate()
+		if err != nil {
+			return g, err
+		}
+
+		// Build dependencies
+		for _, dep := range c.Dependencies() {
+			g.Connect(dep, c.GetID())
+		}
+	}
+
+	// Ensure the graph can be built
+	return g, libmapper.CheckCycles(g)
+}
+
+// ConvertGraph : converts the service graph into an input yaml format
+func (m Mapper) ConvertGraph(g *graph.Graph) (libmapper.Definition, error) {
+	var d def.Definition
+
+	for _, c := range g.Components {
+		c.Rebuild(g)
+
+		for _, dep := range c.Dependencies() {
+			if g.HasComponent(dep) != true {
+				return &d, errors.New("Could not resolve component dependency: " + dep)
+			}
+		}
+
+		err := c.Validate()
+		if err != nil {
+			return &d, err
+		}
+	}
+
+	d.Networks = MapDefinitionNetworks(g)
+	d.Firewalls = MapDefinitionFirewalls(g)
+	d.Instances = MapDefinitionInstances(g)
+	d.LoadBalancers = MapDefinitionLoadBalancers(g)
+	d.SQLInstances = MapDefinitionSQLInstances(g)
+
+	return &d, nil
+}
+
+// LoadDefinition : returns a gcp type definition
+func (m Mapper) LoadDefinition(gd map[string]interface{}) (libmapper.Definition, error) {
+	var d def.Definition
+
+	err := d.LoadMap(gd)
+
+	return &d, err
+}
+
+// LoadGraph : returns a generic interal graph
+func (m Mapper) LoadGraph(gg map[string]interface{}) (*graph.Graph, error) {
+	g := graph.New()
+
+	g.Load(gg)
+
+	for i := 0; i < len(g.Components); i++ {
+		gc := g.Components[i].(*graph.GenericComponent)
+
+		var c graph.Component
+
+		switch gc.GetType() {
+		case "network":
+			c = &components.Network{}
+		case "subnetwork":
+			c = &components.Subnetwork{}
+		case "firewall":
+			c = &components.Firewall{}
+		case "disk":
+			c = &components.Disk{}
+		case "instance":
+			c = &components.Instance{}
+		case "instance_group":
+			c = &components.InstanceGroup{}
+		case "backend_service":
+			c = &components.BackendService{}
+		case "forwarding_rule":
+			c = &components.ForwardingRule{}
+		case "sql_instance":
+			c = &components.SQLInstance{}
+		default:
+			continue
+		}
+
+		config := &mapstructure.DecoderConfig{
+			Metadata: nil,
+			Result:   c,
+			TagName:  "json",
+		}
+
+		decoder, err := mapstructure.NewDecoder(config)
+		if err != nil {
+			return g, err
+		}
+
+		err = decoder.Decode(gc)
+		if err != nil {
+			return g, err
+		}
+
+		g.Components[i] = c
+	}
+
+	return g, nil
+}
+
+// CreateImportGraph : creates a new graph with component queries used to import components from a provider.
+// gcp components are matched on the ernest service label set against them
+func (m Mapper) CreateImportGraph(params []string) *graph.Graph {
+	g := graph.New()
+	filter := make(map[string]string)
+
+	if len(params) > 0 {
+		filter[components.LABELSERVICE] = strings.ToLower(params[0])
+	}
+
+	for _, ctype := range SUPPORTEDCOMPONENTS {
+		q := MapQuery(ctype, filter)
+		g.AddComponent(q)
+	}
+
+	return g
+}
+
+// ProviderCredentials : maps gcp credentials to a generic component
+func (m Mapper) ProviderCredentials(details map[string]interface{}) graph.Component {
+	credentials := make(graph.GenericComponent)
+
+	credentials["_action"] = "none"
+	credentials["_component_id"] = "credentials::gcp"
+	credentials["_provider"] = details["type"]
+	credentials["name"] = details["name"]
+	credentials["region"] = details["region"]
+	credentials["credentials"] = details["credentials"]
+	credentials["project_id"] = mapProjectID(details["credentials"])
+
+	return &credentials
+}
+
+func mapComponents(d *def.Definition, g *graph.Graph) error {
+	// Map basic component values from definition
+
+	for _, network := range MapNetworks(d) {
+		err := g.AddComponent(network)
+		if err != nil {
+			return err
+		}
+	}
+
+	for _, subnetwork := range MapSubnetworks(d) {
+		err := g.AddComponent(subnetwork)
+		if err != nil {
+			return err
+		}
+	}
+
+	for _, firewall := range MapFirewalls(d) {
+		err := g.AddComponent(firewall)
+		if err != nil {
+			return err
+		}
+	}
+
+	for _, disk := range MapDisks(d) {
+		err := g.AddComponent(disk)
+		if err != nil {
+			return err
+		}
+	}
+
+	for _, instance := range MapInstances(d) {
+		err := g.AddComponent(instance)
+		if err != nil {
+			return err
+		}
+	}
+
+	for _, ig := range MapInstanceGroups(d) {
+		err := g.AddComponent(ig)
+		if err != nil {
+			return err
+		}
+	}
+
+	for _, bs := range MapBackendServices(d) {
+		err := g.AddComponent(bs)
+		if err != nil {
+			return err
+		}
+	}
+
+	for _, fr := range MapForwardingRules(d) {
+		err := g.AddComponent(fr)
+		if err != nil {
+			return err
+		}
+	}
+
+	for _, sql := range MapSQLInstances(d) {
+		err := g.AddComponent(sql)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// mapLabels : gcp label values must be lowercase
+func mapLabels(service string) map[string]string {
+	labels := make(map[string]string)
+
+	labels[components.LABELSERVICE] = strings.ToLower(service)
+
+	return labels
+}
+
+// mapProjectID : returns the project id of a service account json key
+func mapProjectID(credentials interface{}) string {
+	var key struct {
+		ProjectID string `json:"project_id"`
+	}
+
+	data, ok := credentials.(string)
+	if ok != true {
+		return ""
+	}
+
+	err := json.Unmarshal([]byte(data), &key)
+	if err != nil {
+		return ""
+	}
+
+	return key.ProjectID
+}
@@ -0,0 +1,101 @@
+/* This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at http://mozilla.org/MPL/2.0/. */
+
+package mapper
+
+import (
+	"github.com/ernestio/libmapper/providers/gcp/components"
+	"github.com/ernestio/libmapper/providers/gcp/definition"
+	graph "gopkg.in/r3labs/graph.v2"
+)
+
+// MapNetworks : Maps the vpc networks from a given input payload.
+func MapNetworks(d *definition.Definition) []*components.Network {
+	var networks []*components.Network
+
+	for _, network := range d.Networks {
+		n := &components.Network{
+			Name:        network.Name,
+			RoutingMode: network.RoutingMode,
+			Labels:      mapLabels(d.Name),
+		}
+
+		n.SetDefaultVariables()
+
+		networks = append(networks, n)
+	}
+
+	return networks
+}
+
+// MapSubnetworks : Maps the subnetworks of each vpc network from a given input payload.
+func MapSubnetworks(d *definition.Definition) []*components.Subnetwork {
+	var subnetworks []*components.Subnetwork
+
+	for _, network := range d.Networks {
+		for _, subnetwork := range network.Subnetworks {
+			sn := &components.Subnetwork{
+				Name:                subnetwork.Name,
+				Network:             network.Name,
+				IPCIDRRange:         subnetwork.Range,
+				PrivateGoogleAccess: subnetwork.PrivateGoogleAccess,
+				Labels:              mapLabels(d.Name),
+			}
+
+			for _, sr := range subnetwork.SecondaryRanges {
+				sn.SecondaryRanges = append(sn.SecondaryRanges, components.SecondaryRange{
+					Name:        sr.Name,
+					IPCIDRRange: sr.Range,
+				})
+			}
+
+			sn.SetDefaultVariables()
+
+			subnetworks = append(subnetworks, sn)
+		}
+	}
+
+	return subnetworks
+}
+
+// MapDefinitionNetworks : Maps components networks and subnetworks into a definition defined networks
+func MapDefinitionNetworks(g *graph.Graph) []definition.Network {
+	var networks []definition.Network
+
+	for _, c := range g.GetComponents().ByType("network") {
+		n := c.(*components.Network)
+
+		network := definition.Network{
+			Name:        n.Name,
+			RoutingMode: n.RoutingMode,
+		}
+
+		for _, sc := range g.GetComponents().ByType("subnetwork") {
+			sn := sc.(*components.Subnetwork)
+
+			if sn.Network != n.Name {
+				continue
+			}
+
+			subnetwork := definition.Subnetwork{
+				Name:                sn.Name,
+				Range:               sn.IPCIDRRange,
+				PrivateGoogleAccess: sn.PrivateGoogleAccess,
+			}
+
+			for _, sr := range sn.SecondaryRanges {
+				subnetwork.SecondaryRanges = append(subnetwork.SecondaryRanges, definition.SecondaryRange{
+					Name:  sr.Name,
+					Range: sr.IPCIDRRange,
+				})
+			}
+
+			network.Subnetworks = append(network.Subnetworks, subnetwork)
+		}
+
+		networks = append(networks, network)
+	}
+
+	return networks
+}
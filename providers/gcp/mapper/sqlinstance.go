@@ -0,0 +1,110 @@
+/* This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at http://mozilla.org/MPL/2.0/. */
+
+package mapper
+
+import (
+	"github.com/ernestio/libmapper/providers/gcp/components"
+	"github.com/ernestio/libmapper/providers/gcp/definition"
+	graph "gopkg.in/r3labs/graph.v2"
+)
+
+// MapSQLInstances : Maps the cloud sql instances from a given input payload.
+func MapSQLInstances(d *definition.Definition) []*components.SQLInstance {
+	var sqls []*components.SQLInstance
+
+	for _, sql := range d.SQLInstances {
+		si := &components.SQLInstance{
+			Name:               sql.Name,
+			DatabaseVersion:    sql.DatabaseVersion,
+			Tier:               sql.Tier,
+			DiskSize:           sql.DiskSize,
+			DiskType:           sql.DiskType,
+			DiskAutoresize:     sql.DiskAutoresize,
+			AvailabilityType:   "ZONAL",
+			Network:            sql.Network,
+			PublicIP:           sql.PublicIP,
+			BackupEnabled:      sql.Backups.Enabled,
+			BackupStartTime:    sql.Backups.StartTime,
+			MaintenanceDay:     sql.Maintenance.Day,
+			MaintenanceHour:    sql.Maintenance.Hour,
+			Databases:          sql.Databases,
+			DeletionProtection: sql.DeletionProtection,
+			Labels:             mapLabels(d.Name),
+		}
+
+		if sql.HighlyAvailable {
+			si.AvailabilityType = "REGIONAL"
+		}
+
+		for _, an := range sql.AuthorizedNetworks {
+			si.AuthorizedNetworks = append(si.AuthorizedNetworks, components.SQLAuthorizedNetwork{
+				Name:  an.Name,
+				Value: an.Range,
+			})
+		}
+
+		for _, u := range sql.Users {
+			si.Users = append(si.Users, components.SQLUser{
+				Name:     u.Name,
+				Password: u.Password,
+			})
+		}
+
+		si.SetDefaultVariables()
+
+		sqls = append(sqls, si)
+	}
+
+	return sqls
+}
+
+// MapDefinitionSQLInstances : Maps components sql instances into a definition defined sql instances
+func MapDefinitionSQLInstances(g *graph.Graph) []definition.SQLInstance {
+	var sqls []definition.SQLInstance
+
+	for _, c := range g.GetComponents().ByType("sql_instance") {
+		si := c.(*components.SQLInstance)
+
+		sql := definition.SQLInstance{
+			Name:            si.Name,
+			DatabaseVersion: si.DatabaseVersion,
+			Tier:            si.Tier,
+			DiskSize:        si.DiskSize,
+			DiskType:        si.DiskType,
+			DiskAutoresize:  si.DiskAutoresize,
+			HighlyAvailable: si.AvailabilityType == "REGIONAL",
+			Network:         si.Network,
+			PublicIP:        si.PublicIP,
+			Backups: definition.SQLBackup{
+				Enabled:   si.BackupEnabled,
+				StartTime: si.BackupStartTime,
+			},
+			Maintenance: definition.SQLMaintenance{
+				Day:  si.MaintenanceDay,
+				Hour: si.MaintenanceHour,
+			},
+			Databases:          si.Databases,
+			DeletionProtection: si.DeletionProtection,
+		}
+
+		for _, an := range si.AuthorizedNetworks {
+			sql.AuthorizedNetworks = append(sql.AuthorizedNetworks, definition.SQLAuthorizedNetwork{
+				Name:  an.Name,
+				Range: an.Value,
+			})
+		}
+
+		for _, u := range si.Users {
+			sql.Users = append(sql.Users, definition.SQLUser{
+				Name:     u.Name,
+				Password: u.Password,
+			})
+		}
+
+		sqls = append(sqls, sql)
+	}
+
+	return sqls
+}
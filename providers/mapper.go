@@ -6,14 +6,28 @@ package providers
 
 import (
 	"github.com/ernestio/libmapper"
+	awsfake "github.com/ernestio/libmapper/providers/aws/fake"
 	aws "github.com/ernestio/libmapper/providers/aws/mapper"
+	gcp "github.com/ernestio/libmapper/providers/gcp/mapper"
+	openstack "github.com/ernestio/libmapper/providers/openstack/mapper"
+	vcloud "github.com/ernestio/libmapper/providers/vcloud/mapper"
 )
 
-// NewMapper : Get a new mapper based on a specified type
+// NewMapper : Get a new mapper based on a specified type. Only "aws-fake" is
+// backed by a simulator, the other "-fake" types are aliases of their provider's
+// mapper, as converting definitions and graphs does not reach the provider
 func NewMapper(t string) (m libmapper.Mapper) {
 	switch t {
-	case "aws", "aws-fake":
+	case "aws":
 		m = aws.New()
+	case "aws-fake":
+		m = awsfake.New()
+	case "vcloud", "vcloud-fake":
+		m = vcloud.New()
+	case "gcp", "gcp-fake":
+		m = gcp.New()
+	case "openstack", "openstack-fake":
+		m = openstack.New()
 	}
 
 	return m
@@ -0,0 +1,185 @@
+/* This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at http://mozilla.org/MPL/2.0/. */
+
+package components
+
+import (
+	"errors"
+	"net"
+
+	graph "gopkg.in/r3labs/graph.v2"
+)
+
+// FloatingIP : mapping of a neutron floating ip component
+type FloatingIP struct {
+	ProviderType     string            `json:"_provider"`
+	ComponentType    string            `json:"_component"`
+	ComponentID      string            `json:"_component_id"`
+	State            string            `json:"_state"`
+	Action           string            `json:"_action"`
+	FloatingIPID     string            `json:"floating_ip_id"`
+	Name             string            `json:"name"`
+	Pool             string            `json:"pool"`
+	Address          string            `json:"address"`
+	Instance         string            `json:"instance,omitempty"`
+	InstanceID       string            `json:"instance_id,omitempty"`
+	FixedIP          string            `json:"fixed_ip,omitempty"`
+	Tags             map[string]string `json:"tags"`
+	DatacenterType   string            `json:"datacenter_type,omitempty"`
+	DatacenterName   string            `json:"datacenter_name,omitempty"`
+	DatacenterRegion string            `json:"datacenter_region"`
+	AuthURL          string            `json:"auth_url"`
+	ProjectName      string            `json:"project_name"`
+	DomainName       string            `json:"domain_name"`
+	Username         string            `json:"username"`
+	Password         string            `json:"password"`
+	Service          string            `json:"service"`
+}
+
+// GetID : returns the component's ID
+func (f *FloatingIP) GetID() string {
+	return f.ComponentID
+}
+
+// GetName returns a components name
+func (f *FloatingIP) GetName() string {
+	return f.Name
+}
+
+// GetProvider : returns the provider type
+func (f *FloatingIP) GetProvider() string {
+	return f.ProviderType
+}
+
+// GetProviderID returns a components provider id
+func (f *FloatingIP) GetProviderID() string {
+	return f.FloatingIPID
+}
+
+// GetType : returns the type of the component
+func (f *FloatingIP) GetType() string {
+	return f.ComponentType
+}
+
+// GetState : returns the state of the component
+func (f *FloatingIP) GetState() string {
+	return f.State
+}
+
+// SetState : sets the state of the component
+func (f *FloatingIP) SetState(s string) {
+	f.State = s
+}
+
+// GetAction : returns the action of the component
+func (f *FloatingIP) GetAction() string {
+	return f.Action
+}
+
+// SetAction : Sets the action of the component
+func (f *FloatingIP) SetAction(s string) {
+	f.Action = s
+}
+
+// GetGroup : returns the components group
+func (f *FloatingIP) GetGroup() string {
+	return f.Tags[GROUPFLOATINGIP]
+}
+
+// GetTags returns a components tags
+func (f *FloatingIP) GetTags() map[string]string {
+	return f.Tags
+}
+
+// GetTag returns a components tag
+func (f *FloatingIP) GetTag(tag string) string {
+	return f.Tags[tag]
+}
+
+// Diff : diff's the component against another component of the same type
+func (f *FloatingIP) Diff(c graph.Component) bool {
+	cf, ok := c.(*FloatingIP)
+	if ok {
+		return f.Instance != cf.Instance
+	}
+
+	return false
+}
+
+// Update : updates the provider returned values of a component
+func (f *FloatingIP) Update(c graph.Component) {
+	cf, ok := c.(*FloatingIP)
+	if ok {
+		f.FloatingIPID = cf.FloatingIPID
+		f.Address = cf.Address
+	}
+
+	f.SetDefaultVariables()
+}
+
+// Rebuild : rebuilds the component's internal state, such as templated values
+func (f *FloatingIP) Rebuild(g *graph.Graph) {
+	if f.Instance == "" && f.InstanceID != "" {
+		in := g.GetComponents().ByProviderID(f.InstanceID)
+		if in != nil {
+			f.Instance = in.GetName()
+		}
+	}
+
+	if f.Instance != "" && f.InstanceID == "" {
+		f.InstanceID = templInstanceID(f.Instance)
+		f.FixedIP = templInstanceIP(f.Instance)
+	}
+
+	f.SetDefaultVariables()
+}
+
+// Dependencies : returns a list of component id's upon which the component depends
+func (f *FloatingIP) Dependencies() []string {
+	var deps []string
+
+	if f.Instance != "" {
+		deps = append(deps, TYPEINSTANCE+TYPEDELIMITER+f.Instance)
+	}
+
+	return deps
+}
+
+// Validate : validates the components values
+func (f *FloatingIP) Validate() error {
+	err := validateName(f.Name, "Floating IP")
+	if err != nil {
+		return err
+	}
+
+	if f.Pool == "" {
+		return errors.New("Floating IP pool should not be null")
+	}
+
+	if f.Address != "" && net.ParseIP(f.Address) == nil {
+		return errors.New("Floating IP address is not valid")
+	}
+
+	return nil
+}
+
+// IsStateful : returns true if the component needs to be actioned to be removed.
+func (f *FloatingIP) IsStateful() bool {
+	return true
+}
+
+// SetDefaultVariables : sets up the default template variables for a component
+func (f *FloatingIP) SetDefaultVariables() {
+	f.ComponentType = TYPEFLOATINGIP
+	f.ComponentID = TYPEFLOATINGIP + TYPEDELIMITER + f.Name
+	f.ProviderType = PROVIDERTYPE
+	f.DatacenterName = DATACENTERNAME
+	f.DatacenterType = DATACENTERTYPE
+	f.DatacenterRegion = DATACENTERREGION
+	f.AuthURL = AUTHURL
+	f.ProjectName = PROJECTNAME
+	f.DomainName = DOMAINNAME
+	f.Username = USERNAME
+	f.Password = PASSWORD
+}
@@ -0,0 +1,291 @@
+/* This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at http://mozilla.org/MPL/2.0/. */
+
+package components
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"reflect"
+
+	graph "gopkg.in/r3labs/graph.v2"
+)
+
+// InstanceVolume : a cinder volume attached to an instance
+type InstanceVolume struct {
+	Volume   string `json:"volume"`
+	VolumeID string `json:"volume_id"`
+	Device   string `json:"device"`
+}
+
+// Instance : mapping of a nova server component
+type Instance struct {
+	ProviderType     string            `json:"_provider"`
+	ComponentType    string            `json:"_component"`
+	ComponentID      string            `json:"_component_id"`
+	State            string            `json:"_state"`
+	Action           string            `json:"_action"`
+	InstanceID       string            `json:"instance_id"`
+	Name             string            `json:"name"`
+	Flavor           string            `json:"flavor"`
+	Image            string            `json:"image"`
+	KeyPair          string            `json:"key_pair"`
+	AvailabilityZone string            `json:"availability_zone"`
+	Network          string            `json:"network"`
+	NetworkID        string            `json:"network_id"`
+	Subnet           string            `json:"subnet"`
+	SubnetID         string            `json:"subnet_id"`
+	IP               string            `json:"ip"`
+	SecurityGroups   []string          `json:"security_groups"`
+	SecurityGroupIDs []string          `json:"security_group_ids"`
+	Volumes          []InstanceVolume  `json:"volumes"`
+	UserData         string            `json:"user_data"`
+	Tags             map[string]string `json:"tags"`
+	DatacenterType   string            `json:"datacenter_type,omitempty"`
+	DatacenterName   string            `json:"datacenter_name,omitempty"`
+	DatacenterRegion string            `json:"datacenter_region"`
+	AuthURL          string            `json:"auth_url"`
+	ProjectName      string            `json:"project_name"`
+	DomainName       string            `json:"domain_name"`
+	Username         string            `json:"username"`
+	Password         string            `json:"password"`
+	Service          string            `json:"service"`
+}
+
+// GetID : returns the component's ID
+func (i *Instance) GetID() string {
+	return i.ComponentID
+}
+
+// GetName returns a components name
+func (i *Instance) GetName() string {
+	return i.Name
+}
+
+// GetProvider : returns the provider type
+func (i *Instance) GetProvider() string {
+	return i.ProviderType
+}
+
+// GetProviderID returns a components provider id
+func (i *Instance) GetProviderID() string {
+	return i.InstanceID
+}
+
+// GetType : returns the type of the component
+func (i *Instance) GetType() string {
+	return i.ComponentType
+}
+
+// GetState : returns the state of the component
+func (i *Instance) GetState() string {
+	return i.State
+}
+
+// SetState : sets the state of the component
+func (i *Instance) SetState(s string) {
+	i.State = s
+}
+
+// GetAction : returns the action of the component
+func (i *Instance) GetAction() string {
+	return i.Action
+}
+
+// SetAction : Sets the action of the component
+func (i *Instance) SetAction(s string) {
+	i.Action = s
+}
+
+// GetGroup : returns the components group
+func (i *Instance) GetGroup() string {
+	return i.Tags[GROUPINSTANCE]
+}
+
+// GetTags returns a components tags
+func (i *Instance) GetTags() map[string]string {
+	return i.Tags
+}
+
+// GetTag returns a components tag
+func (i *Instance) GetTag(tag string) string {
+	return i.Tags[tag]
+}
+
+// Diff : diff's the component against another component of the same type
+func (i *Instance) Diff(c graph.Component) bool {
+	ci, ok := c.(*Instance)
+	if ok {
+		if i.Flavor != ci.Flavor {
+			return true
+		}
+
+		if reflect.DeepEqual(i.SecurityGroups, ci.SecurityGroups) != true {
+			return true
+		}
+
+		if len(i.Volumes) != len(ci.Volumes) {
+			return true
+		}
+
+		for x := 0; x < len(i.Volumes); x++ {
+			if i.Volumes[x].Volume != ci.Volumes[x].Volume || i.Volumes[x].Device != ci.Volumes[x].Device {
+				return true
+			}
+		}
+
+		return !reflect.DeepEqual(i.Tags, ci.Tags)
+	}
+
+	return false
+}
+
+// Update : updates the provider returned values of a component
+func (i *Instance) Update(c graph.Component) {
+	ci, ok := c.(*Instance)
+	if ok {
+		i.InstanceID = ci.InstanceID
+		i.IP = ci.IP
+	}
+
+	i.SetDefaultVariables()
+}
+
+// Rebuild : rebuilds the component's internal state, such as templated values
+func (i *Instance) Rebuild(g *graph.Graph) {
+	if i.Subnet == "" && i.SubnetID != "" {
+		sn := g.GetComponents().ByProviderID(i.SubnetID)
+		if sn != nil {
+			i.Subnet = sn.GetName()
+		}
+	}
+
+	if i.Subnet != "" && i.SubnetID == "" {
+		i.SubnetID = templSubnetID(i.Subnet)
+	}
+
+	// servers are attached to the network of their subnet
+	for _, c := range g.GetComponents().ByType(TYPESUBNET) {
+		sn, ok := c.(*Subnet)
+		if ok && sn.Name == i.Subnet {
+			i.Network = sn.Network
+		}
+	}
+
+	if i.Network != "" && i.NetworkID == "" {
+		i.NetworkID = templNetworkID(i.Network)
+	}
+
+	if len(i.SecurityGroups) > len(i.SecurityGroupIDs) {
+		for _, sg := range i.SecurityGroups {
+			i.SecurityGroupIDs = append(i.SecurityGroupIDs, templSecurityGroupID(sg))
+		}
+	}
+
+	if len(i.SecurityGroupIDs) > len(i.SecurityGroups) {
+		for _, sgid := range i.SecurityGroupIDs {
+			sg := g.GetComponents().ByProviderID(sgid)
+			if sg != nil {
+				i.SecurityGroups = append(i.SecurityGroups, sg.GetName())
+			}
+		}
+	}
+
+	for x := 0; x < len(i.Volumes); x++ {
+		if i.Volumes[x].Volume == "" && i.Volumes[x].VolumeID != "" {
+			v := g.GetComponents().ByProviderID(i.Volumes[x].VolumeID)
+			if v != nil {
+				i.Volumes[x].Volume = v.GetName()
+			}
+		}
+
+		if i.Volumes[x].Volume != "" && i.Volumes[x].VolumeID == "" {
+			i.Volumes[x].VolumeID = templVolumeID(i.Volumes[x].Volume)
+		}
+	}
+
+	i.SetDefaultVariables()
+}
+
+// Dependencies : returns a list of component id's upon which the component depends
+func (i *Instance) Dependencies() []string {
+	deps := []string{TYPESUBNET + TYPEDELIMITER + i.Subnet}
+
+	for _, sg := range i.SecurityGroups {
+		deps = append(deps, TYPESECURITYGROUP+TYPEDELIMITER+sg)
+	}
+
+	for _, v := range i.Volumes {
+		deps = append(deps, TYPEVOLUME+TYPEDELIMITER+v.Volume)
+	}
+
+	return deps
+}
+
+// Validate : validates the components values
+func (i *Instance) Validate() error {
+	err := validateName(i.Name, "Instance")
+	if err != nil {
+		return err
+	}
+
+	if i.Flavor == "" {
+		return errors.New("Instance flavor should not be null")
+	}
+
+	if i.Image == "" {
+		return errors.New("Instance image should not be null")
+	}
+
+	if i.Subnet == "" {
+		return errors.New("Instance subnet should not be null")
+	}
+
+	if i.IP != "" && net.ParseIP(i.IP) == nil {
+		return errors.New("Instance ip is not valid")
+	}
+
+	if len(i.UserData) > 65535 {
+		return errors.New("Instance user data should not exceed 64KB")
+	}
+
+	var devices []string
+
+	for _, v := range i.Volumes {
+		if v.Volume == "" {
+			return errors.New("Instance volume should not be null")
+		}
+
+		if v.Device != "" {
+			if isOneOf(devices, v.Device) {
+				return fmt.Errorf("Instance volume device (%s) is used more than once", v.Device)
+			}
+
+			devices = append(devices, v.Device)
+		}
+	}
+
+	return nil
+}
+
+// IsStateful : returns true if the component needs to be actioned to be removed.
+func (i *Instance) IsStateful() bool {
+	return true
+}
+
+// SetDefaultVariables : sets up the default template variables for a component
+func (i *Instance) SetDefaultVariables() {
+	i.ComponentType = TYPEINSTANCE
+	i.ComponentID = TYPEINSTANCE + TYPEDELIMITER + i.Name
+	i.ProviderType = PROVIDERTYPE
+	i.DatacenterName = DATACENTERNAME
+	i.DatacenterType = DATACENTERTYPE
+	i.DatacenterRegion = DATACENTERREGION
+	i.AuthURL = AUTHURL
+	i.ProjectName = PROJECTNAME
+	i.DomainName = DOMAINNAME
+	i.Username = USERNAME
+	i.Password = PASSWORD
+}
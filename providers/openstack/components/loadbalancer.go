@@ -0,0 +1,355 @@
+/* This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at http://mozilla.org/MPL/2.0/. */
+
+package components
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"sort"
+
+	graph "gopkg.in/r3labs/graph.v2"
+)
+
+const (
+	LBPROTOCOLHTTP            = "HTTP"
+	LBPROTOCOLHTTPS           = "HTTPS"
+	LBPROTOCOLTCP             = "TCP"
+	LBPROTOCOLUDP             = "UDP"
+	LBPROTOCOLTERMINATEDHTTPS = "TERMINATED_HTTPS"
+)
+
+// LBALGORITHMS : pool load balancing algorithms supported by octavia
+var LBALGORITHMS = []string{"ROUND_ROBIN", "LEAST_CONNECTIONS", "SOURCE_IP"}
+
+// LoadBalancerListener : an octavia listener and the pool of members it forwards to
+type LoadBalancerListener struct {
+	ListenerID      string `json:"listener_id"`
+	PoolID          string `json:"pool_id"`
+	Protocol        string `json:"protocol"`
+	Port            int    `json:"port"`
+	BackendProtocol string `json:"backend_protocol"`
+	BackendPort     int    `json:"backend_port"`
+	Algorithm       string `json:"algorithm"`
+	Certificate     string `json:"certificate,omitempty"`
+}
+
+// LoadBalancerMonitor : the health monitor applied to every pool
+type LoadBalancerMonitor struct {
+	Type       string `json:"type"`
+	Delay      int    `json:"delay"`
+	Timeout    int    `json:"timeout"`
+	MaxRetries int    `json:"max_retries"`
+	URLPath    string `json:"url_path,omitempty"`
+}
+
+// LoadBalancer : mapping of an octavia load balancer component
+type LoadBalancer struct {
+	ProviderType     string                 `json:"_provider"`
+	ComponentType    string                 `json:"_component"`
+	ComponentID      string                 `json:"_component_id"`
+	State            string                 `json:"_state"`
+	Action           string                 `json:"_action"`
+	LoadBalancerID   string                 `json:"loadbalancer_id"`
+	Name             string                 `json:"name"`
+	Subnet           string                 `json:"subnet"`
+	SubnetID         string                 `json:"subnet_id"`
+	VipAddress       string                 `json:"vip_address"`
+	Listeners        []LoadBalancerListener `json:"listeners"`
+	HealthMonitor    LoadBalancerMonitor    `json:"health_monitor"`
+	Instances        []string               `json:"instances"`
+	InstanceNames    sort.StringSlice       `json:"instance_names"`
+	InstanceIPs      []string               `json:"instance_ips"`
+	Tags             map[string]string      `json:"tags"`
+	DatacenterType   string                 `json:"datacenter_type,omitempty"`
+	DatacenterName   string                 `json:"datacenter_name,omitempty"`
+	DatacenterRegion string                 `json:"datacenter_region"`
+	AuthURL          string                 `json:"auth_url"`
+	ProjectName      string                 `json:"project_name"`
+	DomainName       string                 `json:"domain_name"`
+	Username         string                 `json:"username"`
+	Password         string                 `json:"password"`
+	Service          string                 `json:"service"`
+}
+
+// GetID : returns the component's ID
+func (lb *LoadBalancer) GetID() string {
+	return lb.ComponentID
+}
+
+// GetName returns a components name
+func (lb *LoadBalancer) GetName() string {
+	return lb.Name
+}
+
+// GetProvider : returns the provider type
+func (lb *LoadBalancer) GetProvider() string {
+	return lb.ProviderType
+}
+
+// GetProviderID returns a components provider id
+func (lb *LoadBalancer) GetProviderID() string {
+	return lb.LoadBalancerID
+}
+
+// GetType : returns the type of the component
+func (lb *LoadBalancer) GetType() string {
+	return lb.ComponentType
+}
+
+// GetState : returns the state of the component
+func (lb *LoadBalancer) GetState() string {
+	return lb.State
+}
+
+// SetState : sets the state of the component
+func (lb *LoadBalancer) SetState(s string) {
+	lb.State = s
+}
+
+// GetAction : returns the action of the component
+func (lb *LoadBalancer) GetAction() string {
+	return lb.Action
+}
+
+// SetAction : Sets the action of the component
+func (lb *LoadBalancer) SetAction(s string) {
+	lb.Action = s
+}
+
+// GetGroup : returns the components group
+func (lb *LoadBalancer) GetGroup() string {
+	return ""
+}
+
+// GetTags returns a components tags
+func (lb *LoadBalancer) GetTags() map[string]string {
+	return lb.Tags
+}
+
+// GetTag returns a components tag
+func (lb *LoadBalancer) GetTag(tag string) string {
+	return lb.Tags[tag]
+}
+
+// Diff : diff's the component against another component of the same type
+func (lb *LoadBalancer) Diff(c graph.Component) bool {
+	clb, ok := c.(*LoadBalancer)
+	if ok {
+		if len(lb.Listeners) != len(clb.Listeners) {
+			return true
+		}
+
+		for x := 0; x < len(lb.Listeners); x++ {
+			l := lb.Listeners[x]
+			cl := clb.Listeners[x]
+
+			if l.Protocol != cl.Protocol || l.Port != cl.Port || l.Certificate != cl.Certificate {
+				return true
+			}
+
+			if l.BackendProtocol != cl.BackendProtocol || l.BackendPort != cl.BackendPort || l.Algorithm != cl.Algorithm {
+				return true
+			}
+		}
+
+		if reflect.DeepEqual(lb.HealthMonitor, clb.HealthMonitor) != true {
+			return true
+		}
+
+		lb.InstanceNames.Sort()
+		clb.InstanceNames.Sort()
+
+		return !reflect.DeepEqual(lb.InstanceNames, clb.InstanceNames)
+	}
+
+	return false
+}
+
+// Update : updates the provider returned values of a component
+func (lb *LoadBalancer) Update(c graph.Component) {
+	clb, ok := c.(*LoadBalancer)
+	if ok {
+		lb.LoadBalancerID = clb.LoadBalancerID
+		lb.VipAddress = clb.VipAddress
+
+		for x := 0; x < len(lb.Listeners) && x < len(clb.Listeners); x++ {
+			lb.Listeners[x].ListenerID = clb.Listeners[x].ListenerID
+			lb.Listeners[x].PoolID = clb.Listeners[x].PoolID
+		}
+	}
+
+	lb.SetDefaultVariables()
+}
+
+// Rebuild : rebuilds the component's internal state, such as templated values
+func (lb *LoadBalancer) Rebuild(g *graph.Graph) {
+	if lb.Subnet == "" && lb.SubnetID != "" {
+		sn := g.GetComponents().ByProviderID(lb.SubnetID)
+		if sn != nil {
+			lb.Subnet = sn.GetName()
+		}
+	}
+
+	if lb.Subnet != "" && lb.SubnetID == "" {
+		lb.SubnetID = templSubnetID(lb.Subnet)
+	}
+
+	if len(lb.Instances) > len(lb.InstanceIPs) {
+		for _, ig := range lb.Instances {
+			for _, i := range g.GetComponents().ByType(TYPEINSTANCE).ByGroup(GROUPINSTANCE, ig) {
+				lb.InstanceIPs = append(lb.InstanceIPs, templInstanceIP(i.GetName()))
+			}
+		}
+	}
+
+	if len(lb.InstanceIPs) > len(lb.Instances) {
+		for _, ip := range lb.InstanceIPs {
+			for _, c := range g.GetComponents().ByType(TYPEINSTANCE) {
+				i, ok := c.(*Instance)
+				if ok && i.IP == ip {
+					lb.Instances = appendUnique(lb.Instances, i.GetGroup())
+				}
+			}
+		}
+	}
+
+	for _, ig := range lb.Instances {
+		for _, i := range g.GetComponents().ByType(TYPEINSTANCE).ByGroup(GROUPINSTANCE, ig) {
+			lb.InstanceNames = appendUnique(lb.InstanceNames, i.GetName())
+		}
+	}
+
+	for x := 0; x < len(lb.Listeners); x++ {
+		if lb.Listeners[x].BackendProtocol == "" {
+			lb.Listeners[x].BackendProtocol = lb.Listeners[x].Protocol
+		}
+
+		if lb.Listeners[x].BackendProtocol == LBPROTOCOLTERMINATEDHTTPS {
+			lb.Listeners[x].BackendProtocol = LBPROTOCOLHTTP
+		}
+
+		if lb.Listeners[x].BackendPort == 0 {
+			lb.Listeners[x].BackendPort = lb.Listeners[x].Port
+		}
+
+		if lb.Listeners[x].Algorithm == "" {
+			lb.Listeners[x].Algorithm = "ROUND_ROBIN"
+		}
+	}
+
+	if lb.HealthMonitor.Type == "" {
+		lb.HealthMonitor.Type = LBPROTOCOLTCP
+	}
+
+	lb.SetDefaultVariables()
+}
+
+// Dependencies : returns a list of component id's upon which the component depends
+func (lb *LoadBalancer) Dependencies() []string {
+	deps := []string{TYPESUBNET + TYPEDELIMITER + lb.Subnet}
+
+	for _, in := range lb.InstanceNames {
+		deps = append(deps, TYPEINSTANCE+TYPEDELIMITER+in)
+	}
+
+	return deps
+}
+
+// Validate : validates the components values
+func (lb *LoadBalancer) Validate() error {
+	err := validateName(lb.Name, "Load Balancer")
+	if err != nil {
+		return err
+	}
+
+	if lb.Subnet == "" {
+		return errors.New("Load Balancer subnet should not be null")
+	}
+
+	if len(lb.Listeners) < 1 {
+		return errors.New("Load Balancer should specify at least one listener")
+	}
+
+	var ports []int
+
+	for _, l := range lb.Listeners {
+		for _, p := range ports {
+			if p == l.Port {
+				return fmt.Errorf("Load Balancer listener port (%d) is used more than once", l.Port)
+			}
+		}
+
+		ports = append(ports, l.Port)
+
+		if isOneOf([]string{LBPROTOCOLHTTP, LBPROTOCOLHTTPS, LBPROTOCOLTCP, LBPROTOCOLUDP, LBPROTOCOLTERMINATEDHTTPS}, l.Protocol) != true {
+			return fmt.Errorf("Load Balancer listener protocol (%s) should be one of HTTP, HTTPS, TCP, UDP or TERMINATED_HTTPS", l.Protocol)
+		}
+
+		if isOneOf([]string{LBPROTOCOLHTTP, LBPROTOCOLHTTPS, LBPROTOCOLTCP, LBPROTOCOLUDP}, l.BackendProtocol) != true {
+			return fmt.Errorf("Load Balancer listener backend protocol (%s) should be one of HTTP, HTTPS, TCP or UDP", l.BackendProtocol)
+		}
+
+		if l.Port < 1 || l.Port > 65535 {
+			return fmt.Errorf("Load Balancer listener port (%d) is out of range [1 - 65535]", l.Port)
+		}
+
+		if l.BackendPort < 1 || l.BackendPort > 65535 {
+			return fmt.Errorf("Load Balancer listener backend port (%d) is out of range [1 - 65535]", l.BackendPort)
+		}
+
+		if isOneOf(LBALGORITHMS, l.Algorithm) != true {
+			return fmt.Errorf("Load Balancer listener algorithm (%s) should be one of ROUND_ROBIN, LEAST_CONNECTIONS or SOURCE_IP", l.Algorithm)
+		}
+
+		if l.Protocol == LBPROTOCOLTERMINATEDHTTPS && l.Certificate == "" {
+			return fmt.Errorf("Load Balancer listener (%d) should specify a certificate to terminate https", l.Port)
+		}
+
+		if l.Protocol != LBPROTOCOLTERMINATEDHTTPS && l.Certificate != "" {
+			return fmt.Errorf("Load Balancer listener (%d) should only specify a certificate when terminating https", l.Port)
+		}
+	}
+
+	hm := lb.HealthMonitor
+
+	if isOneOf([]string{"HTTP", "HTTPS", "PING", "TCP", "TLS-HELLO", "UDP-CONNECT"}, hm.Type) != true {
+		return fmt.Errorf("Load Balancer health monitor type (%s) is not supported", hm.Type)
+	}
+
+	if hm.URLPath != "" && hm.Type != "HTTP" && hm.Type != "HTTPS" {
+		return errors.New("Load Balancer health monitor url path should only be set for http health monitors")
+	}
+
+	if hm.MaxRetries != 0 && (hm.MaxRetries < 1 || hm.MaxRetries > 10) {
+		return errors.New("Load Balancer health monitor max retries should be between 1 and 10")
+	}
+
+	if hm.Delay != 0 && hm.Timeout > hm.Delay {
+		return errors.New("Load Balancer health monitor timeout should not be greater than its delay")
+	}
+
+	return nil
+}
+
+// IsStateful : returns true if the component needs to be actioned to be removed.
+func (lb *LoadBalancer) IsStateful() bool {
+	return true
+}
+
+// SetDefaultVariables : sets up the default template variables for a component
+func (lb *LoadBalancer) SetDefaultVariables() {
+	lb.ComponentType = TYPELOADBALANCER
+	lb.ComponentID = TYPELOADBALANCER + TYPEDELIMITER + lb.Name
+	lb.ProviderType = PROVIDERTYPE
+	lb.DatacenterName = DATACENTERNAME
+	lb.DatacenterType = DATACENTERTYPE
+	lb.DatacenterRegion = DATACENTERREGION
+	lb.AuthURL = AUTHURL
+	lb.ProjectName = PROJECTNAME
+	lb.DomainName = DOMAINNAME
+	lb.Username = USERNAME
+	lb.Password = PASSWORD
+}
@@ -0,0 +1,155 @@
+/* This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at http://mozilla.org/MPL/2.0/. */
+
+package components
+
+import (
+	"reflect"
+
+	graph "gopkg.in/r3labs/graph.v2"
+)
+
+// Network : Mapping of a neutron network component
+type Network struct {
+	ProviderType     string            `json:"_provider"`
+	ComponentType    string            `json:"_component"`
+	ComponentID      string            `json:"_component_id"`
+	State            string            `json:"_state"`
+	Action           string            `json:"_action"`
+	NetworkID        string            `json:"network_id"`
+	Name             string            `json:"name"`
+	AdminStateUp     bool              `json:"admin_state_up"`
+	PortSecurity     bool              `json:"port_security_enabled"`
+	MTU              *int64            `json:"mtu,omitempty"`
+	Tags             map[string]string `json:"tags"`
+	DatacenterType   string            `json:"datacenter_type,omitempty"`
+	DatacenterName   string            `json:"datacenter_name,omitempty"`
+	DatacenterRegion string            `json:"datacenter_region"`
+	AuthURL          string            `json:"auth_url"`
+	ProjectName      string            `json:"project_name"`
+	DomainName       string            `json:"domain_name"`
+	Username         string            `json:"username"`
+	Password         string            `json:"password"`
+	Service          string            `json:"service"`
+}
+
+// GetID : returns the component's ID
+func (n *Network) GetID() string {
+	return n.ComponentID
+}
+
+// GetName returns a components name
+func (n *Network) GetName() string {
+	return n.Name
+}
+
+// GetProvider : returns the provider type
+func (n *Network) GetProvider() string {
+	return n.ProviderType
+}
+
+// GetProviderID returns a components provider id
+func (n *Network) GetProviderID() string {
+	return n.NetworkID
+}
+
+// GetType : returns the type of the component
+func (n *Network) GetType() string {
+	return n.ComponentType
+}
+
+// GetState : returns the state of the component
+func (n *Network) GetState() string {
+	return n.State
+}
+
+// SetState : sets the state of the component
+func (n *Network) SetState(s string) {
+	n.State = s
+}
+
+// GetAction : returns the action of the component
+func (n *Network) GetAction() string {
+	return n.Action
+}
+
+// SetAction : Sets the action of the component
+func (n *Network) SetAction(s string) {
+	n.Action = s
+}
+
+// GetGroup : returns the components group
+func (n *Network) GetGroup() string {
+	return ""
+}
+
+// GetTags returns a components tags
+func (n *Network) GetTags() map[string]string {
+	return n.Tags
+}
+
+// GetTag returns a components tag
+func (n *Network) GetTag(tag string) string {
+	return n.Tags[tag]
+}
+
+// Diff : diff's the component against another component of the same type
+func (n *Network) Diff(c graph.Component) bool {
+	cn, ok := c.(*Network)
+	if ok {
+		if n.AdminStateUp != cn.AdminStateUp || n.PortSecurity != cn.PortSecurity {
+			return true
+		}
+
+		return !reflect.DeepEqual(n.Tags, cn.Tags)
+	}
+
+	return false
+}
+
+// Update : updates the provider returned values of a component
+func (n *Network) Update(c graph.Component) {
+	cn, ok := c.(*Network)
+	if ok {
+		n.NetworkID = cn.NetworkID
+		n.MTU = cn.MTU
+	}
+
+	n.SetDefaultVariables()
+}
+
+// Rebuild : rebuilds the component's internal state, such as templated values
+func (n *Network) Rebuild(g *graph.Graph) {
+	n.SetDefaultVariables()
+}
+
+// Dependencies : returns a list of component id's upon which the component depends
+func (n *Network) Dependencies() []string {
+	return []string{}
+}
+
+// Validate : validates the components values
+func (n *Network) Validate() error {
+	return validateName(n.Name, "Network")
+}
+
+// IsStateful : returns true if the component needs to be actioned to be removed.
+func (n *Network) IsStateful() bool {
+	return true
+}
+
+// SetDefaultVariables : sets up the default template variables for a component
+func (n *Network) SetDefaultVariables() {
+	n.ComponentType = TYPENETWORK
+	n.ComponentID = TYPENETWORK + TYPEDELIMITER + n.Name
+	n.ProviderType = PROVIDERTYPE
+	n.DatacenterName = DATACENTERNAME
+	n.DatacenterType = DATACENTERTYPE
+	n.DatacenterRegion = DATACENTERREGION
+	n.AuthURL = AUTHURL
+	n.ProjectName = PROJECTNAME
+	n.DomainName = DOMAINNAME
+	n.Username = USERNAME
+	n.Password = PASSWORD
+}
@@ -0,0 +1,200 @@
+/* This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at http://mozilla.org/MPL/2.0/. */
+
+package components
+
+import (
+	"errors"
+	"reflect"
+
+	graph "gopkg.in/r3labs/graph.v2"
+)
+
+// Router : Mapping of a neutron router component
+type Router struct {
+	ProviderType      string            `json:"_provider"`
+	ComponentType     string            `json:"_component"`
+	ComponentID       string            `json:"_component_id"`
+	State             string            `json:"_state"`
+	Action            string            `json:"_action"`
+	RouterID          string            `json:"router_id"`
+	Name              string            `json:"name"`
+	ExternalNetworkID string            `json:"external_network_id"`
+	EnableSNAT        bool              `json:"enable_snat"`
+	Subnets           []string          `json:"subnets"`
+	SubnetIDs         []string          `json:"subnet_ids"`
+	Tags              map[string]string `json:"tags"`
+	DatacenterType    string            `json:"datacenter_type,omitempty"`
+	DatacenterName    string            `json:"datacenter_name,omitempty"`
+	DatacenterRegion  string            `json:"datacenter_region"`
+	AuthURL           string            `json:"auth_url"`
+	ProjectName       string            `json:"project_name"`
+	DomainName        string            `json:"domain_name"`
+	Username          string            `json:"username"`
+	Password          string            `json:"password"`
+	Service           string            `json:"service"`
+}
+
+// GetID : returns the component's ID
+func (r *Router) GetID() string {
+	return r.ComponentID
+}
+
+// GetName returns a components name
+func (r *Router) GetName() string {
+	return r.Name
+}
+
+// GetProvider : returns the provider type
+func (r *Router) GetProvider() string {
+	return r.ProviderType
+}
+
+// GetProviderID returns a components provider id
+func (r *Router) GetProviderID() string {
+	return r.RouterID
+}
+
+// GetType : returns the type of the component
+func (r *Router) GetType() string {
+	return r.ComponentType
+}
+
+// GetState : returns the state of the component
+func (r *Router) GetState() string {
+	return r.State
+}
+
+// SetState : sets the state of the component
+func (r *Router) SetState(s string) {
+	r.State = s
+}
+
+// GetAction : returns the action of the component
+func (r *Router) GetAction() string {
+	return r.Action
+}
+
+// SetAction : Sets the action of the component
+func (r *Router) SetAction(s string) {
+	r.Action = s
+}
+
+// GetGroup : returns the components group
+func (r *Router) GetGroup() string {
+	return ""
+}
+
+// GetTags returns a components tags
+func (r *Router) GetTags() map[string]string {
+	return r.Tags
+}
+
+// GetTag returns a components tag
+func (r *Router) GetTag(tag string) string {
+	return r.Tags[tag]
+}
+
+// Diff : diff's the component against another component of the same type
+func (r *Router) Diff(c graph.Component) bool {
+	cr, ok := c.(*Router)
+	if ok {
+		if r.ExternalNetworkID != cr.ExternalNetworkID || r.EnableSNAT != cr.EnableSNAT {
+			return true
+		}
+
+		if reflect.DeepEqual(r.Subnets, cr.Subnets) != true {
+			return true
+		}
+
+		return !reflect.DeepEqual(r.Tags, cr.Tags)
+	}
+
+	return false
+}
+
+// Update : updates the provider returned values of a component
+func (r *Router) Update(c graph.Component) {
+	cr, ok := c.(*Router)
+	if ok {
+		r.RouterID = cr.RouterID
+	}
+
+	r.SetDefaultVariables()
+}
+
+// Rebuild : rebuilds the component's internal state, such as templated values
+func (r *Router) Rebuild(g *graph.Graph) {
+	if len(r.Subnets) > len(r.SubnetIDs) {
+		for _, sn := range r.Subnets {
+			r.SubnetIDs = append(r.SubnetIDs, templSubnetID(sn))
+		}
+	}
+
+	if len(r.SubnetIDs) > len(r.Subnets) {
+		for _, snid := range r.SubnetIDs {
+			sn := g.GetComponents().ByProviderID(snid)
+			if sn != nil {
+				r.Subnets = append(r.Subnets, sn.GetName())
+			}
+		}
+	}
+
+	r.SetDefaultVariables()
+}
+
+// Dependencies : returns a list of component id's upon which the component depends
+func (r *Router) Dependencies() []string {
+	var deps []string
+
+	for _, sn := range r.Subnets {
+		deps = append(deps, TYPESUBNET+TYPEDELIMITER+sn)
+	}
+
+	return deps
+}
+
+// Validate : validates the components values
+func (r *Router) Validate() error {
+	err := validateName(r.Name, "Router")
+	if err != nil {
+		return err
+	}
+
+	if r.EnableSNAT && r.ExternalNetworkID == "" {
+		return errors.New("Router should specify an external network to enable snat")
+	}
+
+	var subnets []string
+
+	for _, sn := range r.Subnets {
+		if isOneOf(subnets, sn) {
+			return errors.New("Router subnet (" + sn + ") is attached more than once")
+		}
+
+		subnets = append(subnets, sn)
+	}
+
+	return nil
+}
+
+// IsStateful : returns true if the component needs to be actioned to be removed.
+func (r *Router) IsStateful() bool {
+	return true
+}
+
+// SetDefaultVariables : sets up the default template variables for a component
+func (r *Router) SetDefaultVariables() {
+	r.ComponentType = TYPEROUTER
+	r.ComponentID = TYPEROUTER + TYPEDELIMITER + r.Name
+	r.ProviderType = PROVIDERTYPE
+	r.DatacenterName = DATACENTERNAME
+	r.DatacenterType = DATACENTERTYPE
+	r.DatacenterRegion = DATACENTERREGION
+	r.AuthURL = AUTHURL
+	r.ProjectName = PROJECTNAME
+	r.DomainName = DOMAINNAME
+	r.Username = USERNAME
+	r.Password = PASSWORD
+}
@@ -0,0 +1,278 @@
+/* This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at http://mozilla.org/MPL/2.0/. */
+
+package components
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"reflect"
+
+	graph "gopkg.in/r3labs/graph.v2"
+)
+
+// SecurityGroupRule ...
+type SecurityGroupRule struct {
+	IP            string `json:"ip"`
+	RemoteGroup   string `json:"remote_group,omitempty"`
+	RemoteGroupID string `json:"remote_group_id,omitempty"`
+	From          int    `json:"from_port"`
+	To            int    `json:"to_port"`
+	Protocol      string `json:"protocol"`
+}
+
+// SecurityGroup : Mapping of a security group component
+type SecurityGroup struct {
+	ProviderType    string `json:"_provider"`
+	ComponentType   string `json:"_component"`
+	ComponentID     string `json:"_component_id"`
+	State           string `json:"_state"`
+	Action          string `json:"_action"`
+	SecurityGroupID string `json:"security_group_id"`
+	Name            string `json:"name"`
+	Description     string `json:"description"`
+	Rules           struct {
+		Ingress []SecurityGroupRule `json:"ingress"`
+		Egress  []SecurityGroupRule `json:"egress"`
+	} `json:"rules"`
+	Tags             map[string]string `json:"tags"`
+	DatacenterType   string            `json:"datacenter_type,omitempty"`
+	DatacenterName   string            `json:"datacenter_name,omitempty"`
+	DatacenterRegion string            `json:"datacenter_region"`
+	AuthURL          string            `json:"auth_url"`
+	ProjectName      string            `json:"project_name"`
+	DomainName       string            `json:"domain_name"`
+	Username         string            `json:"username"`
+	Password         string            `json:"password"`
+	Service          string            `json:"service"`
+}
+
+// GetID : returns the component's ID
+func (sg *SecurityGroup) GetID() string {
+	return sg.ComponentID
+}
+
+// GetName returns a components name
+func (sg *SecurityGroup) GetName() string {
+	return sg.Name
+}
+
+// GetProvider : returns the provider type
+func (sg *SecurityGroup) GetProvider() string {
+	return sg.ProviderType
+}
+
+// GetProviderID returns a components provider id
+func (sg *SecurityGroup) GetProviderID() string {
+	return sg.SecurityGroupID
+}
+
+// GetType : returns the type of the component
+func (sg *SecurityGroup) GetType() string {
+	return sg.ComponentType
+}
+
+// GetState : returns the state of the component
+func (sg *SecurityGroup) GetState() string {
+	return sg.State
+}
+
+// SetState : sets the state of the component
+func (sg *SecurityGroup) SetState(s string) {
+	sg.State = s
+}
+
+// GetAction : returns the action of the component
+func (sg *SecurityGroup) GetAction() string {
+	return sg.Action
+}
+
+// SetAction : Sets the action of the component
+func (sg *SecurityGroup) SetAction(s string) {
+	sg.Action = s
+}
+
+// GetGroup : returns the components group
+func (sg *SecurityGroup) GetGroup() string {
+	return ""
+}
+
+// GetTags returns a components tags
+func (sg *SecurityGroup) GetTags() map[string]string {
+	return sg.Tags
+}
+
+// GetTag returns a components tag
+func (sg *SecurityGroup) GetTag(tag string) string {
+	return sg.Tags[tag]
+}
+
+// Diff : diff's the component against another component of the same type
+func (sg *SecurityGroup) Diff(c graph.Component) bool {
+	csg, ok := c.(*SecurityGroup)
+	if ok {
+		if len(sg.Rules.Ingress) != len(csg.Rules.Ingress) ||
+			len(sg.Rules.Egress) != len(csg.Rules.Egress) {
+			return true
+		}
+
+		for _, rule := range sg.Rules.Ingress {
+			if hasRule(csg.Rules.Ingress, rule) != true {
+				return true
+			}
+		}
+
+		for _, rule := range sg.Rules.Egress {
+			if hasRule(csg.Rules.Egress, rule) != true {
+				return true
+			}
+		}
+
+		return !reflect.DeepEqual(sg.Tags, csg.Tags)
+	}
+
+	return false
+}
+
+// Update : updates the provider returned values of a component
+func (sg *SecurityGroup) Update(c graph.Component) {
+	csg, ok := c.(*SecurityGroup)
+	if ok {
+		sg.SecurityGroupID = csg.SecurityGroupID
+	}
+
+	sg.SetDefaultVariables()
+}
+
+// Rebuild : rebuilds the component's internal state, such as templated values
+func (sg *SecurityGroup) Rebuild(g *graph.Graph) {
+	sg.rebuildRules(g, sg.Rules.Ingress)
+	sg.rebuildRules(g, sg.Rules.Egress)
+
+	sg.SetDefaultVariables()
+}
+
+// Dependencies : returns a list of component id's upon which the component depends
+func (sg *SecurityGroup) Dependencies() []string {
+	var deps []string
+
+	for _, rule := range append(append([]SecurityGroupRule{}, sg.Rules.Ingress...), sg.Rules.Egress...) {
+		if rule.RemoteGroup != "" && rule.RemoteGroup != sg.Name {
+			deps = appendUnique(deps, TYPESECURITYGROUP+TYPEDELIMITER+rule.RemoteGroup)
+		}
+	}
+
+	return deps
+}
+
+// Validate : validates the components values
+func (sg *SecurityGroup) Validate() error {
+	err := validateName(sg.Name, "Security Group")
+	if err != nil {
+		return err
+	}
+
+	if sg.Name == "default" {
+		return errors.New("Security Group name should not be 'default', as it is reserved by neutron")
+	}
+
+	for _, rule := range sg.Rules.Ingress {
+		err := rule.Validate()
+		if err != nil {
+			return err
+		}
+	}
+
+	for _, rule := range sg.Rules.Egress {
+		err := rule.Validate()
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// IsStateful : returns true if the component needs to be actioned to be removed.
+func (sg *SecurityGroup) IsStateful() bool {
+	return true
+}
+
+// SetDefaultVariables : sets up the default template variables for a component
+func (sg *SecurityGroup) SetDefaultVariables() {
+	sg.ComponentType = TYPESECURITYGROUP
+	sg.ComponentID = TYPESECURITYGROUP + TYPEDELIMITER + sg.Name
+	sg.ProviderType = PROVIDERTYPE
+	sg.DatacenterName = DATACENTERNAME
+	sg.DatacenterType = DATACENTERTYPE
+	sg.DatacenterRegion = DATACENTERREGION
+	sg.AuthURL = AUTHURL
+	sg.ProjectName = PROJECTNAME
+	sg.DomainName = DOMAINNAME
+	sg.Username = USERNAME
+	sg.Password = PASSWORD
+}
+
+// Validate security group rule
+func (rule *SecurityGroupRule) Validate() error {
+	if rule.IP != "" && rule.RemoteGroup != "" {
+		return errors.New("Security Group rule should not specify both an ip and a remote group")
+	}
+
+	if rule.IP != "" {
+		_, _, err := net.ParseCIDR(rule.IP)
+		if err != nil {
+			return fmt.Errorf("Security Group rule ip (%s) is not a valid CIDR", rule.IP)
+		}
+	}
+
+	err := validatePort(rule.From, "Security Group From")
+	if err != nil {
+		return err
+	}
+
+	err = validatePort(rule.To, "Security Group To")
+	if err != nil {
+		return err
+	}
+
+	if rule.From > rule.To {
+		return errors.New("Security Group From Port should not be greater than the To Port")
+	}
+
+	// Must be one of: tcp | udp | icmp | any
+	return validateProtocol(rule.Protocol)
+}
+
+// rebuildRules : resolves remote security groups to their ids, or names when loaded from a provider.
+// A rule referencing its own group is left for the provider to resolve once the group exists
+func (sg *SecurityGroup) rebuildRules(g *graph.Graph, rules []SecurityGroupRule) {
+	for x := 0; x < len(rules); x++ {
+		if rules[x].RemoteGroup == "" && rules[x].RemoteGroupID != "" {
+			rsg := g.GetComponents().ByProviderID(rules[x].RemoteGroupID)
+			if rsg != nil {
+				rules[x].RemoteGroup = rsg.GetName()
+			}
+		}
+
+		if rules[x].RemoteGroup != "" && rules[x].RemoteGroup != sg.Name && rules[x].RemoteGroupID == "" {
+			rules[x].RemoteGroupID = templSecurityGroupID(rules[x].RemoteGroup)
+		}
+	}
+}
+
+func hasRule(rules []SecurityGroupRule, rule SecurityGroupRule) bool {
+	for _, r := range rules {
+		if r.Protocol == rule.Protocol &&
+			r.IP == rule.IP &&
+			r.RemoteGroup == rule.RemoteGroup &&
+			r.From == rule.From &&
+			r.To == rule.To {
+			return true
+		}
+	}
+
+	return false
+}
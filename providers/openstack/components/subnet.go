@@ -0,0 +1,234 @@
+/* This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at http://mozilla.org/MPL/2.0/. */
+
+package components
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"reflect"
+
+	graph "gopkg.in/r3labs/graph.v2"
+)
+
+// AllocationPool : a range of addresses neutron can allocate from
+type AllocationPool struct {
+	Start string `json:"start"`
+	End   string `json:"end"`
+}
+
+// Subnet : Mapping of a neutron subnet component
+type Subnet struct {
+	ProviderType     string            `json:"_provider"`
+	ComponentType    string            `json:"_component"`
+	ComponentID      string            `json:"_component_id"`
+	State            string            `json:"_state"`
+	Action           string            `json:"_action"`
+	SubnetID         string            `json:"subnet_id"`
+	Name             string            `json:"name"`
+	Network          string            `json:"network"`
+	NetworkID        string            `json:"network_id"`
+	CIDR             string            `json:"range"`
+	GatewayIP        string            `json:"gateway_ip"`
+	DNSNameservers   []string          `json:"dns_nameservers"`
+	EnableDHCP       bool              `json:"enable_dhcp"`
+	AllocationPools  []AllocationPool  `json:"allocation_pools"`
+	Tags             map[string]string `json:"tags"`
+	DatacenterType   string            `json:"datacenter_type,omitempty"`
+	DatacenterName   string            `json:"datacenter_name,omitempty"`
+	DatacenterRegion string            `json:"datacenter_region"`
+	AuthURL          string            `json:"auth_url"`
+	ProjectName      string            `json:"project_name"`
+	DomainName       string            `json:"domain_name"`
+	Username         string            `json:"username"`
+	Password         string            `json:"password"`
+	Service          string            `json:"service"`
+}
+
+// GetID : returns the component's ID
+func (sn *Subnet) GetID() string {
+	return sn.ComponentID
+}
+
+// GetName returns a components name
+func (sn *Subnet) GetName() string {
+	return sn.Name
+}
+
+// GetProvider : returns the provider type
+func (sn *Subnet) GetProvider() string {
+	return sn.ProviderType
+}
+
+// GetProviderID returns a components provider id
+func (sn *Subnet) GetProviderID() string {
+	return sn.SubnetID
+}
+
+// GetType : returns the type of the component
+func (sn *Subnet) GetType() string {
+	return sn.ComponentType
+}
+
+// GetState : returns the state of the component
+func (sn *Subnet) GetState() string {
+	return sn.State
+}
+
+// SetState : sets the state of the component
+func (sn *Subnet) SetState(s string) {
+	sn.State = s
+}
+
+// GetAction : returns the action of the component
+func (sn *Subnet) GetAction() string {
+	return sn.Action
+}
+
+// SetAction : Sets the action of the component
+func (sn *Subnet) SetAction(s string) {
+	sn.Action = s
+}
+
+// GetGroup : returns the components group
+func (sn *Subnet) GetGroup() string {
+	return ""
+}
+
+// GetTags returns a components tags
+func (sn *Subnet) GetTags() map[string]string {
+	return sn.Tags
+}
+
+// GetTag returns a components tag
+func (sn *Subnet) GetTag(tag string) string {
+	return sn.Tags[tag]
+}
+
+// Diff : diff's the component against another component of the same type
+func (sn *Subnet) Diff(c graph.Component) bool {
+	cs, ok := c.(*Subnet)
+	if ok {
+		if sn.GatewayIP != cs.GatewayIP || sn.EnableDHCP != cs.EnableDHCP {
+			return true
+		}
+
+		if reflect.DeepEqual(sn.DNSNameservers, cs.DNSNameservers) != true {
+			return true
+		}
+
+		if reflect.DeepEqual(sn.AllocationPools, cs.AllocationPools) != true {
+			return true
+		}
+
+		return !reflect.DeepEqual(sn.Tags, cs.Tags)
+	}
+
+	return false
+}
+
+// Update : updates the provider returned values of a component
+func (sn *Subnet) Update(c graph.Component) {
+	cs, ok := c.(*Subnet)
+	if ok {
+		sn.SubnetID = cs.SubnetID
+		sn.GatewayIP = cs.GatewayIP
+	}
+
+	sn.SetDefaultVariables()
+}
+
+// Rebuild : rebuilds the component's internal state, such as templated values
+func (sn *Subnet) Rebuild(g *graph.Graph) {
+	if sn.Network == "" && sn.NetworkID != "" {
+		nw := g.GetComponents().ByProviderID(sn.NetworkID)
+		if nw != nil {
+			sn.Network = nw.GetName()
+		}
+	}
+
+	if sn.Network != "" && sn.NetworkID == "" {
+		sn.NetworkID = templNetworkID(sn.Network)
+	}
+
+	sn.SetDefaultVariables()
+}
+
+// Dependencies : returns a list of component id's upon which the component depends
+func (sn *Subnet) Dependencies() []string {
+	return []string{TYPENETWORK + TYPEDELIMITER + sn.Network}
+}
+
+// Validate : validates the components values
+func (sn *Subnet) Validate() error {
+	err := validateName(sn.Name, "Subnet")
+	if err != nil {
+		return err
+	}
+
+	if sn.Network == "" {
+		return errors.New("Subnet network should not be null")
+	}
+
+	_, _, err = net.ParseCIDR(sn.CIDR)
+	if err != nil {
+		return errors.New("Subnet CIDR is not valid")
+	}
+
+	if sn.GatewayIP != "" {
+		err := validateIPInCIDR(sn.GatewayIP, sn.CIDR)
+		if err != nil {
+			return errors.New("Subnet gateway " + err.Error())
+		}
+	}
+
+	for _, ns := range sn.DNSNameservers {
+		if net.ParseIP(ns) == nil {
+			return fmt.Errorf("Subnet dns nameserver (%s) is not a valid ip address", ns)
+		}
+	}
+
+	for _, pool := range sn.AllocationPools {
+		err := validateIPInCIDR(pool.Start, sn.CIDR)
+		if err != nil {
+			return errors.New("Subnet allocation pool start " + err.Error())
+		}
+
+		err = validateIPInCIDR(pool.End, sn.CIDR)
+		if err != nil {
+			return errors.New("Subnet allocation pool end " + err.Error())
+		}
+
+		if ipInRange(pool.End, pool.Start, pool.End) != true {
+			return fmt.Errorf("Subnet allocation pool start (%s) should come before its end (%s)", pool.Start, pool.End)
+		}
+
+		if sn.GatewayIP != "" && ipInRange(sn.GatewayIP, pool.Start, pool.End) {
+			return errors.New("Subnet allocation pool should not include the gateway ip")
+		}
+	}
+
+	return nil
+}
+
+// IsStateful : returns true if the component needs to be actioned to be removed.
+func (sn *Subnet) IsStateful() bool {
+	return true
+}
+
+// SetDefaultVariables : sets up the default template variables for a component
+func (sn *Subnet) SetDefaultVariables() {
+	sn.ComponentType = TYPESUBNET
+	sn.ComponentID = TYPESUBNET + TYPEDELIMITER + sn.Name
+	sn.ProviderType = PROVIDERTYPE
+	sn.DatacenterName = DATACENTERNAME
+	sn.DatacenterType = DATACENTERTYPE
+	sn.DatacenterRegion = DATACENTERREGION
+	sn.AuthURL = AUTHURL
+	sn.ProjectName = PROJECTNAME
+	sn.DomainName = DOMAINNAME
+	sn.Username = USERNAME
+	sn.Password = PASSWORD
+}
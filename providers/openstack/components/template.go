@@ -0,0 +1,55 @@
+/* This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at http://mozilla.org/MPL/2.0/. */
+
+package components
+
+const (
+	TYPEDELIMITER     = "::"
+	TYPENETWORK       = "network"
+	TYPESUBNET        = "subnet"
+	TYPEROUTER        = "router"
+	TYPESECURITYGROUP = "security_group"
+	TYPEVOLUME        = "volume"
+	TYPEINSTANCE      = "instance"
+	TYPEFLOATINGIP    = "floating_ip"
+	TYPELOADBALANCER  = "loadbalancer"
+
+	GROUPINSTANCE   = "ernest.instance_group"
+	GROUPVOLUME     = "ernest.volume_group"
+	GROUPFLOATINGIP = "ernest.floating_ip_group"
+
+	PROVIDERTYPE     = `$(components.#[_component_id="credentials::openstack"]._provider)`
+	DATACENTERNAME   = `$(components.#[_component_id="credentials::openstack"].name)`
+	DATACENTERTYPE   = `$(components.#[_component_id="credentials::openstack"]._provider)`
+	DATACENTERREGION = `$(components.#[_component_id="credentials::openstack"].region)`
+	AUTHURL          = `$(components.#[_component_id="credentials::openstack"].auth_url)`
+	PROJECTNAME      = `$(components.#[_component_id="credentials::openstack"].project_name)`
+	DOMAINNAME       = `$(components.#[_component_id="credentials::openstack"].domain_name)`
+	USERNAME         = `$(components.#[_component_id="credentials::openstack"].username)`
+	PASSWORD         = `$(components.#[_component_id="credentials::openstack"].password)`
+)
+
+func templNetworkID(nw string) string {
+	return `$(components.#[_component_id="` + "network::" + nw + `"].network_id)`
+}
+
+func templSubnetID(sn string) string {
+	return `$(components.#[_component_id="` + "subnet::" + sn + `"].subnet_id)`
+}
+
+func templSecurityGroupID(sg string) string {
+	return `$(components.#[_component_id="` + "security_group::" + sg + `"].security_group_id)`
+}
+
+func templVolumeID(vol string) string {
+	return `$(components.#[_component_id="` + "volume::" + vol + `"].volume_id)`
+}
+
+func templInstanceID(in string) string {
+	return `$(components.#[_component_id="` + "instance::" + in + `"].instance_id)`
+}
+
+func templInstanceIP(in string) string {
+	return `$(components.#[_component_id="` + "instance::" + in + `"].ip)`
+}
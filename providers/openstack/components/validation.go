@@ -0,0 +1,101 @@
+/* This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at http://mozilla.org/MPL/2.0/. */
+
+package components
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net"
+)
+
+const (
+	// PROTOCOLTCP : TCP Protocol value
+	PROTOCOLTCP = "tcp"
+	// PROTOCOLUDP : UDP Protocol value
+	PROTOCOLUDP = "udp"
+	// PROTOCOLICMP : ICMP Protocol value
+	PROTOCOLICMP = "icmp"
+	// PROTOCOLANY : Any Protocol value, neutron matches all protocols when none is set
+	PROTOCOLANY = ""
+	// OPENSTACKMAXNAME : Maximum length of a neutron or nova resource name
+	OPENSTACKMAXNAME = 255
+)
+
+func validateName(name, ctype string) error {
+	if name == "" {
+		return fmt.Errorf("%s name should not be null", ctype)
+	}
+
+	if len(name) > OPENSTACKMAXNAME {
+		return fmt.Errorf("%s name should not exceed %d characters", ctype, OPENSTACKMAXNAME)
+	}
+
+	return nil
+}
+
+func validateProtocol(p string) error {
+	switch p {
+	case PROTOCOLTCP, PROTOCOLUDP, PROTOCOLICMP, PROTOCOLANY:
+		return nil
+	}
+	return errors.New("Protocol is invalid")
+}
+
+// ValidatePort checks an string to be a valid TCP port
+func validatePort(port int, ptype string) error {
+	if port < 0 || port > 65535 {
+		return fmt.Errorf("%s Port (%d) is out of range [0 - 65535]", ptype, port)
+	}
+
+	return nil
+}
+
+// validateIPInCIDR : checks an address is a valid ip within the given cidr
+func validateIPInCIDR(ip, cidr string) error {
+	_, n, err := net.ParseCIDR(cidr)
+	if err != nil {
+		return err
+	}
+
+	addr := net.ParseIP(ip)
+	if addr == nil {
+		return fmt.Errorf("IP (%s) is not valid", ip)
+	}
+
+	if n.Contains(addr) != true {
+		return fmt.Errorf("IP (%s) is not within %s", ip, cidr)
+	}
+
+	return nil
+}
+
+// ipInRange : returns true if an ip falls between the start and end of a range
+func ipInRange(ip, start, end string) bool {
+	addr := net.ParseIP(ip).To16()
+	if addr == nil {
+		return false
+	}
+
+	return bytes.Compare(addr, net.ParseIP(start).To16()) >= 0 && bytes.Compare(addr, net.ParseIP(end).To16()) <= 0
+}
+
+func appendUnique(s []string, v string) []string {
+	for _, x := range s {
+		if x == v {
+			return s
+		}
+	}
+	return append(s, v)
+}
+
+func isOneOf(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,181 @@
+/* This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at http://mozilla.org/MPL/2.0/. */
+
+package components
+
+import (
+	"errors"
+	"reflect"
+
+	graph "gopkg.in/r3labs/graph.v2"
+)
+
+// Volume : mapping of a cinder block storage volume component
+type Volume struct {
+	ProviderType     string            `json:"_provider"`
+	ComponentType    string            `json:"_component"`
+	ComponentID      string            `json:"_component_id"`
+	State            string            `json:"_state"`
+	Action           string            `json:"_action"`
+	VolumeID         string            `json:"volume_id"`
+	Name             string            `json:"name"`
+	Size             *int64            `json:"size"`
+	VolumeType       string            `json:"volume_type"`
+	AvailabilityZone string            `json:"availability_zone"`
+	ImageID          string            `json:"image_id,omitempty"`
+	SnapshotID       string            `json:"snapshot_id,omitempty"`
+	Tags             map[string]string `json:"tags"`
+	DatacenterType   string            `json:"datacenter_type,omitempty"`
+	DatacenterName   string            `json:"datacenter_name,omitempty"`
+	DatacenterRegion string            `json:"datacenter_region"`
+	AuthURL          string            `json:"auth_url"`
+	ProjectName      string            `json:"project_name"`
+	DomainName       string            `json:"domain_name"`
+	Username         string            `json:"username"`
+	Password         string            `json:"password"`
+	Service          string            `json:"service"`
+}
+
+// GetID : returns the component's ID
+func (v *Volume) GetID() string {
+	return v.ComponentID
+}
+
+// GetName returns a components name
+func (v *Volume) GetName() string {
+	return v.Name
+}
+
+// GetProvider : returns the provider type
+func (v *Volume) GetProvider() string {
+	return v.ProviderType
+}
+
+// GetProviderID returns a components provider id
+func (v *Volume) GetProviderID() string {
+	return v.VolumeID
+}
+
+// GetType : returns the type of the component
+func (v *Volume) GetType() string {
+	return v.ComponentType
+}
+
+// GetState : returns the state of the component
+func (v *Volume) GetState() string {
+	return v.State
+}
+
+// SetState : sets the state of the component
+func (v *Volume) SetState(s string) {
+	v.State = s
+}
+
+// GetAction : returns the action of the component
+func (v *Volume) GetAction() string {
+	return v.Action
+}
+
+// SetAction : Sets the action of the component
+func (v *Volume) SetAction(s string) {
+	v.Action = s
+}
+
+// GetGroup : returns the components group
+func (v *Volume) GetGroup() string {
+	return ""
+}
+
+// GetTags returns a components tags
+func (v *Volume) GetTags() map[string]string {
+	return v.Tags
+}
+
+// GetTag returns a components tag
+func (v *Volume) GetTag(tag string) string {
+	return v.Tags[tag]
+}
+
+// Diff : diff's the component against another component of the same type.
+// Cinder volumes can only be extended in place
+func (v *Volume) Diff(c graph.Component) bool {
+	cv, ok := c.(*Volume)
+	if ok {
+		if v.Size != nil && cv.Size != nil {
+			if *v.Size != *cv.Size {
+				return true
+			}
+		}
+
+		if v.VolumeType != cv.VolumeType {
+			return true
+		}
+
+		return !reflect.DeepEqual(v.Tags, cv.Tags)
+	}
+
+	return false
+}
+
+// Update : updates the provider returned values of a component
+func (v *Volume) Update(c graph.Component) {
+	cv, ok := c.(*Volume)
+	if ok {
+		v.VolumeID = cv.VolumeID
+	}
+
+	v.SetDefaultVariables()
+}
+
+// Rebuild : rebuilds the component's internal state, such as templated values
+func (v *Volume) Rebuild(g *graph.Graph) {
+	v.SetDefaultVariables()
+}
+
+// Dependencies : returns a list of component id's upon which the component depends
+func (v *Volume) Dependencies() []string {
+	return []string{}
+}
+
+// Validate : validates the components values
+func (v *Volume) Validate() error {
+	err := validateName(v.Name, "Volume")
+	if err != nil {
+		return err
+	}
+
+	if v.Size == nil {
+		return errors.New("Volume size should not be null")
+	}
+
+	if *v.Size < 1 {
+		return errors.New("Volume size should be at least 1 GB")
+	}
+
+	if v.ImageID != "" && v.SnapshotID != "" {
+		return errors.New("Volume should not specify both an image and a snapshot")
+	}
+
+	return nil
+}
+
+// IsStateful : returns true if the component needs to be actioned to be removed.
+func (v *Volume) IsStateful() bool {
+	return true
+}
+
+// SetDefaultVariables : sets up the default template variables for a component
+func (v *Volume) SetDefaultVariables() {
+	v.ComponentType = TYPEVOLUME
+	v.ComponentID = TYPEVOLUME + TYPEDELIMITER + v.Name
+	v.ProviderType = PROVIDERTYPE
+	v.DatacenterName = DATACENTERNAME
+	v.DatacenterType = DATACENTERTYPE
+	v.DatacenterRegion = DATACENTERREGION
+	v.AuthURL = AUTHURL
+	v.ProjectName = PROJECTNAME
+	v.DomainName = DOMAINNAME
+	v.Username = USERNAME
+	v.Password = PASSWORD
+}
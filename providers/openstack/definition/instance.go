@@ -0,0 +1,28 @@
+/* This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at http://mozilla.org/MPL/2.0/. */
+
+package definition
+
+// InstanceVolume ...
+type InstanceVolume struct {
+	Volume string `json:"volume"`
+	Device string `json:"device"`
+}
+
+// Instance ...
+type Instance struct {
+	Name             string           `json:"name"`
+	Flavor           string           `json:"flavor"`
+	Image            string           `json:"image"`
+	Count            int              `json:"count"`
+	Subnet           string           `json:"subnet"`
+	StartIP          string           `json:"start_ip"`
+	KeyPair          string           `json:"key_pair"`
+	AvailabilityZone string           `json:"availability_zone"`
+	FloatingIP       bool             `json:"floating_ip"`
+	FloatingIPPool   string           `json:"floating_ip_pool"`
+	SecurityGroups   []string         `json:"security_groups"`
+	Volumes          []InstanceVolume `json:"volumes"`
+	UserData         string           `json:"user_data"`
+}
@@ -0,0 +1,33 @@
+/* This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at http://mozilla.org/MPL/2.0/. */
+
+package definition
+
+// LoadBalancerListener ...
+type LoadBalancerListener struct {
+	Protocol        string `json:"protocol"`
+	Port            int    `json:"port"`
+	BackendProtocol string `json:"backend_protocol"`
+	BackendPort     int    `json:"backend_port"`
+	Algorithm       string `json:"algorithm"`
+	Certificate     string `json:"certificate"`
+}
+
+// LoadBalancerMonitor ...
+type LoadBalancerMonitor struct {
+	Type       string `json:"type"`
+	Delay      int    `json:"delay"`
+	Timeout    int    `json:"timeout"`
+	MaxRetries int    `json:"max_retries"`
+	URLPath    string `json:"url_path"`
+}
+
+// LoadBalancer ...
+type LoadBalancer struct {
+	Name          string                 `json:"name"`
+	Subnet        string                 `json:"subnet"`
+	Instances     []string               `json:"instances"`
+	Listeners     []LoadBalancerListener `json:"listeners"`
+	HealthMonitor LoadBalancerMonitor    `json:"health_monitor"`
+}
@@ -0,0 +1,28 @@
+/* This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at http://mozilla.org/MPL/2.0/. */
+
+package definition
+
+// Network ...
+type Network struct {
+	Name         string `json:"name"`
+	PortSecurity *bool  `json:"port_security"`
+}
+
+// AllocationPool ...
+type AllocationPool struct {
+	Start string `json:"start"`
+	End   string `json:"end"`
+}
+
+// Subnet ...
+type Subnet struct {
+	Name            string           `json:"name"`
+	Network         string           `json:"network"`
+	Range           string           `json:"range"`
+	Gateway         string           `json:"gateway"`
+	DNS             []string         `json:"dns"`
+	DHCP            bool             `json:"dhcp"`
+	AllocationPools []AllocationPool `json:"allocation_pools"`
+}
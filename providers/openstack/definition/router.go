@@ -0,0 +1,13 @@
+/* This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at http://mozilla.org/MPL/2.0/. */
+
+package definition
+
+// Router ...
+type Router struct {
+	Name            string   `json:"name"`
+	ExternalNetwork string   `json:"external_network"`
+	SNAT            bool     `json:"snat"`
+	Subnets         []string `json:"subnets"`
+}
@@ -0,0 +1,16 @@
+/* This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at http://mozilla.org/MPL/2.0/. */
+
+package definition
+
+// Volume ...
+type Volume struct {
+	Name             string `json:"name"`
+	Type             string `json:"type"`
+	Size             *int64 `json:"size"`
+	Count            int    `json:"count"`
+	AvailabilityZone string `json:"availability_zone"`
+	Image            string `json:"image"`
+	SnapshotID       string `json:"snapshot_id"`
+}
@@ -0,0 +1,164 @@
+/* This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at http://mozilla.org/MPL/2.0/. */
+
+package mapper
+
+import (
+	"net"
+	"strconv"
+
+	"github.com/ernestio/libmapper/providers/openstack/components"
+	"github.com/ernestio/libmapper/providers/openstack/definition"
+	graph "gopkg.in/r3labs/graph.v2"
+)
+
+// MapInstances : Maps the instances from a given input payload.
+func MapInstances(d *definition.Definition) []*components.Instance {
+	var is []*components.Instance
+
+	for _, instance := range d.Instances {
+		var ip net.IP
+
+		if instance.StartIP != "" {
+			ip = make(net.IP, net.IPv4len)
+			copy(ip, net.ParseIP(instance.StartIP).To4())
+		}
+
+		for i := 0; i < instance.Count; i++ {
+			name := instance.Name + "-" + strconv.Itoa(i+1)
+
+			ci := &components.Instance{
+				Name:             name,
+				Flavor:           instance.Flavor,
+				Image:            instance.Image,
+				KeyPair:          instance.KeyPair,
+				AvailabilityZone: instance.AvailabilityZone,
+				Subnet:           instance.Subnet,
+				SecurityGroups:   instance.SecurityGroups,
+				UserData:         instance.UserData,
+				Tags:             mapInstanceTags(name, d.Name, instance.Name),
+			}
+
+			if ip != nil {
+				ci.IP = ip.String()
+
+				// Increment IP address
+				ip[3]++
+			}
+
+			for _, vol := range instance.Volumes {
+				ci.Volumes = append(ci.Volumes, components.InstanceVolume{
+					Volume: vol.Volume + "-" + strconv.Itoa(i+1),
+					Device: vol.Device,
+				})
+			}
+
+			ci.SetDefaultVariables()
+
+			is = append(is, ci)
+		}
+	}
+
+	return is
+}
+
+// MapFloatingIPs : Maps the floating ips requested by instance groups from a given input payload.
+func MapFloatingIPs(d *definition.Definition) []*components.FloatingIP {
+	var fips []*components.FloatingIP
+
+	for _, instance := range d.Instances {
+		if instance.FloatingIP != true {
+			continue
+		}
+
+		for i := 0; i < instance.Count; i++ {
+			name := instance.Name + "-" + strconv.Itoa(i+1)
+
+			f := &components.FloatingIP{
+				Name:     name,
+				Pool:     instance.FloatingIPPool,
+				Instance: name,
+				Tags:     mapFloatingIPTags(name, d.Name, instance.Name),
+			}
+
+			f.SetDefaultVariables()
+
+			fips = append(fips, f)
+		}
+	}
+
+	return fips
+}
+
+// MapDefinitionInstances : Maps output instances into a definition defined instances
+func MapDefinitionInstances(g *graph.Graph) []definition.Instance {
+	var instances []definition.Instance
+
+	ci := g.GetComponents().ByType("instance")
+
+	for _, ig := range ci.TagValues(components.GROUPINSTANCE) {
+		is := ci.ByGroup(components.GROUPINSTANCE, ig)
+
+		if len(is) < 1 {
+			continue
+		}
+
+		firstInstance := is[0].(*components.Instance)
+
+		instance := definition.Instance{
+			Name:             ig,
+			Flavor:           firstInstance.Flavor,
+			Image:            firstInstance.Image,
+			Count:            len(is),
+			Subnet:           firstInstance.Subnet,
+			StartIP:          firstInstance.IP,
+			KeyPair:          firstInstance.KeyPair,
+			AvailabilityZone: firstInstance.AvailabilityZone,
+			SecurityGroups:   firstInstance.SecurityGroups,
+			UserData:         firstInstance.UserData,
+		}
+
+		fips := g.GetComponents().ByType("floating_ip").ByGroup(components.GROUPFLOATINGIP, ig)
+		if len(fips) > 0 {
+			instance.FloatingIP = true
+			instance.FloatingIPPool = fips[0].(*components.FloatingIP).Pool
+		}
+
+		for _, vol := range firstInstance.Volumes {
+			vc := g.GetComponents().ByProviderID(vol.VolumeID)
+			if vc == nil {
+				continue
+			}
+
+			instance.Volumes = append(instance.Volumes, definition.InstanceVolume{
+				Device: vol.Device,
+				Volume: vc.GetTag(components.GROUPVOLUME),
+			})
+		}
+
+		instances = append(instances, instance)
+	}
+
+	return instances
+}
+
+func mapInstanceTags(name, service, instanceGroup string) map[string]string {
+	tags := make(map[string]string)
+
+	tags["Name"] = name
+	tags["ernest.service"] = service
+	tags[components.GROUPINSTANCE] = instanceGroup
+
+	return tags
+}
+
+func mapFloatingIPTags(name, service, instanceGroup string) map[string]string {
+	tags := make(map[string]string)
+
+	tags["Name"] = name
+	tags["ernest.service"] = service
+	tags[components.GROUPFLOATINGIP] = instanceGroup
+
+	return tags
+}
@@ -0,0 +1,86 @@
+/* This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at http://mozilla.org/MPL/2.0/. */
+
+package mapper
+
+import (
+	"github.com/ernestio/libmapper/providers/openstack/components"
+	"github.com/ernestio/libmapper/providers/openstack/definition"
+	graph "gopkg.in/r3labs/graph.v2"
+)
+
+// MapLoadBalancers : Maps the octavia load balancers from a given input payload.
+func MapLoadBalancers(d *definition.Definition) []*components.LoadBalancer {
+	var lbs []*components.LoadBalancer
+
+	for _, lb := range d.LoadBalancers {
+		l := &components.LoadBalancer{
+			Name:      lb.Name,
+			Subnet:    lb.Subnet,
+			Instances: lb.Instances,
+			HealthMonitor: components.LoadBalancerMonitor{
+				Type:       lb.HealthMonitor.Type,
+				Delay:      lb.HealthMonitor.Delay,
+				Timeout:    lb.HealthMonitor.Timeout,
+				MaxRetries: lb.HealthMonitor.MaxRetries,
+				URLPath:    lb.HealthMonitor.URLPath,
+			},
+			Tags: mapTags(lb.Name, d.Name),
+		}
+
+		for _, listener := range lb.Listeners {
+			l.Listeners = append(l.Listeners, components.LoadBalancerListener{
+				Protocol:        listener.Protocol,
+				Port:            listener.Port,
+				BackendProtocol: listener.BackendProtocol,
+				BackendPort:     listener.BackendPort,
+				Algorithm:       listener.Algorithm,
+				Certificate:     listener.Certificate,
+			})
+		}
+
+		l.SetDefaultVariables()
+
+		lbs = append(lbs, l)
+	}
+
+	return lbs
+}
+
+// MapDefinitionLoadBalancers : Maps components load balancers into a definition defined load balancers
+func MapDefinitionLoadBalancers(g *graph.Graph) []definition.LoadBalancer {
+	var lbs []definition.LoadBalancer
+
+	for _, c := range g.GetComponents().ByType("loadbalancer") {
+		l := c.(*components.LoadBalancer)
+
+		lb := definition.LoadBalancer{
+			Name:      l.Name,
+			Subnet:    l.Subnet,
+			Instances: l.Instances,
+			HealthMonitor: definition.LoadBalancerMonitor{
+				Type:       l.HealthMonitor.Type,
+				Delay:      l.HealthMonitor.Delay,
+				Timeout:    l.HealthMonitor.Timeout,
+				MaxRetries: l.HealthMonitor.MaxRetries,
+				URLPath:    l.HealthMonitor.URLPath,
+			},
+		}
+
+		for _, listener := range l.Listeners {
+			lb.Listeners = append(lb.Listeners, definition.LoadBalancerListener{
+				Protocol:        listener.Protocol,
+				Port:            listener.Port,
+				BackendProtocol: listener.BackendProtocol,
+				BackendPort:     listener.BackendPort,
+				Algorithm:       listener.Algorithm,
+				Certificate:     listener.Certificate,
+			})
+		}
+
+		lbs = append(lbs, lb)
+	}
+
+	return lbs
+}
@@ -0,0 +1,266 @@
+/* This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at http://mozilla.org/MPL/2.0/. */
+
+package mapper
+
+import (
+	"errors"
+
+	"github.com/ernestio/libmapper"
+	"github.com/ernestio/libmapper/providers/openstack/components"
+	def "github.com/ernestio/libmapper/providers/openstack/definition"
+	"github.com/mitchellh/mapstructure"
+	graph "gopkg.in/r3labs/graph.v2"
+)
+
+// SUPPORTEDCOMPONENTS represents all component types supported by ernest
+var SUPPORTEDCOMPONENTS = []string{"network", "subnet", "router", "security_group", "volume", "instance", "floating_ip", "loadbalancer"}
+
+// Mapper : implements the generic mapper structure
+type Mapper struct{}
+
+// New : returns a new openstack mapper
+func New() libmapper.Mapper {
+	return &Mapper{}
+}
+
+// ConvertDefinition : converts the input yaml definition to a graph format
+func (m Mapper) ConvertDefinition(gd libmapper.Definition) (*graph.Graph, error) {
+	g := graph.New()
+
+	d, ok := gd.(*def.Definition)
+	if ok != true {
+		return g, errors.New("Could not convert generic definition into openstack format")
+	}
+
+	// Map basic component values from definition
+	err := mapComponents(d, g)
+	if err != nil {
+		return g, err
+	}
+
+	for _, c := range g.Components {
+		// Build internal & template values
+		for _, dep := range c.Dependencies() {
+			if g.HasComponent(dep) != true {
+				return g, errors.New("Could not resolve component dependency: " + dep)
+			}
+		}
+
+		c.Rebuild(g)
+
+		// Validate Components
+		err := c.Validate()
+		if err != nil {
+			return g, err
+		}
+
+		// Build dependencies
+		for _, dep := range c.Dependencies() {
+			g.Connect(dep, c.GetID())
+		}
+	}
+
+	// Ensure the graph can be built
+	return g, libmapper.CheckCycles(g)
+}
+
+// ConvertGraph : converts the service graph into an input yaml format
+func (m Mapper) ConvertGraph(g *graph.Graph) (libmapper.Definition, error) {
+	var d def.Definition
+
+	for _, c := range g.Components {
+		c.Rebuild(g)
+
+		for _, dep := range c.Dependencies() {
+			if g.HasComponent(dep) != true {
+				return &d, errors.New("Could not resolve component dependency: " + dep)
+			}
+		}
+
+		err := c.Validate()
+		if err != nil {
+			return &d, err
+		}
+	}
+
+	d.Networks = MapDefinitionNetworks(g)
+	d.Subnets = MapDefinitionSubnets(g)
+	d.Routers = MapDefinitionRouters(g)
+	d.SecurityGroups = MapDefinitionSecurityGroups(g)
+	d.Volumes = MapDefinitionVolumes(g)
+	d.Instances = MapDefinitionInstances(g)
+	d.LoadBalancers = MapDefinitionLoadBalancers(g)
+
+	return &d, nil
+}
+
+// LoadDefinition : returns a openstack type definition
+func (m Mapper) LoadDefinition(gd map[string]interface{}) (libmapper.Definition, error) {
+	var d def.Definition
+
+	err := d.LoadMap(gd)
+
+	return &d, err
+}
+
+// LoadGraph : returns a generic interal graph
+func (m Mapper) LoadGraph(gg map[string]interface{}) (*graph.Graph, error) {
+	g := graph.New()
+
+	g.Load(gg)
+
+	for i := 0; i < len(g.Components); i++ {
+		gc := g.Components[i].(*graph.GenericComponent)
+
+		var c graph.Component
+
+		switch gc.GetType() {
+		case "network":
+			c = &components.Network{}
+		case "subnet":
+			c = &components.Subnet{}
+		case "router":
+			c = &components.Router{}
+		case "security_group":
+			c = &components.SecurityGroup{}
+		case "volume":
+			c = &components.Volume{}
+		case "instance":
+			c = &components.Instance{}
+		case "floating_ip":
+			c = &components.FloatingIP{}
+		case "loadbalancer":
+			c = &components.LoadBalancer{}
+		default:
+			continue
+		}
+
+		config := &mapstructure.DecoderConfig{
+			Metadata: nil,
+			Result:   c,
+			TagName:  "json",
+		}
+
+		decoder, err := mapstructure.NewDecoder(config)
+		if err != nil {
+			return g, err
+		}
+
+		err = decoder.Decode(gc)
+		if err != nil {
+			return g, err
+		}
+
+		g.Components[i] = c
+	}
+
+	return g, nil
+}
+
+// CreateImportGraph : creates a new graph with component queries used to import components from a provider.
+func (m Mapper) CreateImportGraph(params []string) *graph.Graph {
+	g := graph.New()
+	filter := make(map[string]string)
+
+	if len(params) > 0 {
+		filter["ernest.service"] = params[0]
+	}
+
+	for _, ctype := range SUPPORTEDCOMPONENTS {
+		q := MapQuery(ctype, filter)
+		g.AddComponent(q)
+	}
+
+	return g
+}
+
+// ProviderCredentials : maps openstack keystone v3 credentials to a generic component
+func (m Mapper) ProviderCredentials(details map[string]interface{}) graph.Component {
+	credentials := make(graph.GenericComponent)
+
+	credentials["_action"] = "none"
+	credentials["_component_id"] = "credentials::openstack"
+	credentials["_provider"] = details["type"]
+	credentials["name"] = details["name"]
+	credentials["region"] = details["region"]
+	credentials["auth_url"] = details["auth_url"]
+	credentials["project_name"] = details["project_name"]
+	credentials["domain_name"] = details["domain_name"]
+	credentials["username"] = details["username"]
+	credentials["password"] = details["password"]
+
+	return &credentials
+}
+
+func mapComponents(d *def.Definition, g *graph.Graph) error {
+	// Map basic component values from definition
+
+	for _, network := range MapNetworks(d) {
+		err := g.AddComponent(network)
+		if err != nil {
+			return err
+		}
+	}
+
+	for _, subnet := range MapSubnets(d) {
+		err := g.AddComponent(subnet)
+		if err != nil {
+			return err
+		}
+	}
+
+	for _, router := range MapRouters(d) {
+		err := g.AddComponent(router)
+		if err != nil {
+			return err
+		}
+	}
+
+	for _, sg := range MapSecurityGroups(d) {
+		err := g.AddComponent(sg)
+		if err != nil {
+			return err
+		}
+	}
+
+	for _, volume := range MapVolumes(d) {
+		err := g.AddComponent(volume)
+		if err != nil {
+			return err
+		}
+	}
+
+	for _, instance := range MapInstances(d) {
+		err := g.AddComponent(instance)
+		if err != nil {
+			return err
+		}
+	}
+
+	for _, fip := range MapFloatingIPs(d) {
+		err := g.AddComponent(fip)
+		if err != nil {
+			return err
+		}
+	}
+
+	for _, lb := range MapLoadBalancers(d) {
+		err := g.AddComponent(lb)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func mapTags(name, service string) map[string]string {
+	tags := make(map[string]string)
+
+	tags["Name"] = name
+	tags["ernest.service"] = service
+
+	return tags
+}
@@ -0,0 +1,34 @@
+/* This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at http://mozilla.org/MPL/2.0/. */
+
+package mapper
+
+import (
+	"testing"
+
+	"github.com/ernestio/libmapper"
+	"github.com/ernestio/libmapper/providers/openstack/definition"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestConvertDefinitionCycles(t *testing.T) {
+	Convey("Given a definition with security groups that reference each other", t, func() {
+		d := definition.New()
+		err := d.LoadJSON([]byte(`{"name":"test","security_groups":[
+			{"name":"a","ingress":[{"security_group":"b","from_port":"80","to_port":"80","protocol":"tcp"}]},
+			{"name":"b","ingress":[{"security_group":"a","from_port":"80","to_port":"80","protocol":"tcp"}]}
+		]}`))
+		So(err, ShouldBeNil)
+
+		Convey("When converting it to a graph", func() {
+			_, err := New().ConvertDefinition(d)
+
+			Convey("It should return the path of the cycle", func() {
+				So(err, ShouldResemble, &libmapper.CycleError{
+					Path: []string{"security_group::a", "security_group::b", "security_group::a"},
+				})
+			})
+		})
+	})
+}
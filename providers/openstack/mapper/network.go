@@ -0,0 +1,115 @@
+/* This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at http://mozilla.org/MPL/2.0/. */
+
+package mapper
+
+import (
+	"github.com/ernestio/libmapper/providers/openstack/components"
+	"github.com/ernestio/libmapper/providers/openstack/definition"
+	graph "gopkg.in/r3labs/graph.v2"
+)
+
+// MapNetworks : Maps the networks from a given input payload.
+func MapNetworks(d *definition.Definition) []*components.Network {
+	var networks []*components.Network
+
+	for _, network := range d.Networks {
+		n := &components.Network{
+			Name:         network.Name,
+			AdminStateUp: true,
+			PortSecurity: true,
+			Tags:         mapTags(network.Name, d.Name),
+		}
+
+		if network.PortSecurity != nil {
+			n.PortSecurity = *network.PortSecurity
+		}
+
+		n.SetDefaultVariables()
+
+		networks = append(networks, n)
+	}
+
+	return networks
+}
+
+// MapDefinitionNetworks : Maps components networks into a definition defined networks
+func MapDefinitionNetworks(g *graph.Graph) []definition.Network {
+	var networks []definition.Network
+
+	for _, c := range g.GetComponents().ByType("network") {
+		n := c.(*components.Network)
+
+		network := definition.Network{
+			Name: n.Name,
+		}
+
+		if n.PortSecurity != true {
+			network.PortSecurity = &n.PortSecurity
+		}
+
+		networks = append(networks, network)
+	}
+
+	return networks
+}
+
+// MapSubnets : Maps the subnets from a given input payload.
+func MapSubnets(d *definition.Definition) []*components.Subnet {
+	var subnets []*components.Subnet
+
+	for _, subnet := range d.Subnets {
+		sn := &components.Subnet{
+			Name:           subnet.Name,
+			Network:        subnet.Network,
+			CIDR:           subnet.Range,
+			GatewayIP:      subnet.Gateway,
+			DNSNameservers: subnet.DNS,
+			EnableDHCP:     subnet.DHCP,
+			Tags:           mapTags(subnet.Name, d.Name),
+		}
+
+		for _, pool := range subnet.AllocationPools {
+			sn.AllocationPools = append(sn.AllocationPools, components.AllocationPool{
+				Start: pool.Start,
+				End:   pool.End,
+			})
+		}
+
+		sn.SetDefaultVariables()
+
+		subnets = append(subnets, sn)
+	}
+
+	return subnets
+}
+
+// MapDefinitionSubnets : Maps components subnets into a definition defined subnets
+func MapDefinitionSubnets(g *graph.Graph) []definition.Subnet {
+	var subnets []definition.Subnet
+
+	for _, c := range g.GetComponents().ByType("subnet") {
+		sn := c.(*components.Subnet)
+
+		subnet := definition.Subnet{
+			Name:    sn.Name,
+			Network: sn.Network,
+			Range:   sn.CIDR,
+			Gateway: sn.GatewayIP,
+			DNS:     sn.DNSNameservers,
+			DHCP:    sn.EnableDHCP,
+		}
+
+		for _, pool := range sn.AllocationPools {
+			subnet.AllocationPools = append(subnet.AllocationPools, definition.AllocationPool{
+				Start: pool.Start,
+				End:   pool.End,
+			})
+		}
+
+		subnets = append(subnets, subnet)
+	}
+
+	return subnets
+}
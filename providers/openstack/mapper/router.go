@@ -0,0 +1,50 @@
+/* This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at http://mozilla.org/MPL/2.0/. */
+
+package mapper
+
+import (
+	"github.com/ernestio/libmapper/providers/openstack/components"
+	"github.com/ernestio/libmapper/providers/openstack/definition"
+	graph "gopkg.in/r3labs/graph.v2"
+)
+
+// MapRouters : Maps the routers from a given input payload.
+func MapRouters(d *definition.Definition) []*components.Router {
+	var routers []*components.Router
+
+	for _, router := range d.Routers {
+		r := &components.Router{
+			Name:              router.Name,
+			ExternalNetworkID: router.ExternalNetwork,
+			EnableSNAT:        router.SNAT,
+			Subnets:           router.Subnets,
+			Tags:              mapTags(router.Name, d.Name),
+		}
+
+		r.SetDefaultVariables()
+
+		routers = append(routers, r)
+	}
+
+	return routers
+}
+
+// MapDefinitionRouters : Maps components routers into a definition defined routers
+func MapDefinitionRouters(g *graph.Graph) []definition.Router {
+	var routers []definition.Router
+
+	for _, c := range g.GetComponents().ByType("router") {
+		r := c.(*components.Router)
+
+		routers = append(routers, definition.Router{
+			Name:            r.Name,
+			ExternalNetwork: r.ExternalNetworkID,
+			SNAT:            r.EnableSNAT,
+			Subnets:         r.Subnets,
+		})
+	}
+
+	return routers
+}
@@ -0,0 +1,79 @@
+/* This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at http://mozilla.org/MPL/2.0/. */
+
+package mapper
+
+import (
+	"strconv"
+
+	"github.com/ernestio/libmapper/providers/openstack/components"
+	"github.com/ernestio/libmapper/providers/openstack/definition"
+	graph "gopkg.in/r3labs/graph.v2"
+)
+
+// MapVolumes : Maps the volumes from a given input payload.
+func MapVolumes(d *definition.Definition) []*components.Volume {
+	var volumes []*components.Volume
+
+	for _, vol := range d.Volumes {
+		for i := 0; i < vol.Count; i++ {
+			name := vol.Name + "-" + strconv.Itoa(i+1)
+
+			v := &components.Volume{
+				Name:             name,
+				Size:             vol.Size,
+				VolumeType:       vol.Type,
+				AvailabilityZone: vol.AvailabilityZone,
+				ImageID:          vol.Image,
+				SnapshotID:       vol.SnapshotID,
+				Tags:             mapVolumeTags(name, d.Name, vol.Name),
+			}
+
+			v.SetDefaultVariables()
+
+			volumes = append(volumes, v)
+		}
+	}
+
+	return volumes
+}
+
+// MapDefinitionVolumes : Maps components volumes into a definition defined volumes
+func MapDefinitionVolumes(g *graph.Graph) []definition.Volume {
+	var vols []definition.Volume
+
+	ci := g.GetComponents().ByType("volume")
+
+	for _, vg := range ci.TagValues(components.GROUPVOLUME) {
+		vs := ci.ByGroup(components.GROUPVOLUME, vg)
+
+		if len(vs) < 1 {
+			continue
+		}
+
+		firstVolume := vs[0].(*components.Volume)
+
+		vols = append(vols, definition.Volume{
+			Name:             vg,
+			Type:             firstVolume.VolumeType,
+			Size:             firstVolume.Size,
+			Count:            len(vs),
+			AvailabilityZone: firstVolume.AvailabilityZone,
+			Image:            firstVolume.ImageID,
+			SnapshotID:       firstVolume.SnapshotID,
+		})
+	}
+
+	return vols
+}
+
+func mapVolumeTags(name, service, volumeGroup string) map[string]string {
+	tags := make(map[string]string)
+
+	tags["Name"] = name
+	tags["ernest.service"] = service
+	tags[components.GROUPVOLUME] = volumeGroup
+
+	return tags
+}
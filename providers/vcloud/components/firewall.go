@@ -0,0 +1,215 @@
+/* This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at http://mozilla.org/MPL/2.0/. */
+
+package components
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+
+	graph "gopkg.in/r3labs/graph.v2"
+)
+
+// FirewallRule ...
+type FirewallRule struct {
+	Name            string `json:"name"`
+	SourceIP        string `json:"source_ip"`
+	SourcePort      string `json:"source_port"`
+	DestinationIP   string `json:"destination_ip"`
+	DestinationPort string `json:"destination_port"`
+	Protocol        string `json:"protocol"`
+	Action          string `json:"action"`
+}
+
+// Firewall : mapping of the firewall rules of a vcloud edge gateway
+type Firewall struct {
+	ProviderType       string            `json:"_provider"`
+	ComponentType      string            `json:"_component"`
+	ComponentID        string            `json:"_component_id"`
+	State              string            `json:"_state"`
+	Action             string            `json:"_action"`
+	Name               string            `json:"name"`
+	Router             string            `json:"router"`
+	EdgeGatewayID      string            `json:"edge_gateway_id"`
+	Rules              []FirewallRule    `json:"rules"`
+	Tags               map[string]string `json:"tags"`
+	DatacenterType     string            `json:"datacenter_type,omitempty"`
+	DatacenterName     string            `json:"datacenter_name,omitempty"`
+	DatacenterOrg      string            `json:"datacenter_org"`
+	DatacenterUsername string            `json:"datacenter_username"`
+	DatacenterPassword string            `json:"datacenter_password"`
+	VCloudURL          string            `json:"vcloud_url"`
+	Service            string            `json:"service"`
+}
+
+// GetID : returns the component's ID
+func (f *Firewall) GetID() string {
+	return f.ComponentID
+}
+
+// GetName returns a components name
+func (f *Firewall) GetName() string {
+	return f.Name
+}
+
+// GetProvider : returns the provider type
+func (f *Firewall) GetProvider() string {
+	return f.ProviderType
+}
+
+// GetProviderID returns a components provider id
+func (f *Firewall) GetProviderID() string {
+	return f.Name
+}
+
+// GetType : returns the type of the component
+func (f *Firewall) GetType() string {
+	return f.ComponentType
+}
+
+// GetState : returns the state of the component
+func (f *Firewall) GetState() string {
+	return f.State
+}
+
+// SetState : sets the state of the component
+func (f *Firewall) SetState(s string) {
+	f.State = s
+}
+
+// GetAction : returns the action of the component
+func (f *Firewall) GetAction() string {
+	return f.Action
+}
+
+// SetAction : Sets the action of the component
+func (f *Firewall) SetAction(s string) {
+	f.Action = s
+}
+
+// GetGroup : returns the components group
+func (f *Firewall) GetGroup() string {
+	return ""
+}
+
+// GetTags returns a components tags
+func (f *Firewall) GetTags() map[string]string {
+	return f.Tags
+}
+
+// GetTag returns a components tag
+func (f *Firewall) GetTag(tag string) string {
+	return f.Tags[tag]
+}
+
+// Diff : diff's the component against another component of the same type
+func (f *Firewall) Diff(c graph.Component) bool {
+	cf, ok := c.(*Firewall)
+	if ok {
+		return !reflect.DeepEqual(f.Rules, cf.Rules)
+	}
+
+	return false
+}
+
+// Update : updates the provider returned values of a component
+func (f *Firewall) Update(c graph.Component) {
+	f.SetDefaultVariables()
+}
+
+// Rebuild : rebuilds the component's internal state, such as templated values
+func (f *Firewall) Rebuild(g *graph.Graph) {
+	if f.Router == "" {
+		f.Router = f.Name
+	}
+
+	if f.EdgeGatewayID == "" {
+		f.EdgeGatewayID = templEdgeGatewayID(f.Router)
+	}
+
+	for i := 0; i < len(f.Rules); i++ {
+		if f.Rules[i].Action == "" {
+			f.Rules[i].Action = "allow"
+		}
+
+		if f.Rules[i].SourcePort == "" {
+			f.Rules[i].SourcePort = TARGETANY
+		}
+
+		if f.Rules[i].DestinationPort == "" {
+			f.Rules[i].DestinationPort = TARGETANY
+		}
+	}
+
+	f.SetDefaultVariables()
+}
+
+// Dependencies : returns a list of component id's upon which the component depends
+func (f *Firewall) Dependencies() []string {
+	return []string{TYPEROUTER + TYPEDELIMITER + f.Router}
+}
+
+// Validate : validates the components values
+func (f *Firewall) Validate() error {
+	if f.Name == "" {
+		return errors.New("Firewall name should not be null")
+	}
+
+	for _, r := range f.Rules {
+		if r.Name == "" {
+			return errors.New("Firewall rule name should not be null")
+		}
+
+		err := validateTarget(r.SourceIP, "Firewall rule ("+r.Name+") source")
+		if err != nil {
+			return err
+		}
+
+		err = validateTarget(r.DestinationIP, "Firewall rule ("+r.Name+") destination")
+		if err != nil {
+			return err
+		}
+
+		err = validatePort(r.SourcePort, "Firewall rule ("+r.Name+") source")
+		if err != nil {
+			return err
+		}
+
+		err = validatePort(r.DestinationPort, "Firewall rule ("+r.Name+") destination")
+		if err != nil {
+			return err
+		}
+
+		err = validateProtocol(r.Protocol)
+		if err != nil {
+			return fmt.Errorf("Firewall rule (%s): %s", r.Name, err.Error())
+		}
+
+		if isOneOf([]string{"allow", "deny"}, strings.ToLower(r.Action)) != true {
+			return fmt.Errorf("Firewall rule (%s) action should be allow or deny", r.Name)
+		}
+	}
+
+	return nil
+}
+
+// IsStateful : returns true if the component needs to be actioned to be removed.
+func (f *Firewall) IsStateful() bool {
+	return true
+}
+
+// SetDefaultVariables : sets up the default template variables for a component
+func (f *Firewall) SetDefaultVariables() {
+	f.ComponentType = TYPEFIREWALL
+	f.ComponentID = TYPEFIREWALL + TYPEDELIMITER + f.Name
+	f.ProviderType = PROVIDERTYPE
+	f.DatacenterName = DATACENTERNAME
+	f.DatacenterType = DATACENTERTYPE
+	f.DatacenterOrg = DATACENTERORG
+	f.DatacenterUsername = DATACENTERUSERNAME
+	f.DatacenterPassword = DATACENTERPASSWORD
+	f.VCloudURL = VCLOUDURL
+}
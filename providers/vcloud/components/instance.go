@@ -0,0 +1,244 @@
+/* This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at http://mozilla.org/MPL/2.0/. */
+
+package components
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"reflect"
+	"strings"
+
+	graph "gopkg.in/r3labs/graph.v2"
+)
+
+// InstanceDisk ...
+type InstanceDisk struct {
+	ID   int `json:"id"`
+	Size int `json:"size"`
+}
+
+// Instance : mapping of a vcloud virtual machine component
+type Instance struct {
+	ProviderType       string            `json:"_provider"`
+	ComponentType      string            `json:"_component"`
+	ComponentID        string            `json:"_component_id"`
+	State              string            `json:"_state"`
+	Action             string            `json:"_action"`
+	InstanceID         string            `json:"instance_id"`
+	Name               string            `json:"name"`
+	Hostname           string            `json:"hostname"`
+	Catalog            string            `json:"reference_catalog"`
+	Image              string            `json:"reference_image"`
+	Cpus               int               `json:"cpus"`
+	Memory             int               `json:"ram"`
+	IP                 string            `json:"ip"`
+	Network            string            `json:"network_name"`
+	NetworkID          string            `json:"network_id"`
+	NetworkSubnet      string            `json:"network_range,omitempty"`
+	Disks              []InstanceDisk    `json:"disks"`
+	Tags               map[string]string `json:"tags"`
+	DatacenterType     string            `json:"datacenter_type,omitempty"`
+	DatacenterName     string            `json:"datacenter_name,omitempty"`
+	DatacenterOrg      string            `json:"datacenter_org"`
+	DatacenterUsername string            `json:"datacenter_username"`
+	DatacenterPassword string            `json:"datacenter_password"`
+	VCloudURL          string            `json:"vcloud_url"`
+	Service            string            `json:"service"`
+}
+
+// GetID : returns the component's ID
+func (i *Instance) GetID() string {
+	return i.ComponentID
+}
+
+// GetName returns a components name
+func (i *Instance) GetName() string {
+	return i.Name
+}
+
+// GetProvider : returns the provider type
+func (i *Instance) GetProvider() string {
+	return i.ProviderType
+}
+
+// GetProviderID returns a components provider id
+func (i *Instance) GetProviderID() string {
+	return i.InstanceID
+}
+
+// GetType : returns the type of the component
+func (i *Instance) GetType() string {
+	return i.ComponentType
+}
+
+// GetState : returns the state of the component
+func (i *Instance) GetState() string {
+	return i.State
+}
+
+// SetState : sets the state of the component
+func (i *Instance) SetState(s string) {
+	i.State = s
+}
+
+// GetAction : returns the action of the component
+func (i *Instance) GetAction() string {
+	return i.Action
+}
+
+// SetAction : Sets the action of the component
+func (i *Instance) SetAction(s string) {
+	i.Action = s
+}
+
+// GetGroup : returns the components group
+func (i *Instance) GetGroup() string {
+	return i.Tags[GROUPINSTANCE]
+}
+
+// GetTags returns a components tags
+func (i *Instance) GetTags() map[string]string {
+	return i.Tags
+}
+
+// GetTag returns a components tag
+func (i *Instance) GetTag(tag string) string {
+	return i.Tags[tag]
+}
+
+// Diff : diff's the component against another component of the same type
+func (i *Instance) Diff(c graph.Component) bool {
+	ci, ok := c.(*Instance)
+	if ok {
+		if i.Cpus != ci.Cpus ||
+			i.Memory != ci.Memory ||
+			i.Catalog != ci.Catalog ||
+			i.Image != ci.Image {
+			return true
+		}
+
+		return !reflect.DeepEqual(i.Disks, ci.Disks)
+	}
+
+	return false
+}
+
+// Update : updates the provider returned values of a component
+func (i *Instance) Update(c graph.Component) {
+	ci, ok := c.(*Instance)
+	if ok {
+		i.InstanceID = ci.InstanceID
+	}
+
+	i.SetDefaultVariables()
+}
+
+// Rebuild : rebuilds the component's internal state, such as templated values
+func (i *Instance) Rebuild(g *graph.Graph) {
+	if i.Network == "" && i.NetworkID != "" {
+		n := g.GetComponents().ByProviderID(i.NetworkID)
+		if n != nil {
+			i.Network = n.GetName()
+		}
+	}
+
+	if i.Network != "" && i.NetworkID == "" {
+		i.NetworkID = templNetworkID(i.Network)
+	}
+
+	for _, c := range g.GetComponents().ByType(TYPENETWORK) {
+		nw, ok := c.(*Network)
+		if ok && nw.Name == i.Network {
+			i.NetworkSubnet = nw.Subnet
+		}
+	}
+
+	if i.Hostname == "" {
+		i.Hostname = i.Name
+	}
+
+	i.SetDefaultVariables()
+}
+
+// Dependencies : returns a list of component id's upon which the component depends
+func (i *Instance) Dependencies() []string {
+	return []string{TYPENETWORK + TYPEDELIMITER + i.Network}
+}
+
+// Validate : validates the components values
+func (i *Instance) Validate() error {
+	if i.Name == "" {
+		return errors.New("Instance name should not be null")
+	}
+
+	if len(i.Hostname) > 63 {
+		return errors.New("Instance hostname should not exceed 63 characters")
+	}
+
+	if i.Catalog == "" || i.Image == "" {
+		return errors.New("Instance image should take the form of 'catalog/image'")
+	}
+
+	if i.Cpus < 1 {
+		return errors.New("Instance cpus should not be < 1")
+	}
+
+	if i.Memory < 512 {
+		return errors.New("Instance memory should not be < 512 (MB)")
+	}
+
+	if i.Network == "" {
+		return errors.New("Instance network should not be null")
+	}
+
+	if i.NetworkSubnet != "" {
+		err := validateIPInSubnet(i.IP, i.NetworkSubnet)
+		if err != nil {
+			return errors.New("Instance " + strings.Replace(err.Error(), "IP address", "ip", 1))
+		}
+	} else if net.ParseIP(i.IP) == nil {
+		return fmt.Errorf("Instance ip (%s) is not a valid ip address", i.IP)
+	}
+
+	ids := make(map[int]bool)
+
+	for _, d := range i.Disks {
+		// disk 0 holds the image's operating system
+		if d.ID < 1 || d.ID > 15 {
+			return fmt.Errorf("Instance disk id (%d) should be between 1 and 15", d.ID)
+		}
+
+		if ids[d.ID] {
+			return fmt.Errorf("Instance disk id (%d) is used more than once", d.ID)
+		}
+
+		if d.Size < 1 {
+			return fmt.Errorf("Instance disk (%d) size should be at least 1 (MB)", d.ID)
+		}
+
+		ids[d.ID] = true
+	}
+
+	return nil
+}
+
+// IsStateful : returns true if the component needs to be actioned to be removed.
+func (i *Instance) IsStateful() bool {
+	return true
+}
+
+// SetDefaultVariables : sets up the default template variables for a component
+func (i *Instance) SetDefaultVariables() {
+	i.ComponentType = TYPEINSTANCE
+	i.ComponentID = TYPEINSTANCE + TYPEDELIMITER + i.Name
+	i.ProviderType = PROVIDERTYPE
+	i.DatacenterName = DATACENTERNAME
+	i.DatacenterType = DATACENTERTYPE
+	i.DatacenterOrg = DATACENTERORG
+	i.DatacenterUsername = DATACENTERUSERNAME
+	i.DatacenterPassword = DATACENTERPASSWORD
+	i.VCloudURL = VCLOUDURL
+}
@@ -0,0 +1,238 @@
+/* This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at http://mozilla.org/MPL/2.0/. */
+
+package components
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"reflect"
+	"strings"
+
+	graph "gopkg.in/r3labs/graph.v2"
+)
+
+const (
+	// NATSOURCE : Source nat rule type
+	NATSOURCE = "snat"
+	// NATDESTINATION : Destination nat rule type
+	NATDESTINATION = "dnat"
+)
+
+// NatRule ...
+type NatRule struct {
+	Type            string `json:"type"`
+	Network         string `json:"network"`
+	OriginIP        string `json:"origin_ip"`
+	OriginPort      string `json:"origin_port"`
+	TranslationIP   string `json:"translation_ip"`
+	TranslationPort string `json:"translation_port"`
+	Protocol        string `json:"protocol"`
+}
+
+// Nat : mapping of the nat rules of a vcloud edge gateway
+type Nat struct {
+	ProviderType       string            `json:"_provider"`
+	ComponentType      string            `json:"_component"`
+	ComponentID        string            `json:"_component_id"`
+	State              string            `json:"_state"`
+	Action             string            `json:"_action"`
+	Name               string            `json:"name"`
+	Router             string            `json:"router"`
+	EdgeGatewayID      string            `json:"edge_gateway_id"`
+	Rules              []NatRule         `json:"rules"`
+	Tags               map[string]string `json:"tags"`
+	DatacenterType     string            `json:"datacenter_type,omitempty"`
+	DatacenterName     string            `json:"datacenter_name,omitempty"`
+	DatacenterOrg      string            `json:"datacenter_org"`
+	DatacenterUsername string            `json:"datacenter_username"`
+	DatacenterPassword string            `json:"datacenter_password"`
+	VCloudURL          string            `json:"vcloud_url"`
+	Service            string            `json:"service"`
+}
+
+// GetID : returns the component's ID
+func (x *Nat) GetID() string {
+	return x.ComponentID
+}
+
+// GetName returns a components name
+func (x *Nat) GetName() string {
+	return x.Name
+}
+
+// GetProvider : returns the provider type
+func (x *Nat) GetProvider() string {
+	return x.ProviderType
+}
+
+// GetProviderID returns a components provider id
+func (x *Nat) GetProviderID() string {
+	return x.Name
+}
+
+// GetType : returns the type of the component
+func (x *Nat) GetType() string {
+	return x.ComponentType
+}
+
+// GetState : returns the state of the component
+func (x *Nat) GetState() string {
+	return x.State
+}
+
+// SetState : sets the state of the component
+func (x *Nat) SetState(s string) {
+	x.State = s
+}
+
+// GetAction : returns the action of the component
+func (x *Nat) GetAction() string {
+	return x.Action
+}
+
+// SetAction : Sets the action of the component
+func (x *Nat) SetAction(s string) {
+	x.Action = s
+}
+
+// GetGroup : returns the components group
+func (x *Nat) GetGroup() string {
+	return ""
+}
+
+// GetTags returns a components tags
+func (x *Nat) GetTags() map[string]string {
+	return x.Tags
+}
+
+// GetTag returns a components tag
+func (x *Nat) GetTag(tag string) string {
+	return x.Tags[tag]
+}
+
+// Diff : diff's the component against another component of the same type
+func (x *Nat) Diff(c graph.Component) bool {
+	cx, ok := c.(*Nat)
+	if ok {
+		return !reflect.DeepEqual(x.Rules, cx.Rules)
+	}
+
+	return false
+}
+
+// Update : updates the provider returned values of a component
+func (x *Nat) Update(c graph.Component) {
+	x.SetDefaultVariables()
+}
+
+// Rebuild : rebuilds the component's internal state, such as templated values
+func (x *Nat) Rebuild(g *graph.Graph) {
+	if x.Router == "" {
+		x.Router = x.Name
+	}
+
+	if x.EdgeGatewayID == "" {
+		x.EdgeGatewayID = templEdgeGatewayID(x.Router)
+	}
+
+	for i := 0; i < len(x.Rules); i++ {
+		// source nat rules translate to, and destination nat rules
+		// originate from, the router's external address by default
+		switch x.Rules[i].Type {
+		case NATSOURCE:
+			if x.Rules[i].TranslationIP == "" {
+				x.Rules[i].TranslationIP = templRouterIP(x.Router)
+			}
+		case NATDESTINATION:
+			if x.Rules[i].OriginIP == "" {
+				x.Rules[i].OriginIP = templRouterIP(x.Router)
+			}
+		}
+
+		if x.Rules[i].Protocol == "" {
+			x.Rules[i].Protocol = PROTOCOLANY
+		}
+	}
+
+	x.SetDefaultVariables()
+}
+
+// Dependencies : returns a list of component id's upon which the component depends
+func (x *Nat) Dependencies() []string {
+	var deps []string
+
+	for _, r := range x.Rules {
+		if r.Network != "" {
+			deps = appendUnique(deps, TYPENETWORK+TYPEDELIMITER+r.Network)
+		}
+	}
+
+	deps = append(deps, TYPEROUTER+TYPEDELIMITER+x.Router)
+
+	return deps
+}
+
+// Validate : validates the components values
+func (x *Nat) Validate() error {
+	if x.Name == "" {
+		return errors.New("Nat name should not be null")
+	}
+
+	for _, r := range x.Rules {
+		switch r.Type {
+		case NATSOURCE:
+			_, _, err := net.ParseCIDR(r.OriginIP)
+			if err != nil && net.ParseIP(r.OriginIP) == nil {
+				return fmt.Errorf("Nat source rule origin (%s) should be a valid ip address or cidr", r.OriginIP)
+			}
+
+			if r.OriginPort != "" || r.TranslationPort != "" {
+				return errors.New("Nat source rules should not specify ports")
+			}
+		case NATDESTINATION:
+			if strings.HasPrefix(r.TranslationIP, "$(") != true && net.ParseIP(r.TranslationIP) == nil {
+				return fmt.Errorf("Nat destination rule translation (%s) should be a valid ip address", r.TranslationIP)
+			}
+
+			err := validatePort(r.OriginPort, "Nat destination rule origin")
+			if err != nil {
+				return err
+			}
+
+			err = validatePort(r.TranslationPort, "Nat destination rule translation")
+			if err != nil {
+				return err
+			}
+		default:
+			return fmt.Errorf("Nat rule type (%s) should be either %s or %s", r.Type, NATSOURCE, NATDESTINATION)
+		}
+
+		err := validateProtocol(r.Protocol)
+		if err != nil {
+			return errors.New("Nat rule: " + err.Error())
+		}
+	}
+
+	return nil
+}
+
+// IsStateful : returns true if the component needs to be actioned to be removed.
+func (x *Nat) IsStateful() bool {
+	return true
+}
+
+// SetDefaultVariables : sets up the default template variables for a component
+func (x *Nat) SetDefaultVariables() {
+	x.ComponentType = TYPENAT
+	x.ComponentID = TYPENAT + TYPEDELIMITER + x.Name
+	x.ProviderType = PROVIDERTYPE
+	x.DatacenterName = DATACENTERNAME
+	x.DatacenterType = DATACENTERTYPE
+	x.DatacenterOrg = DATACENTERORG
+	x.DatacenterUsername = DATACENTERUSERNAME
+	x.DatacenterPassword = DATACENTERPASSWORD
+	x.VCloudURL = VCLOUDURL
+}
@@ -0,0 +1,351 @@
+/* This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at http://mozilla.org/MPL/2.0/. */
+
+package components
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net"
+	"reflect"
+
+	graph "gopkg.in/r3labs/graph.v2"
+)
+
+// NetworkDHCP ...
+type NetworkDHCP struct {
+	Enabled          bool   `json:"enabled"`
+	StartAddress     string `json:"start_address"`
+	EndAddress       string `json:"end_address"`
+	DefaultLeaseTime int    `json:"default_lease_time"`
+	MaxLeaseTime     int    `json:"max_lease_time"`
+}
+
+// Network : mapping of a vcloud org vdc network component
+type Network struct {
+	ProviderType       string            `json:"_provider"`
+	ComponentType      string            `json:"_component"`
+	ComponentID        string            `json:"_component_id"`
+	State              string            `json:"_state"`
+	Action             string            `json:"_action"`
+	NetworkID          string            `json:"network_id"`
+	Name               string            `json:"name"`
+	Router             string            `json:"router"`
+	EdgeGatewayID      string            `json:"edge_gateway_id"`
+	Subnet             string            `json:"range"`
+	Gateway            string            `json:"gateway"`
+	Netmask            string            `json:"netmask"`
+	StartAddress       string            `json:"start_address"`
+	EndAddress         string            `json:"end_address"`
+	DHCP               NetworkDHCP       `json:"dhcp"`
+	DNS                []string          `json:"dns"`
+	Tags               map[string]string `json:"tags"`
+	DatacenterType     string            `json:"datacenter_type,omitempty"`
+	DatacenterName     string            `json:"datacenter_name,omitempty"`
+	DatacenterOrg      string            `json:"datacenter_org"`
+	DatacenterUsername string            `json:"datacenter_username"`
+	DatacenterPassword string            `json:"datacenter_password"`
+	VCloudURL          string            `json:"vcloud_url"`
+	Service            string            `json:"service"`
+}
+
+// GetID : returns the component's ID
+func (n *Network) GetID() string {
+	return n.ComponentID
+}
+
+// GetName returns a components name
+func (n *Network) GetName() string {
+	return n.Name
+}
+
+// GetProvider : returns the provider type
+func (n *Network) GetProvider() string {
+	return n.ProviderType
+}
+
+// GetProviderID returns a components provider id
+func (n *Network) GetProviderID() string {
+	return n.NetworkID
+}
+
+// GetType : returns the type of the component
+func (n *Network) GetType() string {
+	return n.ComponentType
+}
+
+// GetState : returns the state of the component
+func (n *Network) GetState() string {
+	return n.State
+}
+
+// SetState : sets the state of the component
+func (n *Network) SetState(s string) {
+	n.State = s
+}
+
+// GetAction : returns the action of the component
+func (n *Network) GetAction() string {
+	return n.Action
+}
+
+// SetAction : Sets the action of the component
+func (n *Network) SetAction(s string) {
+	n.Action = s
+}
+
+// GetGroup : returns the components group
+func (n *Network) GetGroup() string {
+	return ""
+}
+
+// GetTags returns a components tags
+func (n *Network) GetTags() map[string]string {
+	return n.Tags
+}
+
+// GetTag returns a components tag
+func (n *Network) GetTag(tag string) string {
+	return n.Tags[tag]
+}
+
+// Diff : diff's the component against another component of the same type
+func (n *Network) Diff(c graph.Component) bool {
+	cn, ok := c.(*Network)
+	if ok {
+		if n.StartAddress != cn.StartAddress ||
+			n.EndAddress != cn.EndAddress {
+			return true
+		}
+
+		if reflect.DeepEqual(n.DHCP, cn.DHCP) != true {
+			return true
+		}
+
+		return !reflect.DeepEqual(n.DNS, cn.DNS)
+	}
+
+	return false
+}
+
+// Update : updates the provider returned values of a component
+func (n *Network) Update(c graph.Component) {
+	cn, ok := c.(*Network)
+	if ok {
+		n.NetworkID = cn.NetworkID
+	}
+
+	n.SetDefaultVariables()
+}
+
+// Rebuild : rebuilds the component's internal state, such as templated values
+func (n *Network) Rebuild(g *graph.Graph) {
+	if n.Router == "" && n.EdgeGatewayID != "" {
+		r := g.GetComponents().ByProviderID(n.EdgeGatewayID)
+		if r != nil {
+			n.Router = r.GetName()
+		}
+	}
+
+	if n.Router != "" && n.EdgeGatewayID == "" {
+		n.EdgeGatewayID = templEdgeGatewayID(n.Router)
+	}
+
+	_, cidr, err := net.ParseCIDR(n.Subnet)
+	if err == nil && cidr.IP.To4() != nil {
+		// defaults follow the ernest convention of reserving the first
+		// and last few addresses of a network for the gateway and services
+		if n.Gateway == "" {
+			n.Gateway = offsetIP(cidr.IP, 1)
+		}
+
+		if n.Netmask == "" {
+			n.Netmask = net.IP(cidr.Mask).String()
+		}
+
+		if n.StartAddress == "" && n.EndAddress == "" {
+			n.StartAddress, n.EndAddress = n.defaultStaticPool(cidr)
+		}
+	}
+
+	n.SetDefaultVariables()
+}
+
+// Dependencies : returns a list of component id's upon which the component depends
+func (n *Network) Dependencies() []string {
+	if n.Router == "" {
+		return []string{}
+	}
+
+	return []string{TYPEROUTER + TYPEDELIMITER + n.Router}
+}
+
+// Validate : validates the components values
+func (n *Network) Validate() error {
+	if n.Name == "" {
+		return errors.New("Network name should not be null")
+	}
+
+	_, cidr, err := net.ParseCIDR(n.Subnet)
+	if err != nil || cidr.IP.To4() == nil {
+		return errors.New("Network CIDR is not valid")
+	}
+
+	ones, _ := cidr.Mask.Size()
+	if ones > 29 {
+		return errors.New("Network CIDR should be at least a /29")
+	}
+
+	err = validateIPInSubnet(n.Gateway, n.Subnet)
+	if err != nil {
+		return errors.New("Network gateway: " + err.Error())
+	}
+
+	if n.StartAddress != "" || n.EndAddress != "" {
+		err = validateRange(n.StartAddress, n.EndAddress, n.Subnet)
+		if err != nil {
+			return errors.New("Network static pool: " + err.Error())
+		}
+	}
+
+	if n.DHCP.Enabled {
+		err = validateRange(n.DHCP.StartAddress, n.DHCP.EndAddress, n.Subnet)
+		if err != nil {
+			return errors.New("Network dhcp pool: " + err.Error())
+		}
+
+		if n.StartAddress != "" && rangesOverlap(n.StartAddress, n.EndAddress, n.DHCP.StartAddress, n.DHCP.EndAddress) {
+			return errors.New("Network dhcp pool should not overlap the static pool")
+		}
+
+		if n.DHCP.MaxLeaseTime > 0 && n.DHCP.DefaultLeaseTime > n.DHCP.MaxLeaseTime {
+			return errors.New("Network dhcp default lease time should not exceed the max lease time")
+		}
+	}
+
+	for _, dns := range n.DNS {
+		if net.ParseIP(dns) == nil {
+			return fmt.Errorf("Network dns server (%s) is not a valid ip address", dns)
+		}
+	}
+
+	return nil
+}
+
+// IsStateful : returns true if the component needs to be actioned to be removed.
+func (n *Network) IsStateful() bool {
+	return true
+}
+
+// SetDefaultVariables : sets up the default template variables for a component
+func (n *Network) SetDefaultVariables() {
+	n.ComponentType = TYPENETWORK
+	n.ComponentID = TYPENETWORK + TYPEDELIMITER + n.Name
+	n.ProviderType = PROVIDERTYPE
+	n.DatacenterName = DATACENTERNAME
+	n.DatacenterType = DATACENTERTYPE
+	n.DatacenterOrg = DATACENTERORG
+	n.DatacenterUsername = DATACENTERUSERNAME
+	n.DatacenterPassword = DATACENTERPASSWORD
+	n.VCloudURL = VCLOUDURL
+}
+
+// defaultStaticPool : returns the largest range of addresses not used by the
+// gateway or the dhcp pool. Networks large enough to spare them keep the first
+// and last few addresses reserved. If no addresses are free, no pool is returned
+func (n *Network) defaultStaticPool(cidr *net.IPNet) (string, string) {
+	first := ipToInt(cidr.IP) + 1
+	last := ipToInt(lastIP(cidr)) - 1
+
+	if last-first >= 32 {
+		first = first + 4
+		last = last - 4
+	}
+
+	gw := net.ParseIP(n.Gateway).To4()
+	if gw != nil && ipToInt(gw) == first {
+		first++
+	}
+
+	if gw != nil && ipToInt(gw) == last {
+		last--
+	}
+
+	ds := net.ParseIP(n.DHCP.StartAddress).To4()
+	de := net.ParseIP(n.DHCP.EndAddress).To4()
+
+	if n.DHCP.Enabled && ds != nil && de != nil {
+		// use whichever side of the dhcp pool has the most free addresses
+		below := ipToInt(ds) - 1
+		above := ipToInt(de) + 1
+
+		if below > last {
+			below = last
+		}
+
+		if above < first {
+			above = first
+		}
+
+		if below-first >= last-above {
+			last = below
+		} else {
+			first = above
+		}
+	}
+
+	if first > last {
+		return "", ""
+	}
+
+	return intToIP(first), intToIP(last)
+}
+
+func ipToInt(ip net.IP) int {
+	x := ip.To4()
+	return int(x[0])<<24 | int(x[1])<<16 | int(x[2])<<8 | int(x[3])
+}
+
+func intToIP(v int) string {
+	return net.IPv4(byte(v>>24), byte(v>>16), byte(v>>8), byte(v)).String()
+}
+
+func offsetIP(ip net.IP, offset int) string {
+	return intToIP(ipToInt(ip) + offset)
+}
+
+func lastIP(cidr *net.IPNet) net.IP {
+	ip := make(net.IP, net.IPv4len)
+	for i := range ip {
+		ip[i] = cidr.IP.To4()[i] | ^cidr.Mask[i]
+	}
+
+	return ip
+}
+
+func validateRange(start, end, subnet string) error {
+	err := validateIPInSubnet(start, subnet)
+	if err != nil {
+		return err
+	}
+
+	err = validateIPInSubnet(end, subnet)
+	if err != nil {
+		return err
+	}
+
+	if bytes.Compare(net.ParseIP(start).To4(), net.ParseIP(end).To4()) > 0 {
+		return fmt.Errorf("start address (%s) should not be after the end address (%s)", start, end)
+	}
+
+	return nil
+}
+
+func rangesOverlap(s1, e1, s2, e2 string) bool {
+	a1, b1 := net.ParseIP(s1).To4(), net.ParseIP(e1).To4()
+	a2, b2 := net.ParseIP(s2).To4(), net.ParseIP(e2).To4()
+
+	return bytes.Compare(a1, b2) <= 0 && bytes.Compare(a2, b1) <= 0
+}
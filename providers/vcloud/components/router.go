@@ -0,0 +1,158 @@
+/* This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at http://mozilla.org/MPL/2.0/. */
+
+package components
+
+import (
+	"errors"
+
+	graph "gopkg.in/r3labs/graph.v2"
+)
+
+// Router : mapping of a vcloud edge gateway component
+type Router struct {
+	ProviderType       string            `json:"_provider"`
+	ComponentType      string            `json:"_component"`
+	ComponentID        string            `json:"_component_id"`
+	State              string            `json:"_state"`
+	Action             string            `json:"_action"`
+	EdgeGatewayID      string            `json:"edge_gateway_id"`
+	Name               string            `json:"name"`
+	EdgeGateway        string            `json:"edge_gateway"`
+	IP                 string            `json:"ip"`
+	Tags               map[string]string `json:"tags"`
+	DatacenterType     string            `json:"datacenter_type,omitempty"`
+	DatacenterName     string            `json:"datacenter_name,omitempty"`
+	DatacenterOrg      string            `json:"datacenter_org"`
+	DatacenterUsername string            `json:"datacenter_username"`
+	DatacenterPassword string            `json:"datacenter_password"`
+	VCloudURL          string            `json:"vcloud_url"`
+	Service            string            `json:"service"`
+}
+
+// GetID : returns the component's ID
+func (r *Router) GetID() string {
+	return r.ComponentID
+}
+
+// GetName returns a components name
+func (r *Router) GetName() string {
+	return r.Name
+}
+
+// GetProvider : returns the provider type
+func (r *Router) GetProvider() string {
+	return r.ProviderType
+}
+
+// GetProviderID returns a components provider id
+func (r *Router) GetProviderID() string {
+	return r.EdgeGatewayID
+}
+
+// GetType : returns the type of the component
+func (r *Router) GetType() string {
+	return r.ComponentType
+}
+
+// GetState : returns the state of the component
+func (r *Router) GetState() string {
+	return r.State
+}
+
+// SetState : sets the state of the component
+func (r *Router) SetState(s string) {
+	r.State = s
+}
+
+// GetAction : returns the action of the component
+func (r *Router) GetAction() string {
+	return r.Action
+}
+
+// SetAction : Sets the action of the component
+func (r *Router) SetAction(s string) {
+	r.Action = s
+}
+
+// GetGroup : returns the components group
+func (r *Router) GetGroup() string {
+	return ""
+}
+
+// GetTags returns a components tags
+func (r *Router) GetTags() map[string]string {
+	return r.Tags
+}
+
+// GetTag returns a components tag
+func (r *Router) GetTag(tag string) string {
+	return r.Tags[tag]
+}
+
+// Diff : diff's the component against another component of the same type
+func (r *Router) Diff(c graph.Component) bool {
+	cr, ok := c.(*Router)
+	if ok {
+		return r.EdgeGateway != cr.EdgeGateway
+	}
+
+	return false
+}
+
+// Update : updates the provider returned values of a component
+func (r *Router) Update(c graph.Component) {
+	cr, ok := c.(*Router)
+	if ok {
+		r.EdgeGatewayID = cr.EdgeGatewayID
+		r.IP = cr.IP
+	}
+
+	r.SetDefaultVariables()
+}
+
+// Rebuild : rebuilds the component's internal state, such as templated values
+func (r *Router) Rebuild(g *graph.Graph) {
+	if r.EdgeGateway == "" {
+		r.EdgeGateway = r.Name
+	}
+
+	r.SetDefaultVariables()
+}
+
+// Dependencies : returns a list of component id's upon which the component depends
+func (r *Router) Dependencies() []string {
+	return []string{}
+}
+
+// Validate : validates the components values
+func (r *Router) Validate() error {
+	if r.Name == "" {
+		return errors.New("Router name should not be null")
+	}
+
+	if r.EdgeGateway == "" {
+		return errors.New("Router edge gateway should not be null")
+	}
+
+	return nil
+}
+
+// IsStateful : returns true if the component needs to be actioned to be removed.
+func (r *Router) IsStateful() bool {
+	return true
+}
+
+// SetDefaultVariables : sets up the default template variables for a component
+func (r *Router) SetDefaultVariables() {
+	r.ComponentType = TYPEROUTER
+	r.ComponentID = TYPEROUTER + TYPEDELIMITER + r.Name
+	r.ProviderType = PROVIDERTYPE
+	r.DatacenterName = DATACENTERNAME
+	r.DatacenterType = DATACENTERTYPE
+	r.DatacenterOrg = DATACENTERORG
+	r.DatacenterUsername = DATACENTERUSERNAME
+	r.DatacenterPassword = DATACENTERPASSWORD
+	r.VCloudURL = VCLOUDURL
+}
@@ -0,0 +1,36 @@
+/* This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at http://mozilla.org/MPL/2.0/. */
+
+package components
+
+const (
+	TYPEDELIMITER = "::"
+	TYPEROUTER    = "router"
+	TYPENETWORK   = "network"
+	TYPEFIREWALL  = "firewall"
+	TYPENAT       = "nat"
+	TYPEINSTANCE  = "instance"
+
+	GROUPINSTANCE = "ernest.instance_group"
+
+	PROVIDERTYPE       = `$(components.#[_component_id="credentials::vcloud"]._provider)`
+	DATACENTERNAME     = `$(components.#[_component_id="credentials::vcloud"].name)`
+	DATACENTERTYPE     = `$(components.#[_component_id="credentials::vcloud"]._provider)`
+	DATACENTERORG      = `$(components.#[_component_id="credentials::vcloud"].org)`
+	DATACENTERUSERNAME = `$(components.#[_component_id="credentials::vcloud"].username)`
+	DATACENTERPASSWORD = `$(components.#[_component_id="credentials::vcloud"].password)`
+	VCLOUDURL          = `$(components.#[_component_id="credentials::vcloud"].vcloud_url)`
+)
+
+func templEdgeGatewayID(router string) string {
+	return `$(components.#[_component_id="` + "router::" + router + `"].edge_gateway_id)`
+}
+
+func templRouterIP(router string) string {
+	return `$(components.#[_component_id="` + "router::" + router + `"].ip)`
+}
+
+func templNetworkID(nw string) string {
+	return `$(components.#[_component_id="` + "network::" + nw + `"].network_id)`
+}
@@ -0,0 +1,111 @@
+/* This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at http://mozilla.org/MPL/2.0/. */
+
+package components
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"strconv"
+	"strings"
+)
+
+const (
+	// PROTOCOLTCP : TCP Protocol value
+	PROTOCOLTCP = "tcp"
+	// PROTOCOLUDP : UDP Protocol value
+	PROTOCOLUDP = "udp"
+	// PROTOCOLTCPUDP : TCP & UDP Protocol value
+	PROTOCOLTCPUDP = "tcp & udp"
+	// PROTOCOLICMP : ICMP Protocol value
+	PROTOCOLICMP = "icmp"
+	// PROTOCOLANY : Any Protocol value
+	PROTOCOLANY = "any"
+	// TARGETEXTERNAL : External target
+	TARGETEXTERNAL = "external"
+	// TARGETINTERNAL : Internal target
+	TARGETINTERNAL = "internal"
+	// TARGETANY : Any Target
+	TARGETANY = "any"
+)
+
+func validateProtocol(p string) error {
+	switch strings.ToLower(p) {
+	case PROTOCOLTCP, PROTOCOLUDP, PROTOCOLTCPUDP, PROTOCOLICMP, PROTOCOLANY:
+		return nil
+	}
+	return fmt.Errorf("Protocol (%s) is invalid", p)
+}
+
+// validatePort : checks a port is either 'any', a single port or a range of ports, i.e. '8000-8080'
+func validatePort(port, ptype string) error {
+	if port == "" || strings.ToLower(port) == TARGETANY {
+		return nil
+	}
+
+	for _, p := range strings.Split(port, "-") {
+		x, err := strconv.Atoi(p)
+		if err != nil || x < 1 || x > 65535 {
+			return fmt.Errorf("%s Port (%s) is out of range [1 - 65535]", ptype, port)
+		}
+	}
+
+	return nil
+}
+
+// validateTarget : checks a rule target is a known alias, an ip address or a cidr range
+func validateTarget(target, ttype string) error {
+	switch strings.ToLower(target) {
+	case TARGETANY, TARGETINTERNAL, TARGETEXTERNAL:
+		return nil
+	}
+
+	if net.ParseIP(target) != nil {
+		return nil
+	}
+
+	_, _, err := net.ParseCIDR(target)
+	if err != nil {
+		return fmt.Errorf("%s (%s) is not a valid ip address or cidr", ttype, target)
+	}
+
+	return nil
+}
+
+func validateIPInSubnet(ip, subnet string) error {
+	_, cidr, err := net.ParseCIDR(subnet)
+	if err != nil {
+		return errors.New("Subnet is not a valid CIDR")
+	}
+
+	address := net.ParseIP(ip)
+	if address == nil || address.To4() == nil {
+		return fmt.Errorf("IP address (%s) is not a valid ipv4 address", ip)
+	}
+
+	if cidr.Contains(address) != true {
+		return fmt.Errorf("IP address (%s) is not within the subnet (%s)", ip, subnet)
+	}
+
+	return nil
+}
+
+func appendUnique(s []string, v string) []string {
+	for _, x := range s {
+		if x == v {
+			return s
+		}
+	}
+	return append(s, v)
+}
+
+func isOneOf(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
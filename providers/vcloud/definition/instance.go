@@ -0,0 +1,23 @@
+/* This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at http://mozilla.org/MPL/2.0/. */
+
+package definition
+
+// InstanceDisk ...
+type InstanceDisk struct {
+	ID   int `json:"id"`
+	Size int `json:"size"`
+}
+
+// Instance ...
+type Instance struct {
+	Name    string         `json:"name"`
+	Image   string         `json:"image"`
+	Count   int            `json:"count"`
+	Cpus    int            `json:"cpus"`
+	Memory  int            `json:"memory"`
+	Network string         `json:"network"`
+	StartIP string         `json:"start_ip"`
+	Disks   []InstanceDisk `json:"disks"`
+}
@@ -0,0 +1,29 @@
+/* This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at http://mozilla.org/MPL/2.0/. */
+
+package definition
+
+// NetworkPool ...
+type NetworkPool struct {
+	Start string `json:"start"`
+	End   string `json:"end"`
+}
+
+// NetworkDHCP ...
+type NetworkDHCP struct {
+	Start     string `json:"start"`
+	End       string `json:"end"`
+	LeaseTime int    `json:"lease_time"`
+}
+
+// Network ...
+type Network struct {
+	Name       string       `json:"name"`
+	Router     string       `json:"router"`
+	Subnet     string       `json:"subnet"`
+	Gateway    string       `json:"gateway"`
+	DNS        []string     `json:"dns"`
+	StaticPool *NetworkPool `json:"static_pool"`
+	DHCP       *NetworkDHCP `json:"dhcp"`
+}
@@ -0,0 +1,33 @@
+/* This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at http://mozilla.org/MPL/2.0/. */
+
+package definition
+
+// FirewallRule ...
+type FirewallRule struct {
+	Name        string `json:"name"`
+	Source      string `json:"source"`
+	FromPort    string `json:"from_port"`
+	Destination string `json:"destination"`
+	ToPort      string `json:"to_port"`
+	Protocol    string `json:"protocol"`
+	Action      string `json:"action"`
+}
+
+// PortForwarding ...
+type PortForwarding struct {
+	Source      string `json:"source"`
+	FromPort    string `json:"from_port"`
+	Destination string `json:"destination"`
+	ToPort      string `json:"to_port"`
+	Protocol    string `json:"protocol"`
+}
+
+// Router ...
+type Router struct {
+	Name           string           `json:"name"`
+	EdgeGateway    string           `json:"edge_gateway"`
+	Rules          []FirewallRule   `json:"rules"`
+	PortForwarding []PortForwarding `json:"port_forwarding"`
+}
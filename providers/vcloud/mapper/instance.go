@@ -0,0 +1,113 @@
+/* This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at http://mozilla.org/MPL/2.0/. */
+
+package mapper
+
+import (
+	"net"
+	"strconv"
+	"strings"
+
+	"github.com/ernestio/libmapper/providers/vcloud/components"
+	"github.com/ernestio/libmapper/providers/vcloud/definition"
+	graph "gopkg.in/r3labs/graph.v2"
+)
+
+// MapInstances : Maps the instances from a given input payload.
+func MapInstances(d *definition.Definition) []*components.Instance {
+	var is []*components.Instance
+
+	for _, instance := range d.Instances {
+		ip := make(net.IP, net.IPv4len)
+		copy(ip, net.ParseIP(instance.StartIP).To4())
+
+		catalog, image := mapImage(instance.Image)
+
+		for i := 0; i < instance.Count; i++ {
+			name := instance.Name + "-" + strconv.Itoa(i+1)
+
+			ci := &components.Instance{
+				Name:    name,
+				Catalog: catalog,
+				Image:   image,
+				Cpus:    instance.Cpus,
+				Memory:  instance.Memory,
+				Network: instance.Network,
+				IP:      ip.String(),
+				Tags:    mapInstanceTags(name, d.Name, instance.Name),
+			}
+
+			for _, disk := range instance.Disks {
+				ci.Disks = append(ci.Disks, components.InstanceDisk{
+					ID:   disk.ID,
+					Size: disk.Size,
+				})
+			}
+
+			ci.SetDefaultVariables()
+
+			is = append(is, ci)
+
+			// Increment IP address
+			ip[3]++
+		}
+	}
+
+	return is
+}
+
+// MapDefinitionInstances : Maps output instances into a definition defined instances
+func MapDefinitionInstances(g *graph.Graph) []definition.Instance {
+	var instances []definition.Instance
+
+	ci := g.GetComponents().ByType("instance")
+
+	for _, ig := range ci.TagValues("ernest.instance_group") {
+		is := ci.ByGroup("ernest.instance_group", ig)
+
+		if len(is) < 1 {
+			continue
+		}
+
+		firstInstance := is[0].(*components.Instance)
+
+		instance := definition.Instance{
+			Name:    ig,
+			Image:   firstInstance.Catalog + "/" + firstInstance.Image,
+			Cpus:    firstInstance.Cpus,
+			Memory:  firstInstance.Memory,
+			Network: firstInstance.Network,
+			StartIP: firstInstance.IP,
+			Count:   len(is),
+		}
+
+		for _, disk := range firstInstance.Disks {
+			instance.Disks = append(instance.Disks, definition.InstanceDisk{
+				ID:   disk.ID,
+				Size: disk.Size,
+			})
+		}
+
+		instances = append(instances, instance)
+	}
+
+	return instances
+}
+
+// mapImage : splits an image reference of the form 'catalog/image'
+func mapImage(image string) (string, string) {
+	parts := strings.SplitN(image, "/", 2)
+	if len(parts) != 2 {
+		return "", image
+	}
+
+	return parts[0], parts[1]
+}
+
+func mapInstanceTags(name, service, instanceGroup string) map[string]string {
+	tags := mapTags(name, service)
+	tags["ernest.instance_group"] = instanceGroup
+
+	return tags
+}
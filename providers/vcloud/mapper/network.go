@@ -0,0 +1,80 @@
+/* This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at http://mozilla.org/MPL/2.0/. */
+
+package mapper
+
+import (
+	"github.com/ernestio/libmapper/providers/vcloud/components"
+	"github.com/ernestio/libmapper/providers/vcloud/definition"
+	graph "gopkg.in/r3labs/graph.v2"
+)
+
+// MapNetworks : Maps the networks from a given input payload.
+func MapNetworks(d *definition.Definition) []*components.Network {
+	var networks []*components.Network
+
+	for _, network := range d.Networks {
+		n := &components.Network{
+			Name:    network.Name,
+			Router:  network.Router,
+			Subnet:  network.Subnet,
+			Gateway: network.Gateway,
+			DNS:     network.DNS,
+			Tags:    mapTags(network.Name, d.Name),
+		}
+
+		if network.StaticPool != nil {
+			n.StartAddress = network.StaticPool.Start
+			n.EndAddress = network.StaticPool.End
+		}
+
+		if network.DHCP != nil {
+			n.DHCP = components.NetworkDHCP{
+				Enabled:          true,
+				StartAddress:     network.DHCP.Start,
+				EndAddress:       network.DHCP.End,
+				DefaultLeaseTime: network.DHCP.LeaseTime,
+			}
+		}
+
+		n.SetDefaultVariables()
+
+		networks = append(networks, n)
+	}
+
+	return networks
+}
+
+// MapDefinitionNetworks : Maps components networks into a definition defined networks
+func MapDefinitionNetworks(g *graph.Graph) []definition.Network {
+	var networks []definition.Network
+
+	for _, c := range g.GetComponents().ByType("network") {
+		n := c.(*components.Network)
+
+		network := definition.Network{
+			Name:    n.Name,
+			Router:  n.Router,
+			Subnet:  n.Subnet,
+			Gateway: n.Gateway,
+			DNS:     n.DNS,
+			StaticPool: &definition.NetworkPool{
+				Start: n.StartAddress,
+				End:   n.EndAddress,
+			},
+		}
+
+		if n.DHCP.Enabled {
+			network.DHCP = &definition.NetworkDHCP{
+				Start:     n.DHCP.StartAddress,
+				End:       n.DHCP.EndAddress,
+				LeaseTime: n.DHCP.DefaultLeaseTime,
+			}
+		}
+
+		networks = append(networks, network)
+	}
+
+	return networks
+}
@@ -0,0 +1,175 @@
+/* This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at http://mozilla.org/MPL/2.0/. */
+
+package mapper
+
+import (
+	"strings"
+
+	"github.com/ernestio/libmapper/providers/vcloud/components"
+	"github.com/ernestio/libmapper/providers/vcloud/definition"
+	graph "gopkg.in/r3labs/graph.v2"
+)
+
+// MapRouters : Maps the routers from a given input payload.
+func MapRouters(d *definition.Definition) []*components.Router {
+	var routers []*components.Router
+
+	for _, router := range d.Routers {
+		r := &components.Router{
+			Name:        router.Name,
+			EdgeGateway: router.EdgeGateway,
+			Tags:        mapTags(router.Name, d.Name),
+		}
+
+		r.SetDefaultVariables()
+
+		routers = append(routers, r)
+	}
+
+	return routers
+}
+
+// MapFirewalls : Maps the firewall rules of each router from a given input payload.
+func MapFirewalls(d *definition.Definition) []*components.Firewall {
+	var firewalls []*components.Firewall
+
+	for _, router := range d.Routers {
+		f := &components.Firewall{
+			Name:   router.Name,
+			Router: router.Name,
+			Tags:   mapTags(router.Name, d.Name),
+		}
+
+		for _, rule := range router.Rules {
+			f.Rules = append(f.Rules, components.FirewallRule{
+				Name:            rule.Name,
+				SourceIP:        rule.Source,
+				SourcePort:      rule.FromPort,
+				DestinationIP:   rule.Destination,
+				DestinationPort: rule.ToPort,
+				Protocol:        rule.Protocol,
+				Action:          rule.Action,
+			})
+		}
+
+		f.SetDefaultVariables()
+
+		firewalls = append(firewalls, f)
+	}
+
+	return firewalls
+}
+
+// MapNats : Maps the nat rules of each router from a given input payload. Port forwarding
+// rules become destination nat rules, and every network attached to the router gets an
+// outbound source nat rule
+func MapNats(d *definition.Definition) []*components.Nat {
+	var nats []*components.Nat
+
+	for _, router := range d.Routers {
+		n := &components.Nat{
+			Name:   router.Name,
+			Router: router.Name,
+			Tags:   mapTags(router.Name, d.Name),
+		}
+
+		for _, pf := range router.PortForwarding {
+			n.Rules = append(n.Rules, components.NatRule{
+				Type:            components.NATDESTINATION,
+				OriginIP:        pf.Source,
+				OriginPort:      pf.FromPort,
+				TranslationIP:   pf.Destination,
+				TranslationPort: pf.ToPort,
+				Protocol:        pf.Protocol,
+			})
+		}
+
+		for _, network := range d.Networks {
+			if network.Router != router.Name {
+				continue
+			}
+
+			n.Rules = append(n.Rules, components.NatRule{
+				Type:     components.NATSOURCE,
+				Network:  network.Name,
+				OriginIP: network.Subnet,
+			})
+		}
+
+		n.SetDefaultVariables()
+
+		nats = append(nats, n)
+	}
+
+	return nats
+}
+
+// MapDefinitionRouters : Maps components routers, firewalls and nats into a definition defined routers
+func MapDefinitionRouters(g *graph.Graph) []definition.Router {
+	var routers []definition.Router
+
+	for _, c := range g.GetComponents().ByType("router") {
+		r := c.(*components.Router)
+
+		router := definition.Router{
+			Name: r.Name,
+		}
+
+		if r.EdgeGateway != r.Name {
+			router.EdgeGateway = r.EdgeGateway
+		}
+
+		for _, fc := range g.GetComponents().ByType("firewall") {
+			f := fc.(*components.Firewall)
+			if f.Router != r.Name {
+				continue
+			}
+
+			for _, rule := range f.Rules {
+				router.Rules = append(router.Rules, definition.FirewallRule{
+					Name:        rule.Name,
+					Source:      rule.SourceIP,
+					FromPort:    rule.SourcePort,
+					Destination: rule.DestinationIP,
+					ToPort:      rule.DestinationPort,
+					Protocol:    rule.Protocol,
+					Action:      rule.Action,
+				})
+			}
+		}
+
+		for _, nc := range g.GetComponents().ByType("nat") {
+			n := nc.(*components.Nat)
+			if n.Router != r.Name {
+				continue
+			}
+
+			// source nat rules are derived from the router's networks
+			for _, rule := range n.Rules {
+				if rule.Type != components.NATDESTINATION {
+					continue
+				}
+
+				pf := definition.PortForwarding{
+					Source:      rule.OriginIP,
+					FromPort:    rule.OriginPort,
+					Destination: rule.TranslationIP,
+					ToPort:      rule.TranslationPort,
+					Protocol:    rule.Protocol,
+				}
+
+				if rule.OriginIP == r.IP || strings.HasPrefix(rule.OriginIP, "$(") {
+					pf.Source = ""
+				}
+
+				router.PortForwarding = append(router.PortForwarding, pf)
+			}
+		}
+
+		routers = append(routers, router)
+	}
+
+	return routers
+}
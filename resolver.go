@@ -0,0 +1,429 @@
+/* This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at http://mozilla.org/MPL/2.0/. */
+
+package libmapper
+
+import (
+	"encoding/json"
+	"errors"
+	"reflect"
+	"regexp"
+	"sort"
+	"strings"
+
+	graph "gopkg.in/r3labs/graph.v2"
+)
+
+const (
+	unresolved = iota
+	resolving
+	resolved
+	failed
+)
+
+// template : matches a reference to another component's field,
+// i.e. $(components.#[_component_id="network::web"].network_aws_id)
+var template = regexp.MustCompile(`\$\(components\.#\[_component_id="([^"]+)"\]\.([\w.]+)\)`)
+
+// UnresolvedReference : a templated value that could not be substituted
+type UnresolvedReference struct {
+	ComponentID string
+	Field       string
+	Reference   string
+	Target      string
+}
+
+// ResolveError : lists all templated values that could not be resolved
+type ResolveError struct {
+	// Dangling : references to components that do not exist
+	Dangling []UnresolvedReference
+	// Unresolved : references to fields that have no value
+	Unresolved []UnresolvedReference
+	// Cycles : chains of fields that reference each other, i.e. [a.x, b.y, a.x]
+	Cycles [][]string
+}
+
+// Error : returns a description of all unresolved references
+func (e *ResolveError) Error() string {
+	msgs := []string{"Could not resolve templates:"}
+
+	for _, r := range e.Dangling {
+		msgs = append(msgs, r.ComponentID+"."+r.Field+" references unknown component "+r.Reference)
+	}
+
+	for _, r := range e.Unresolved {
+		msgs = append(msgs, r.ComponentID+"."+r.Field+" references "+r.Reference+"."+r.Target+", which has no value")
+	}
+
+	for _, c := range e.Cycles {
+		msgs = append(msgs, "template cycle "+strings.Join(c, " -> "))
+	}
+
+	return strings.Join(msgs, "\n  ")
+}
+
+func (e *ResolveError) empty() bool {
+	return len(e.Dangling) < 1 && len(e.Unresolved) < 1 && len(e.Cycles) < 1
+}
+
+// merge : adds the references of another error that have not already been reported
+func (e *ResolveError) merge(o *ResolveError) {
+	for _, r := range o.Dangling {
+		if hasReference(e.Dangling, r) != true {
+			e.Dangling = append(e.Dangling, r)
+		}
+	}
+
+	for _, r := range o.Unresolved {
+		if hasReference(e.Unresolved, r) != true {
+			e.Unresolved = append(e.Unresolved, r)
+		}
+	}
+
+	for _, c := range o.Cycles {
+		if hasCycle(e.Cycles, c) != true {
+			e.Cycles = append(e.Cycles, c)
+		}
+	}
+}
+
+// Resolver : substitutes templated values with the fields of the components they reference.
+// Fields are resolved on demand, so components can be resolved in any order
+type Resolver struct {
+	values map[string]map[string]interface{}
+	state  map[string]map[string]int
+	errs   map[string]*ResolveError
+	stack  []string
+	err    *ResolveError
+}
+
+// NewResolver : returns a new resolver without any components
+func NewResolver() *Resolver {
+	return &Resolver{
+		values: make(map[string]map[string]interface{}),
+		state:  make(map[string]map[string]int),
+		errs:   make(map[string]*ResolveError),
+	}
+}
+
+// Set : sets the values of a component, replacing any values previously set
+func (r *Resolver) Set(id string, v map[string]interface{}) {
+	r.values[id] = v
+	r.state[id] = make(map[string]int)
+}
+
+// Resolve : resolves all templated values of a component. Values that cannot be
+// resolved are left untouched and reported in the returned *ResolveError
+func (r *Resolver) Resolve(id string) (map[string]interface{}, error) {
+	v, ok := r.values[id]
+	if ok != true {
+		return nil, errors.New("Could not resolve templates, component " + id + " does not exist")
+	}
+
+	r.err = &ResolveError{}
+
+	for _, k := range keys(v) {
+		r.field(id, k)
+	}
+
+	if r.err.empty() {
+		return v, nil
+	}
+
+	return v, r.err
+}
+
+// field : resolves a top level field of a component, returning false if it
+// could not be fully resolved
+func (r *Resolver) field(id, key string) bool {
+	name := id + "." + key
+
+	switch r.state[id][key] {
+	case resolved:
+		return true
+	case failed:
+		r.err.merge(r.errs[name])
+		return false
+	case resolving:
+		for i := range r.stack {
+			if r.stack[i] == name {
+				cycle := append([]string{}, r.stack[i:]...)
+				r.err.Cycles = append(r.err.Cycles, append(cycle, name))
+			}
+		}
+		return false
+	}
+
+	r.state[id][key] = resolving
+	r.stack = append(r.stack, name)
+
+	// errors are kept for each field, so they are reported again
+	// whenever a field that failed to resolve is reached
+	parent := r.err
+	r.err = &ResolveError{}
+
+	v, ok := r.resolve(id, key, r.values[id][key])
+
+	r.stack = r.stack[:len(r.stack)-1]
+
+	ferr := r.err
+	r.err = parent
+	r.err.merge(ferr)
+
+	if ok != true {
+		r.state[id][key] = failed
+		r.errs[name] = ferr
+		return false
+	}
+
+	r.values[id][key] = v
+	r.state[id][key] = resolved
+
+	return true
+}
+
+func (r *Resolver) resolve(id, key string, v interface{}) (interface{}, bool) {
+	switch x := v.(type) {
+	case string:
+		return r.resolveString(id, key, x)
+	case []interface{}:
+		rv := make([]interface{}, len(x))
+		ok := true
+		for i := range x {
+			var rok bool
+			rv[i], rok = r.resolve(id, key, x[i])
+			ok = ok && rok
+		}
+		return rv, ok
+	case map[string]interface{}:
+		rv := make(map[string]interface{})
+		ok := true
+		for _, k := range keys(x) {
+			var rok bool
+			rv[k], rok = r.resolve(id, key, x[k])
+			ok = ok && rok
+		}
+		return rv, ok
+	}
+
+	return v, true
+}
+
+func (r *Resolver) resolveString(id, key, s string) (interface{}, bool) {
+	matches := template.FindAllStringSubmatchIndex(s, -1)
+	if len(matches) < 1 {
+		return s, true
+	}
+
+	var b strings.Builder
+	var value interface{}
+
+	last := 0
+	ok := true
+
+	for _, m := range matches {
+		ref := UnresolvedReference{
+			ComponentID: id,
+			Field:       key,
+			Reference:   s[m[2]:m[3]],
+			Target:      s[m[4]:m[5]],
+		}
+
+		_, exists := r.values[ref.Reference]
+		if exists != true {
+			r.err.Dangling = append(r.err.Dangling, ref)
+			ok = false
+			continue
+		}
+
+		// the referenced field may itself be templated
+		if r.field(ref.Reference, strings.Split(ref.Target, ".")[0]) != true {
+			ok = false
+			continue
+		}
+
+		value = lookup(r.values[ref.Reference], ref.Target)
+		if value == nil || value == "" {
+			r.err.Unresolved = append(r.err.Unresolved, ref)
+			ok = false
+			continue
+		}
+
+		b.WriteString(s[last:m[0]])
+		b.WriteString(toString(value))
+		last = m[1]
+	}
+
+	if ok != true {
+		return s, false
+	}
+
+	// a template that makes up the whole value keeps the type of the field it references
+	if len(matches) == 1 && matches[0][0] == 0 && matches[0][1] == len(s) {
+		return value, true
+	}
+
+	b.WriteString(s[last:])
+
+	return b.String(), true
+}
+
+// Resolve : returns a copy of the graph with all templated values substituted.
+// If any value could not be resolved, the partially resolved copy is returned
+// along with a *ResolveError describing all dangling, unresolved and cyclic references
+func Resolve(g *graph.Graph) (*graph.Graph, error) {
+	r := NewResolver()
+
+	for _, c := range g.Components {
+		v, err := ToMap(c)
+		if err != nil {
+			return nil, err
+		}
+		r.Set(c.GetID(), v)
+	}
+
+	// components being deleted only exist in the changes, and may reference each other
+	for _, c := range g.Changes {
+		if g.HasComponent(c.GetID()) {
+			continue
+		}
+
+		v, err := ToMap(c)
+		if err != nil {
+			return nil, err
+		}
+		r.Set(c.GetID(), v)
+	}
+
+	rerr := &ResolveError{}
+
+	ng := *g
+	ng.Components = make([]graph.Component, len(g.Components))
+	ng.Changes = make([]graph.Component, len(g.Changes))
+	ng.Edges = append(ng.Edges[:0:0], g.Edges...)
+
+	for i, c := range g.Components {
+		v, err := r.Resolve(c.GetID())
+		if rv, ok := err.(*ResolveError); ok {
+			rerr.merge(rv)
+		} else if err != nil {
+			return nil, err
+		}
+
+		ng.Components[i], err = FromMap(c, v)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// changes are resolved against the graph's components and deleted components,
+	// but not added to the graph's components
+	for i, c := range g.Changes {
+		v, err := ToMap(c)
+		if err != nil {
+			return nil, err
+		}
+
+		r.err = &ResolveError{}
+
+		for _, k := range keys(v) {
+			v[k], _ = r.resolve(c.GetID(), k, v[k])
+		}
+
+		rerr.merge(r.err)
+
+		ng.Changes[i], err = FromMap(c, v)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if rerr.empty() {
+		return &ng, nil
+	}
+
+	return &ng, rerr
+}
+
+// lookup : returns the value of a dot separated field
+func lookup(c map[string]interface{}, field string) interface{} {
+	var v interface{} = c
+
+	for _, key := range strings.Split(field, ".") {
+		m, ok := v.(map[string]interface{})
+		if ok != true {
+			return nil
+		}
+		v = m[key]
+	}
+
+	return v
+}
+
+func hasReference(refs []UnresolvedReference, ref UnresolvedReference) bool {
+	for _, r := range refs {
+		if r == ref {
+			return true
+		}
+	}
+
+	return false
+}
+
+func hasCycle(cycles [][]string, cycle []string) bool {
+	for _, c := range cycles {
+		if reflect.DeepEqual(c, cycle) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func keys(m map[string]interface{}) []string {
+	var k []string
+
+	for key := range m {
+		k = append(k, key)
+	}
+
+	sort.Strings(k)
+
+	return k
+}
+
+func toString(v interface{}) string {
+	s, ok := v.(string)
+	if ok {
+		return s
+	}
+
+	data, _ := json.Marshal(v)
+
+	return string(data)
+}
+
+// ToMap : returns the values of a component, keyed by their json field names
+func ToMap(c graph.Component) (map[string]interface{}, error) {
+	var v map[string]interface{}
+
+	data, err := json.Marshal(c)
+	if err != nil {
+		return nil, err
+	}
+
+	return v, json.Unmarshal(data, &v)
+}
+
+// FromMap : builds a new component of the same type as c from its values
+func FromMap(c graph.Component, v map[string]interface{}) (graph.Component, error) {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+
+	rc := reflect.New(reflect.TypeOf(c).Elem()).Interface().(graph.Component)
+
+	return rc, json.Unmarshal(data, rc)
+}
@@ -0,0 +1,155 @@
+/* This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at http://mozilla.org/MPL/2.0/. */
+
+package libmapper
+
+import (
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+	graph "gopkg.in/r3labs/graph.v2"
+)
+
+func TestResolve(t *testing.T) {
+	Convey("Given a graph with components that reference each other", t, func() {
+		g := testGraph([]graph.Component{
+			&testComponent{ID: "t::a", Value: "a", Ref: ref("t::b", "value") + "-" + ref("t::c", "value")},
+			&testComponent{ID: "t::b", Value: "b"},
+			&testComponent{ID: "t::c", Value: ref("t::b", "value")},
+		}, nil)
+
+		Convey("When resolving the graph", func() {
+			ng, err := Resolve(g)
+
+			Convey("It should substitute all templated values", func() {
+				So(err, ShouldBeNil)
+				So(ng.Components[0].(*testComponent).Ref, ShouldEqual, "b-b")
+				So(ng.Components[2].(*testComponent).Value, ShouldEqual, "b")
+			})
+
+			Convey("It should not change the original graph", func() {
+				So(g.Components[2].(*testComponent).Value, ShouldEqual, ref("t::b", "value"))
+			})
+		})
+	})
+
+	Convey("Given a graph with a reference to a component that does not exist", t, func() {
+		g := testGraph([]graph.Component{
+			&testComponent{ID: "t::a", Ref: ref("t::missing", "value")},
+		}, nil)
+
+		Convey("When resolving the graph", func() {
+			_, err := Resolve(g)
+
+			Convey("It should report the dangling reference", func() {
+				So(err, ShouldResemble, &ResolveError{
+					Dangling: []UnresolvedReference{
+						{ComponentID: "t::a", Field: "ref", Reference: "t::missing", Target: "value"},
+					},
+				})
+			})
+		})
+	})
+
+	Convey("Given a graph with a reference to a field without a value", t, func() {
+		g := testGraph([]graph.Component{
+			&testComponent{ID: "t::a", Ref: ref("t::b", "value")},
+			&testComponent{ID: "t::b"},
+		}, nil)
+
+		Convey("When resolving the graph", func() {
+			_, err := Resolve(g)
+
+			Convey("It should report the unresolved reference", func() {
+				So(err, ShouldResemble, &ResolveError{
+					Unresolved: []UnresolvedReference{
+						{ComponentID: "t::a", Field: "ref", Reference: "t::b", Target: "value"},
+					},
+				})
+			})
+		})
+	})
+
+	Convey("Given a graph with fields that reference each other", t, func() {
+		g := testGraph([]graph.Component{
+			&testComponent{ID: "t::a", Value: ref("t::b", "value")},
+			&testComponent{ID: "t::b", Value: ref("t::a", "value")},
+		}, nil)
+
+		Convey("When resolving the graph", func() {
+			_, err := Resolve(g)
+
+			Convey("It should report the cycle once", func() {
+				So(err, ShouldResemble, &ResolveError{
+					Cycles: [][]string{{"t::a.value", "t::b.value", "t::a.value"}},
+				})
+			})
+		})
+	})
+
+	Convey("Given a graph with deleted components that reference each other", t, func() {
+		g := testGraph(nil, []graph.Component{
+			&testComponent{ID: "t::a", Action: "delete", Value: "a"},
+			&testComponent{ID: "t::b", Action: "delete", Ref: ref("t::a", "value")},
+		})
+
+		Convey("When resolving the graph", func() {
+			ng, err := Resolve(g)
+
+			Convey("It should resolve the deleted components against each other", func() {
+				So(err, ShouldBeNil)
+				So(ng.Changes[1].(*testComponent).Ref, ShouldEqual, "a")
+			})
+		})
+	})
+}
+
+func TestResolverResolve(t *testing.T) {
+	Convey("Given a resolver with a chain of references to a missing component", t, func() {
+		r := NewResolver()
+		r.Set("t::a", map[string]interface{}{"value": ref("t::b", "value")})
+		r.Set("t::b", map[string]interface{}{"value": ref("t::c", "value")})
+
+		Convey("When resolving the first component", func() {
+			_, err := r.Resolve("t::a")
+
+			Convey("It should report the dangling reference", func() {
+				So(err, ShouldNotBeNil)
+				So(err.(*ResolveError).Dangling, ShouldHaveLength, 1)
+			})
+
+			Convey("And when resolving the second component", func() {
+				v, err := r.Resolve("t::b")
+
+				Convey("It should report the same dangling reference", func() {
+					So(err, ShouldResemble, &ResolveError{
+						Dangling: []UnresolvedReference{
+							{ComponentID: "t::b", Field: "value", Reference: "t::c", Target: "value"},
+						},
+					})
+					So(v["value"], ShouldEqual, ref("t::c", "value"))
+				})
+			})
+		})
+	})
+
+	Convey("Given a resolver with fields that reference each other", t, func() {
+		r := NewResolver()
+		r.Set("t::a", map[string]interface{}{"value": ref("t::b", "value")})
+		r.Set("t::b", map[string]interface{}{"value": ref("t::a", "value")})
+
+		Convey("When resolving both components in turn", func() {
+			_, aerr := r.Resolve("t::a")
+			v, berr := r.Resolve("t::b")
+
+			Convey("It should report the cycle for each component", func() {
+				cycles := [][]string{{"t::a.value", "t::b.value", "t::a.value"}}
+
+				So(aerr, ShouldResemble, &ResolveError{Cycles: cycles})
+				So(berr, ShouldResemble, &ResolveError{Cycles: cycles})
+				So(v["value"], ShouldEqual, ref("t::a", "value"))
+			})
+		})
+	})
+}
@@ -0,0 +1,142 @@
+/* This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at http://mozilla.org/MPL/2.0/. */
+
+package libmapper
+
+import (
+	"errors"
+
+	graph "gopkg.in/r3labs/graph.v2"
+)
+
+// BuildOptions : options used when ordering a graph's changes
+type BuildOptions struct {
+	// Concurrency : the maximum number of components of a given type,
+	// i.e. "elastic_ip", that can be actioned within a single stage.
+	// Types that are not set are not limited
+	Concurrency map[string]int
+}
+
+func (o *BuildOptions) limit(ctype string) int {
+	if o == nil {
+		return 0
+	}
+
+	return o.Concurrency[ctype]
+}
+
+// BuildStages : returns the ordered stages of component id's in which a planned
+// graph's changes should be applied. Components within a stage do not depend on
+// each other and can be actioned concurrently. Creates and updates are staged
+// after their dependencies, followed by deletes, which are staged before the
+// components they depend on. Changes with no action are omitted
+func BuildStages(g *graph.Graph, opts *BuildOptions) ([][]string, error) {
+	var changes, deletes []graph.Component
+
+	for _, c := range g.Changes {
+		switch c.GetAction() {
+		case "", "none":
+			continue
+		case "delete":
+			deletes = append(deletes, c)
+		default:
+			changes = append(changes, c)
+		}
+	}
+
+	stages, err := buildStages(changes, false, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	ds, err := buildStages(deletes, true, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	return append(stages, ds...), nil
+}
+
+// buildStages : stages a set of changes by their dependencies on each other.
+// If reverse is set, dependent components are staged first
+func buildStages(changes []graph.Component, reverse bool, opts *BuildOptions) ([][]string, error) {
+	var stages [][]string
+
+	ids := make(map[string]bool)
+	for _, c := range changes {
+		ids[c.GetID()] = true
+	}
+
+	deps := make(map[string][]string)
+	waits := make(map[string][]string)
+
+	for _, c := range changes {
+		for _, dep := range c.Dependencies() {
+			if ids[dep] != true {
+				continue
+			}
+
+			deps[c.GetID()] = append(deps[c.GetID()], dep)
+
+			if reverse {
+				waits[dep] = append(waits[dep], c.GetID())
+			} else {
+				waits[c.GetID()] = append(waits[c.GetID()], dep)
+			}
+		}
+	}
+
+	done := make(map[string]bool)
+
+	for len(done) < len(changes) {
+		var stage []string
+
+		count := make(map[string]int)
+
+		for _, c := range changes {
+			if done[c.GetID()] || ready(waits[c.GetID()], done) != true {
+				continue
+			}
+
+			limit := opts.limit(c.GetType())
+			if limit > 0 && count[c.GetType()] >= limit {
+				continue
+			}
+
+			count[c.GetType()]++
+			stage = append(stage, c.GetID())
+		}
+
+		if len(stage) < 1 {
+			state := make(map[string]int)
+
+			for _, c := range changes {
+				path := visit(c.GetID(), deps, state, nil)
+				if path != nil {
+					return nil, &CycleError{Path: path}
+				}
+			}
+
+			return nil, errors.New("Could not order changes, no component can be actioned")
+		}
+
+		for _, id := range stage {
+			done[id] = true
+		}
+
+		stages = append(stages, stage)
+	}
+
+	return stages, nil
+}
+
+func ready(waits []string, done map[string]bool) bool {
+	for _, id := range waits {
+		if done[id] != true {
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,84 @@
+/* This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at http://mozilla.org/MPL/2.0/. */
+
+package libmapper
+
+import (
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+	graph "gopkg.in/r3labs/graph.v2"
+)
+
+func TestBuildStages(t *testing.T) {
+	Convey("Given a graph with planned creates and updates", t, func() {
+		g := testGraph(nil, []graph.Component{
+			&testComponent{ID: "t::a", Action: "create"},
+			&testComponent{ID: "t::b", Action: "create", Deps: []string{"t::a"}},
+			&testComponent{ID: "t::c", Action: "update", Deps: []string{"t::a", "t::b"}},
+			&testComponent{ID: "t::d", Action: "update", Deps: []string{"t::unchanged"}},
+			&testComponent{ID: "t::e", Action: "none"},
+		})
+
+		Convey("When building its stages", func() {
+			stages, err := BuildStages(g, nil)
+
+			Convey("It should stage each change after its dependencies", func() {
+				So(err, ShouldBeNil)
+				So(stages, ShouldResemble, [][]string{{"t::a", "t::d"}, {"t::b"}, {"t::c"}})
+			})
+		})
+	})
+
+	Convey("Given a graph with planned creates and deletes", t, func() {
+		g := testGraph(nil, []graph.Component{
+			&testComponent{ID: "t::a", Action: "delete"},
+			&testComponent{ID: "t::b", Action: "delete", Deps: []string{"t::a"}},
+			&testComponent{ID: "t::c", Action: "delete", Deps: []string{"t::b"}},
+			&testComponent{ID: "t::d", Action: "create"},
+		})
+
+		Convey("When building its stages", func() {
+			stages, err := BuildStages(g, nil)
+
+			Convey("It should stage deletes after creates, before the components they depend on", func() {
+				So(err, ShouldBeNil)
+				So(stages, ShouldResemble, [][]string{{"t::d"}, {"t::c"}, {"t::b"}, {"t::a"}})
+			})
+		})
+	})
+
+	Convey("Given a graph with planned creates of a limited component type", t, func() {
+		g := testGraph(nil, []graph.Component{
+			&testComponent{ID: "elastic_ip::a", Action: "create"},
+			&testComponent{ID: "elastic_ip::b", Action: "create"},
+			&testComponent{ID: "elastic_ip::c", Action: "create"},
+			&testComponent{ID: "t::d", Action: "create"},
+		})
+
+		Convey("When building its stages", func() {
+			stages, err := BuildStages(g, &BuildOptions{Concurrency: map[string]int{"elastic_ip": 2}})
+
+			Convey("It should not stage more components of the type than allowed", func() {
+				So(err, ShouldBeNil)
+				So(stages, ShouldResemble, [][]string{{"elastic_ip::a", "elastic_ip::b", "t::d"}, {"elastic_ip::c"}})
+			})
+		})
+	})
+
+	Convey("Given a graph with planned changes that depend on each other", t, func() {
+		g := testGraph(nil, []graph.Component{
+			&testComponent{ID: "t::a", Action: "create", Deps: []string{"t::b"}},
+			&testComponent{ID: "t::b", Action: "create", Deps: []string{"t::a"}},
+		})
+
+		Convey("When building its stages", func() {
+			_, err := BuildStages(g, nil)
+
+			Convey("It should return the path of the cycle", func() {
+				So(err, ShouldResemble, &CycleError{Path: []string{"t::a", "t::b", "t::a"}})
+			})
+		})
+	})
+}